@@ -0,0 +1,104 @@
+package compatibilities
+
+import (
+	"fmt"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	ast_diffs "github.com/steve-care-software/grammars/domain/engine/asts/diffs"
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+)
+
+type application struct {
+	grammarAdapter grammars.Adapter
+	astAdapter     asts.Adapter
+	astDiffAdapter ast_diffs.Adapter
+}
+
+func createApplication(
+	grammarAdapter grammars.Adapter,
+	astAdapter asts.Adapter,
+	astDiffAdapter ast_diffs.Adapter,
+) Application {
+	out := application{
+		grammarAdapter: grammarAdapter,
+		astAdapter:     astAdapter,
+		astDiffAdapter: astDiffAdapter,
+	}
+
+	return &out
+}
+
+// Check compares the new version of a grammar to the old one
+func (app *application) Check(from grammars.Grammar, to grammars.Grammar, corpus [][]byte) (Report, error) {
+	diff, err := app.grammarAdapter.ToDiff(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	coverage := createCoverage(from)
+	samples := []Sample{}
+	for _, oneBlock := range from.Blocks().List() {
+		if !oneBlock.HasSuites() {
+			continue
+		}
+
+		blockName := oneBlock.Name()
+		for _, oneSuite := range oneBlock.Suites().List() {
+			name := fmt.Sprintf("%s/%s", blockName, oneSuite.Name())
+			retSample, err := app.sample(name, oneSuite.Input(), func(grammar grammars.Grammar, input []byte) (asts.AST, []byte, error) {
+				return app.astAdapter.ToASTWithRoot(grammar, blockName, input)
+			}, from, to, coverage)
+
+			if err != nil {
+				return nil, err
+			}
+
+			samples = append(samples, retSample)
+		}
+	}
+
+	for idx, oneInput := range corpus {
+		name := fmt.Sprintf("%s[%d]", corpusName, idx)
+		retSample, err := app.sample(name, oneInput, app.astAdapter.ToAST, from, to, coverage)
+		if err != nil {
+			return nil, err
+		}
+
+		samples = append(samples, retSample)
+	}
+
+	return createReport(diff, samples, coverage.uncovered(diff)), nil
+}
+
+func (app *application) sample(
+	name string,
+	input []byte,
+	parse func(grammar grammars.Grammar, input []byte) (asts.AST, []byte, error),
+	from grammars.Grammar,
+	to grammars.Grammar,
+	coverage *coverage,
+) (Sample, error) {
+	// an input is accepted when it parses without remaining bytes:
+	fromAST, fromRemaining, err := parse(from, input)
+	isAcceptedBefore := err == nil && len(fromRemaining) <= 0
+	if isAcceptedBefore {
+		coverage.add(fromAST, input)
+	}
+
+	toAST, toRemaining, err := parse(to, input)
+	isAcceptedAfter := err == nil && len(toRemaining) <= 0
+	if !isAcceptedBefore || !isAcceptedAfter {
+		return createSample(name, input, isAcceptedBefore, isAcceptedAfter), nil
+	}
+
+	retDiff, err := app.astDiffAdapter.Compare(fromAST, toAST)
+	if err != nil {
+		return nil, err
+	}
+
+	if retDiff.IsEmpty() {
+		return createSample(name, input, true, true), nil
+	}
+
+	return createSampleWithDiff(name, input, retDiff), nil
+}
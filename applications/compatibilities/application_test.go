@@ -0,0 +1,278 @@
+package compatibilities
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/diffs"
+)
+
+func TestApplication_Success(t *testing.T) {
+	from, err := grammar("1", ".LL_A | .LL_B", "")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	corpus := [][]byte{
+		[]byte("let a; use a;"),
+		[]byte("let c;"),
+	}
+
+	cases := []struct {
+		name           string
+		lines          string
+		rules          string
+		classification Classification
+		diff           string
+	}{
+		{
+			name:           "compatible",
+			lines:          ".LL_A | .LL_B",
+			classification: ClassificationCompatible,
+			diff:           "",
+		},
+		{
+			name:           "widening",
+			lines:          ".LL_A | .LL_B | .LL_C",
+			rules:          `LL_C: "c";`,
+			classification: ClassificationWidening,
+			diff:           "+ line name[2]: .LL_C\n+ rule LL_C: \"c\"\n",
+		},
+		{
+			name:           "breaking",
+			lines:          ".LL_B | .LL_A",
+			classification: ClassificationBreaking,
+			diff:           "~ line name[0]: .LL_A -> .LL_B\n~ line name[1]: .LL_B -> .LL_A\n",
+		},
+	}
+
+	application := NewApplication(grammars.NewRepositoryMemory(map[string]grammars.Grammar{}))
+	for _, oneCase := range cases {
+		to, err := grammar("2", oneCase.lines, oneCase.rules)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retReport, err := application.Check(from, to, corpus)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if retReport.Classification() != oneCase.classification {
+			t.Errorf("the %s case was expected to be classified as %s, %s returned", oneCase.name, oneCase.classification.String(), retReport.Classification().String())
+			return
+		}
+
+		retText := string(diffs.NewAdapter().ToText(retReport.Diff()))
+		if retText != oneCase.diff {
+			t.Errorf("the %s case was expected to contain the diff:\n%s\nreturned:\n%s", oneCase.name, oneCase.diff, retText)
+			return
+		}
+
+		if len(retReport.Uncovered()) != 0 {
+			t.Errorf("the %s case was expected to contain no uncovered change, %d returned", oneCase.name, len(retReport.Uncovered()))
+			return
+		}
+
+		samples := retReport.Samples()
+		if len(samples) != 5 {
+			t.Errorf("the %s case was expected to contain %d samples, %d returned", oneCase.name, 5, len(samples))
+			return
+		}
+	}
+}
+
+func TestApplication_withBreakingSuite_Success(t *testing.T) {
+	from, err := grammar("1", ".LL_A | .LL_B", "")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	to, err := grammar("2", ".LL_A", "")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retReport, err := NewApplication(grammars.NewRepositoryMemory(map[string]grammars.Grammar{})).Check(from, to, nil)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if retReport.Classification() != ClassificationBreaking {
+		t.Errorf("the change was expected to be breaking")
+		return
+	}
+
+	for _, oneSample := range retReport.Samples() {
+		isBreaking := oneSample.Classification() == ClassificationBreaking
+		if isBreaking != (oneSample.Name() == "name/second") {
+			t.Errorf("only the name/second suite was expected to be breaking, %s is breaking: %t", oneSample.Name(), isBreaking)
+			return
+		}
+
+		if oneSample.Name() == "name/second" && (!oneSample.IsAcceptedBefore() || oneSample.IsAcceptedAfter() || oneSample.HasDiff()) {
+			t.Errorf("the name/second suite was expected to be accepted before and rejected after")
+			return
+		}
+	}
+}
+
+func TestApplication_withUncoveredChanges_Success(t *testing.T) {
+	from, err := grammar("1", ".LL_A | .LL_B | .LL_D", `LL_D: "d"; LL_E: "e";`)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	to, err := grammar("2", ".LL_A | .LL_B", `LL_E: "E";`)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	corpus := [][]byte{
+		[]byte("let a; use b;"),
+	}
+
+	retReport, err := NewApplication(grammars.NewRepositoryMemory(map[string]grammars.Grammar{})).Check(from, to, corpus)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	for _, oneSample := range retReport.Samples() {
+		if oneSample.Classification() != ClassificationCompatible {
+			t.Errorf("the sample (%s) was expected to be compatible, %s returned", oneSample.Name(), oneSample.Classification().String())
+			return
+		}
+	}
+
+	if retReport.Classification() != ClassificationBreaking {
+		t.Errorf("the change was expected to be breaking, %s returned", retReport.Classification().String())
+		return
+	}
+
+	expected := "- line name[2]: .LL_D\n- rule LL_D: \"d\"\n~ rule LL_E: \"e\" -> \"E\"\n"
+	retDiff, err := diffs.NewBuilder().Create().WithList(retReport.Uncovered()).Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retText := string(diffs.NewAdapter().ToText(retDiff))
+	if retText != expected {
+		t.Errorf("the uncovered changes were expected to be:\n%s\nreturned:\n%s", expected, retText)
+		return
+	}
+}
+
+func TestApplication_withRootAndOmissionChanges_Success(t *testing.T) {
+	from, err := grammar("1", ".LL_A | .LL_B", "")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	cases := []struct {
+		name    string
+		header  string
+		subject diffs.Subject
+	}{
+		{
+			name:    "root",
+			header:  "> .declaration; # .SPACE;",
+			subject: diffs.SubjectRoot,
+		},
+		{
+			name:    "omissions",
+			header:  "> .program;",
+			subject: diffs.SubjectOmissions,
+		},
+	}
+
+	application := NewApplication(grammars.NewRepositoryMemory(map[string]grammars.Grammar{}))
+	for _, oneCase := range cases {
+		to, err := grammarWithHeader("2", oneCase.header, ".LL_A | .LL_B", "")
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		// the suites are parsed from their block and contain no omitted bytes, so they exercise neither:
+		retReport, err := application.Check(from, to, nil)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if retReport.Classification() != ClassificationBreaking {
+			t.Errorf("the %s case was expected to be breaking, %s returned", oneCase.name, retReport.Classification().String())
+			return
+		}
+
+		uncovered := retReport.Uncovered()
+		if len(uncovered) != 1 || uncovered[0].Subject() != oneCase.subject || uncovered[0].IsAdded() {
+			t.Errorf("the %s case was expected to contain its change as the only uncovered change, %d returned", oneCase.name, len(uncovered))
+			return
+		}
+
+		// an input of the corpus is parsed from the root and contains omitted bytes, so it exercises both:
+		retReport, err = application.Check(from, to, [][]byte{
+			[]byte("let a;"),
+		})
+
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if len(retReport.Uncovered()) != 0 {
+			t.Errorf("the %s case was expected to contain no uncovered change once exercised by the corpus, %d returned", oneCase.name, len(retReport.Uncovered()))
+			return
+		}
+	}
+}
+
+func grammar(version string, lines string, rules string) (grammars.Grammar, error) {
+	return grammarWithHeader(version, "> .program; # .SPACE;", lines, rules)
+}
+
+func grammarWithHeader(version string, header string, lines string, rules string) (grammars.Grammar, error) {
+	retGrammar, _, err := grammars.NewAdapter().ToGrammar([]byte(fmt.Sprintf(`
+		v%s;
+		%s
+
+		program: .declaration+ .usage*
+				;
+
+		declaration: .LET .name .SEMICOLON
+					;
+
+		usage: .USE .name .SEMICOLON
+				;
+
+		name: %s
+			---
+				first: "a";
+				second: "b";
+				invalid: !"c";
+			;
+
+		LET: "let";
+		USE: "use";
+		SEMICOLON: ";";
+		LL_A: "a";
+		LL_B: "b";
+		SPACE: " ";
+		%s
+	`, version, header, lines, rules)))
+
+	return retGrammar, err
+}
@@ -0,0 +1,15 @@
+package compatibilities
+
+// String returns the name of the classification
+func (obj Classification) String() string {
+	switch obj {
+	case ClassificationCompatible:
+		return "compatible"
+	case ClassificationWidening:
+		return "widening"
+	case ClassificationBreaking:
+		return "breaking"
+	}
+
+	return "unknown"
+}
@@ -0,0 +1,158 @@
+package compatibilities
+
+import (
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/constants"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/diffs"
+)
+
+type coverage struct {
+	grammar     grammars.Grammar
+	lines       map[string]map[uint]bool
+	rules       map[string]bool
+	constants   map[string]bool
+	isRoot      bool
+	isOmissions bool
+}
+
+func createCoverage(
+	grammar grammars.Grammar,
+) *coverage {
+	out := coverage{
+		grammar:   grammar,
+		lines:     map[string]map[uint]bool{},
+		rules:     map[string]bool{},
+		constants: map[string]bool{},
+	}
+
+	return &out
+}
+
+// add records the root, omissions, lines, rules and constants used by the AST of the input, skipping its embedded ASTs
+// that belong to other grammars
+func (app *coverage) add(ast asts.AST, input []byte) {
+	root := ast.Root()
+	if root.Name() == app.grammar.Root().Name() {
+		app.isRoot = true
+	}
+
+	// the spans exclude the omitted bytes, so the omissions were used if the leaves do not span the whole input:
+	if consumed(root) < uint(len(input)) {
+		app.isOmissions = true
+	}
+
+	app.element(root)
+}
+
+// uncovered returns the removed or changed root, omissions, blocks, lines, constants and rules that are not used by any AST
+func (app *coverage) uncovered(diff diffs.Diff) []diffs.Change {
+	output := []diffs.Change{}
+	for _, oneChange := range diff.List() {
+		if oneChange.IsAdded() {
+			continue
+		}
+
+		isCovered := true
+		name := oneChange.Name()
+		switch oneChange.Subject() {
+		case diffs.SubjectRoot:
+			isCovered = app.isRoot
+		case diffs.SubjectOmissions:
+			isCovered = app.isOmissions
+		case diffs.SubjectBlock:
+			isCovered = len(app.lines[name]) > 0
+		case diffs.SubjectLine:
+			isCovered = app.lines[name][oneChange.Index()]
+		case diffs.SubjectConstant:
+			isCovered = app.constants[name]
+		case diffs.SubjectRule:
+			isCovered = app.rules[name]
+		}
+
+		if !isCovered {
+			output = append(output, oneChange)
+		}
+	}
+
+	return output
+}
+
+func (app *coverage) element(element asts.Element) {
+	if element.IsConstant() {
+		app.name(element.Constant().Name())
+		return
+	}
+
+	if !element.IsInstruction() {
+		return
+	}
+
+	instruction := element.Instruction()
+	block := instruction.Block()
+	if _, ok := app.lines[block]; !ok {
+		app.lines[block] = map[uint]bool{}
+	}
+
+	app.lines[block][instruction.Line()] = true
+	for _, oneToken := range instruction.Tokens().List() {
+		for _, oneElement := range oneToken.Elements().List() {
+			app.element(oneElement)
+		}
+	}
+}
+
+// name records a constant and the rules and constants it contains, or a rule
+func (app *coverage) name(name string) {
+	if app.grammar.HasConstants() {
+		constant, err := app.grammar.Constants().Fetch(name)
+		if err == nil {
+			app.constant(constant)
+			return
+		}
+	}
+
+	app.rules[name] = true
+}
+
+func (app *coverage) constant(constant constants.Constant) {
+	if app.constants[constant.Name()] {
+		return
+	}
+
+	app.constants[constant.Name()] = true
+	for _, oneToken := range constant.Tokens().List() {
+		element := oneToken.Element()
+		if element.IsConstant() {
+			app.name(element.Constant())
+			continue
+		}
+
+		app.rules[element.Rule()] = true
+	}
+}
+
+// consumed returns the amount of bytes spanned by the leaves of the element, including the ones of its embedded ASTs
+func consumed(element asts.Element) uint {
+	if element.IsAST() {
+		return consumed(element.AST().Root())
+	}
+
+	if element.IsConstant() {
+		if !element.HasSpan() {
+			return 0
+		}
+
+		span := element.Span()
+		return span.End() - span.Start()
+	}
+
+	output := uint(0)
+	for _, oneToken := range element.Instruction().Tokens().List() {
+		for _, oneElement := range oneToken.Elements().List() {
+			output += consumed(oneElement)
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,52 @@
+package compatibilities
+
+import "github.com/steve-care-software/grammars/domain/engine/grammars/diffs"
+
+type report struct {
+	diff      diffs.Diff
+	samples   []Sample
+	uncovered []diffs.Change
+}
+
+func createReport(
+	diff diffs.Diff,
+	samples []Sample,
+	uncovered []diffs.Change,
+) Report {
+	out := report{
+		diff:      diff,
+		samples:   samples,
+		uncovered: uncovered,
+	}
+
+	return &out
+}
+
+// Diff returns the diff between the grammars
+func (obj *report) Diff() diffs.Diff {
+	return obj.diff
+}
+
+// Samples returns the samples
+func (obj *report) Samples() []Sample {
+	return obj.samples
+}
+
+// Uncovered returns the removed or changed grammar parts that no sample covers
+func (obj *report) Uncovered() []diffs.Change {
+	return obj.uncovered
+}
+
+// Classification returns the most severe classification of the samples, or breaking if a removed or changed grammar part is not covered by any sample
+func (obj *report) Classification() Classification {
+	if len(obj.uncovered) > 0 {
+		return ClassificationBreaking
+	}
+
+	output := ClassificationCompatible
+	for _, oneSample := range obj.samples {
+		output = max(output, oneSample.Classification())
+	}
+
+	return output
+}
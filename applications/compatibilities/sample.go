@@ -0,0 +1,89 @@
+package compatibilities
+
+import ast_diffs "github.com/steve-care-software/grammars/domain/engine/asts/diffs"
+
+type sample struct {
+	name             string
+	input            []byte
+	isAcceptedBefore bool
+	isAcceptedAfter  bool
+	diff             ast_diffs.Diff
+}
+
+func createSample(
+	name string,
+	input []byte,
+	isAcceptedBefore bool,
+	isAcceptedAfter bool,
+) Sample {
+	return createSampleInternally(name, input, isAcceptedBefore, isAcceptedAfter, nil)
+}
+
+func createSampleWithDiff(
+	name string,
+	input []byte,
+	diff ast_diffs.Diff,
+) Sample {
+	return createSampleInternally(name, input, true, true, diff)
+}
+
+func createSampleInternally(
+	name string,
+	input []byte,
+	isAcceptedBefore bool,
+	isAcceptedAfter bool,
+	diff ast_diffs.Diff,
+) Sample {
+	out := sample{
+		name:             name,
+		input:            input,
+		isAcceptedBefore: isAcceptedBefore,
+		isAcceptedAfter:  isAcceptedAfter,
+		diff:             diff,
+	}
+
+	return &out
+}
+
+// Name returns the name
+func (obj *sample) Name() string {
+	return obj.name
+}
+
+// Input returns the input
+func (obj *sample) Input() []byte {
+	return obj.input
+}
+
+// IsAcceptedBefore returns true if the old version accepts the input, false otherwise
+func (obj *sample) IsAcceptedBefore() bool {
+	return obj.isAcceptedBefore
+}
+
+// IsAcceptedAfter returns true if the new version accepts the input, false otherwise
+func (obj *sample) IsAcceptedAfter() bool {
+	return obj.isAcceptedAfter
+}
+
+// HasDiff returns true if there is a diff, false otherwise
+func (obj *sample) HasDiff() bool {
+	return obj.diff != nil
+}
+
+// Diff returns the diff, if any
+func (obj *sample) Diff() ast_diffs.Diff {
+	return obj.diff
+}
+
+// Classification returns the classification
+func (obj *sample) Classification() Classification {
+	if obj.isAcceptedBefore && (!obj.isAcceptedAfter || obj.HasDiff()) {
+		return ClassificationBreaking
+	}
+
+	if !obj.isAcceptedBefore && obj.isAcceptedAfter {
+		return ClassificationWidening
+	}
+
+	return ClassificationCompatible
+}
@@ -0,0 +1,73 @@
+package compatibilities
+
+import (
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	ast_diffs "github.com/steve-care-software/grammars/domain/engine/asts/diffs"
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/diffs"
+)
+
+// Classification represents the compatibility of a new grammar version with the old one
+type Classification uint8
+
+const (
+	// ClassificationCompatible represents a version that accepts the same inputs and parses them the same
+	ClassificationCompatible Classification = iota
+
+	// ClassificationWidening represents a version that also accepts inputs rejected by the old one
+	ClassificationWidening
+
+	// ClassificationBreaking represents a version that rejects inputs accepted by the old one, or parses them differently
+	ClassificationBreaking
+)
+
+const corpusName = "corpus"
+
+// NewApplication creates a new compatibility application
+func NewApplication(
+	grammarRepository grammars.Repository,
+) Application {
+	grammarAdapter := grammars.NewAdapter()
+	astAdapter := asts.NewAdapter(
+		grammarRepository,
+	)
+
+	astDiffAdapter := ast_diffs.NewAdapter()
+	return createApplication(
+		grammarAdapter,
+		astAdapter,
+		astDiffAdapter,
+	)
+}
+
+// Application represents the compatibility application
+type Application interface {
+	// Check compares the new version of a grammar to the old one, then parses the suites of the old version, using their
+	// block as root, and the corpus using both versions in order to classify the change
+	Check(from grammars.Grammar, to grammars.Grammar, corpus [][]byte) (Report, error)
+}
+
+// Report represents a compatibility report
+type Report interface {
+	Diff() diffs.Diff
+	Samples() []Sample
+
+	// Uncovered returns the removed or changed root, omissions, blocks, lines, constants and rules of the diff that are
+	// not used by any sample accepted by the old version, which make the change breaking
+	Uncovered() []diffs.Change
+	Classification() Classification
+}
+
+// Sample represents an input parsed using both versions of the grammar
+type Sample interface {
+	// Name returns block/suite for a suite, or corpus[index] for an input of the corpus
+	Name() string
+	Input() []byte
+	IsAcceptedBefore() bool
+	IsAcceptedAfter() bool
+
+	// HasDiff returns true if the input is accepted by both versions but parsed differently
+	HasDiff() bool
+	Diff() ast_diffs.Diff
+	Classification() Classification
+}
@@ -3,10 +3,16 @@ package engine
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/asts/printers"
 	"github.com/steve-care-software/grammars/domain/engine/grammars"
 	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/suites"
+	"github.com/steve-care-software/grammars/domain/engine/results"
+	"github.com/steve-care-software/grammars/domain/engine/snapshots"
 	"github.com/steve-care-software/grammars/domain/engine/walkers"
 )
 
@@ -14,20 +20,38 @@ type application struct {
 	elementsAdapter asts.ElementsAdapter
 	astAdapter      asts.Adapter
 	tokensBuilder   asts.TokensBuilder
+	resultsBuilder  results.Builder
+	resultBuilder   results.ResultBuilder
+	snapshotAdapter snapshots.Adapter
+	printer         printers.Printer
 	walker          walkers.Walker
+	goldenDirectory string
+	isGoldenUpdate  bool
 }
 
 func createApplication(
 	elementsAdapter asts.ElementsAdapter,
 	astAdapter asts.Adapter,
 	tokensBuilder asts.TokensBuilder,
+	resultsBuilder results.Builder,
+	resultBuilder results.ResultBuilder,
+	snapshotAdapter snapshots.Adapter,
+	printer printers.Printer,
 	walker walkers.Walker,
+	goldenDirectory string,
+	isGoldenUpdate bool,
 ) Application {
 	out := application{
 		elementsAdapter: elementsAdapter,
 		astAdapter:      astAdapter,
 		tokensBuilder:   tokensBuilder,
+		resultsBuilder:  resultsBuilder,
+		resultBuilder:   resultBuilder,
+		snapshotAdapter: snapshotAdapter,
+		printer:         printer,
 		walker:          walker,
+		goldenDirectory: goldenDirectory,
+		isGoldenUpdate:  isGoldenUpdate,
 	}
 
 	return &out
@@ -228,8 +252,32 @@ func (app *application) node(
 	return app.element(element, ins.Element())
 }
 
-// Suites executes all the test suites of the grammar
+// Suites executes all the test suites of the grammar, and returns the failure of the first unsuccessful suite as an error
 func (app *application) Suites(grammar grammars.Grammar) error {
+	retResults, err := app.RunSuites(grammar)
+	if err != nil {
+		return err
+	}
+
+	failures := retResults.Failures()
+	if len(failures) <= 0 {
+		return nil
+	}
+
+	first := failures[0]
+	prefix := fmt.Sprintf("block (name: %s) index (%d) suite (%s)", first.Block(), first.Index(), first.Name())
+	if first.IsFail() {
+		str := fmt.Sprintf("%s: the suite was expected to FAIL but succeeded!", prefix)
+		return errors.New(str)
+	}
+
+	str := fmt.Sprintf("%s the suite was expected to SUCCEED but failed --- error: %s", prefix, first.Error().Error())
+	return errors.New(str)
+}
+
+// RunSuites executes every test suite of every block of the grammar, without stopping on failures, and returns their results
+func (app *application) RunSuites(grammar grammars.Grammar) (results.Results, error) {
+	list := []results.Result{}
 	blocksList := grammar.Blocks().List()
 	for _, oneBlock := range blocksList {
 		if !oneBlock.HasSuites() {
@@ -239,36 +287,47 @@ func (app *application) Suites(grammar grammars.Grammar) error {
 		blockName := oneBlock.Name()
 		suitesList := oneBlock.Suites().List()
 		for idx, oneSuite := range suitesList {
-			err := app.interpretSuite(
+			start := time.Now()
+			retRemaining, retDiff, err := app.interpretSuite(
 				grammar,
 				blockName,
 				oneSuite,
 			)
 
-			prefix := fmt.Sprintf("block (name: %s) index (%d) suite (%s)", blockName, idx, oneSuite.Name())
-			if oneSuite.IsFail() {
-				if err == nil {
-					str := fmt.Sprintf("%s: the suite was expected to FAIL but succeeded!", prefix)
-					return errors.New(str)
-				}
+			builder := app.resultBuilder.Create().
+				WithBlock(blockName).
+				WithIndex(uint(idx)).
+				WithName(oneSuite.Name()).
+				WithRemaining(retRemaining).
+				WithDuration(time.Since(start))
+
+			if err != nil {
+				builder.WithError(err).WithDiff(retDiff)
+			}
 
-				continue
+			if oneSuite.IsFail() {
+				builder.IsFail()
 			}
 
+			retResult, err := builder.Now()
 			if err != nil {
-				str := fmt.Sprintf("%s the suite was expected to SUCCEED but failed --- error: %s", prefix, err.Error())
-				return errors.New(str)
+				return nil, err
 			}
+
+			list = append(list, retResult)
 		}
 	}
-	return nil
+
+	return app.resultsBuilder.Create().
+		WithList(list).
+		Now()
 }
 
 func (app *application) interpretSuite(
 	grammar grammars.Grammar,
 	blockName string,
 	suite suites.Suite,
-) error {
+) ([]byte, []byte, error) {
 	ast, retRemaining, err := app.astAdapter.ToASTWithRoot(
 		grammar,
 		blockName,
@@ -276,15 +335,75 @@ func (app *application) interpretSuite(
 	)
 
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	if len(retRemaining) != 0 {
 		str := fmt.Sprintf("the bytes (%s) were remaining", retRemaining)
-		return errors.New(str)
+		return retRemaining, nil, errors.New(str)
+	}
+
+	err = app.execute(ast)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	retDiff, err := app.compareSuite(suite, ast)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if retDiff != nil {
+		tree, err := app.printer.Print(ast)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		str := fmt.Sprintf("the AST does not match its expected snapshot:\n%s\nthe parsed tree is:\n%s", retDiff, tree)
+		return nil, retDiff, errors.New(str)
+	}
+
+	return nil, nil, nil
+}
+
+func (app *application) compareSuite(
+	suite suites.Suite,
+	ast asts.AST,
+) ([]byte, error) {
+	if suite.HasExpected() {
+		return app.snapshotAdapter.Compare(suite.Expected(), ast)
+	}
+
+	if !suite.HasGolden() {
+		return nil, nil
+	}
+
+	path := suite.Golden()
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(app.goldenDirectory, path)
 	}
 
-	return app.execute(ast)
+	if app.isGoldenUpdate {
+		snapshot, err := app.snapshotAdapter.ToSnapshot(ast)
+		if err != nil {
+			return nil, err
+		}
+
+		err = os.MkdirAll(filepath.Dir(path), os.ModePerm)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, os.WriteFile(path, snapshot, 0644)
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		str := fmt.Sprintf("the golden file (%s) could not be read, use the golden update mode to create it: %s", path, err.Error())
+		return nil, errors.New(str)
+	}
+
+	return app.snapshotAdapter.Compare(expected, ast)
 }
 
 func (app *application) interpretInstruction(
@@ -352,6 +471,10 @@ func (app *application) interpretElement(
 		return nil
 	}
 
+	if element.IsAST() {
+		return app.execute(element.AST())
+	}
+
 	instruction := element.Instruction()
 	return app.interpretInstruction(
 		instruction,
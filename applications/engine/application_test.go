@@ -1,9 +1,20 @@
 package engine
 
 import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/steve-care-software/grammars/domain/engine/coverages"
 	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/results"
 	"github.com/steve-care-software/grammars/domain/engine/walkers/elements"
 )
 
@@ -723,3 +734,445 @@ func TestApplication_grammar_withSuites_Success(t *testing.T) {
 		return
 	}
 }
+
+func TestApplication_withCoverage_Success(t *testing.T) {
+	grammarInput := []byte(`
+		v1;
+		> .assignment;
+		# .SPACE;
+
+		assignment: .name .EQUAL .value
+				---
+					valid: "a = 1";
+				;
+
+		name: .LL_A
+			| .LL_B
+			;
+
+		value: .N_ONE
+			 | .N_TWO
+			 ---
+			 	two: "2";
+			 ;
+
+		N_ONE: "1";
+		N_TWO: "2";
+		LL_A: "a";
+		LL_B: "b";
+		EQUAL: "=";
+		SPACE: " ";
+	`)
+
+	grammarAdapter := grammars.NewAdapter()
+	retGrammar, _, err := grammarAdapter.ToGrammar(grammarInput)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	collector := coverages.NewCollector()
+	application, err := NewBuilderWithCoverage(
+		grammars.NewRepositoryMemory(map[string]grammars.Grammar{}),
+		collector,
+	).Create().Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = application.Suites(retGrammar)
+	if err != nil {
+		t.Errorf("there was an error while running the grammar test suites: %s", err.Error())
+		return
+	}
+
+	retReport, err := collector.Report(retGrammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	unexercised := retReport.Unexercised()
+	if len(unexercised) != 2 {
+		t.Errorf("the report was expected to contain %d unexercised parts, %d returned: %v", 2, len(unexercised), unexercised)
+		return
+	}
+
+	expected := map[string]bool{
+		"name.line[1]":                true,
+		"name.line[1].token[0](LL_B)": true,
+	}
+
+	for _, onePath := range unexercised {
+		if _, ok := expected[onePath]; !ok {
+			t.Errorf("the path (%s) was not expected to be unexercised", onePath)
+			return
+		}
+	}
+
+	if retReport.Covered()+2 != retReport.Total() {
+		t.Errorf("the report was expected to cover all but %d parts, %d/%d returned", 2, retReport.Covered(), retReport.Total())
+		return
+	}
+
+	adapter := coverages.NewAdapter()
+	retJSON, err := adapter.ToJSON(retReport)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	decoded := map[string]any{}
+	err = json.Unmarshal(retJSON, &decoded)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if _, ok := decoded["unexercised"]; !ok {
+		t.Errorf("the JSON report was expected to contain the unexercised parts")
+		return
+	}
+
+	retText, err := adapter.ToText(retReport)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(retText) <= 0 {
+		t.Errorf("the text report was expected to NOT be empty")
+		return
+	}
+}
+
+func TestApplication_runSuites_withFailures_Success(t *testing.T) {
+	grammarInput := []byte(`
+		v1;
+		> .assignment;
+		# .SPACE;
+
+		assignment: .name .EQUAL .value
+				---
+					valid: "a = 1";
+					invalidValue: "a = 3";
+					remaining: "a = 1 b";
+					expectedToFail: !"b = 2";
+					failure: !"a = 4";
+				;
+
+		name: .LL_A
+			| .LL_B
+			;
+
+		value: .N_ONE
+			 | .N_TWO
+			 ;
+
+		N_ONE: "1";
+		N_TWO: "2";
+		LL_A: "a";
+		LL_B: "b";
+		EQUAL: "=";
+		SPACE: " ";
+	`)
+
+	grammarAdapter := grammars.NewAdapter()
+	retGrammar, _, err := grammarAdapter.ToGrammar(grammarInput)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	application, err := NewBuilder(
+		grammars.NewRepositoryMemory(map[string]grammars.Grammar{}),
+	).Create().Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retResults, err := application.RunSuites(retGrammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	list := retResults.List()
+	if len(list) != 5 {
+		t.Errorf("the results were expected to contain %d results, %d returned", 5, len(list))
+		return
+	}
+
+	if retResults.IsSuccess() {
+		t.Errorf("the results were expected to NOT be a success")
+		return
+	}
+
+	failures := retResults.Failures()
+	if len(failures) != 3 {
+		t.Errorf("the results were expected to contain %d failures, %d returned", 3, len(failures))
+		return
+	}
+
+	if failures[0].Name() != "invalidValue" || !failures[0].HasError() {
+		t.Errorf("the first failure was expected to be the invalidValue suite, with an error")
+		return
+	}
+
+	if !bytes.Equal(failures[1].Remaining(), []byte("b")) {
+		t.Errorf("the remaining of the second failure was expected to be '%s', '%s' returned", "b", failures[1].Remaining())
+		return
+	}
+
+	if !failures[2].IsFail() || failures[2].HasFailed() {
+		t.Errorf("the third failure was expected to FAIL but succeeded")
+		return
+	}
+
+	adapter := results.NewAdapter()
+	retJUnit, err := adapter.ToJUnit(retResults)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	decoded := struct {
+		Failures uint `xml:"failures,attr"`
+	}{}
+
+	err = xml.Unmarshal(retJUnit, &decoded)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if decoded.Failures != 3 {
+		t.Errorf("the JUnit document was expected to contain %d failures, %d returned", 3, decoded.Failures)
+		return
+	}
+
+	retTAP, err := adapter.ToTAP(retResults)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !bytes.Contains(retTAP, []byte("not ok 2 - assignment/invalidValue")) {
+		t.Errorf("the TAP output was expected to report the invalidValue failure, returned:\n%s", retTAP)
+		return
+	}
+
+	err = application.Suites(retGrammar)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	if !strings.Contains(err.Error(), "suite (invalidValue)") {
+		t.Errorf("the error was expected to report the first failure (invalidValue), returned: %s", err.Error())
+		return
+	}
+}
+
+func TestApplication_runSuites_withExpectedSnapshots_Success(t *testing.T) {
+	grammarInput := []byte(`
+		v1;
+		> .assignment;
+		# .SPACE;
+
+		assignment: .name .EQUAL .value
+				---
+					inline: "a = 1" => "(assignment#0 (name (name#0 (LL_A \"a\"))) (EQUAL \"=\") (value (value#0 (N_ONE \"1\"))))";
+					wrongTree: "b = 1" => "(assignment#0 (name (name#0 (LL_A \"a\"))) (EQUAL \"=\") (value (value#0 (N_ONE \"1\"))))";
+					golden: "b = 2" => @"assignment/golden.ast";
+				;
+
+		name: .LL_A
+			| .LL_B
+			;
+
+		value: .N_ONE
+			 | .N_TWO
+			 ;
+
+		N_ONE: "1";
+		N_TWO: "2";
+		LL_A: "a";
+		LL_B: "b";
+		EQUAL: "=";
+		SPACE: " ";
+	`)
+
+	grammarAdapter := grammars.NewAdapter()
+	retGrammar, _, err := grammarAdapter.ToGrammar(grammarInput)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	goldenDirectory := t.TempDir()
+	builder := NewBuilder(
+		grammars.NewRepositoryMemory(map[string]grammars.Grammar{}),
+	)
+
+	application, err := builder.Create().WithGoldenDirectory(goldenDirectory).Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retResults, err := application.RunSuites(retGrammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	failures := retResults.Failures()
+	if len(failures) != 2 {
+		t.Errorf("the results were expected to contain %d failures, %d returned", 2, len(failures))
+		return
+	}
+
+	if failures[0].Name() != "wrongTree" || !failures[0].HasDiff() {
+		t.Errorf("the first failure was expected to be the wrongTree suite, with a diff")
+		return
+	}
+
+	if !bytes.Contains(failures[0].Diff(), []byte(`-			(LL_A "a")))`)) || !bytes.Contains(failures[0].Diff(), []byte(`+			(LL_B "b")))`)) {
+		t.Errorf("the diff was expected to replace the LL_A token by the LL_B token, returned:\n%s", failures[0].Diff())
+		return
+	}
+
+	if !strings.Contains(failures[0].Error().Error(), "the parsed tree is:\n") {
+		t.Errorf("the error was expected to contain the parsed tree, returned:\n%s", failures[0].Error().Error())
+		return
+	}
+
+	if failures[1].Name() != "golden" || failures[1].HasDiff() {
+		t.Errorf("the second failure was expected to be the golden suite, without a diff since its golden file is missing")
+		return
+	}
+
+	updateApplication, err := builder.Create().WithGoldenDirectory(goldenDirectory).UpdateGoldens().Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = updateApplication.RunSuites(retGrammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retGolden, err := os.ReadFile(filepath.Join(goldenDirectory, "assignment", "golden.ast"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expectedGolden := "(assignment#0\n\t(name\n\t\t(name#1\n\t\t\t(LL_B \"b\")))\n\t(EQUAL \"=\")\n\t(value\n\t\t(value#1\n\t\t\t(N_TWO \"2\"))))\n"
+	if string(retGolden) != expectedGolden {
+		t.Errorf("the golden file was expected to be:\n%s\nreturned:\n%s", expectedGolden, retGolden)
+		return
+	}
+
+	retResults, err = application.RunSuites(retGrammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	failures = retResults.Failures()
+	if len(failures) != 1 || failures[0].Name() != "wrongTree" {
+		t.Errorf("the wrongTree suite was expected to be the only failure once the golden file is written")
+		return
+	}
+}
+
+func TestApplication_concurrent_Success(t *testing.T) {
+	grammarInput := []byte(`
+		v1;
+		> .pointer;
+		# .SPACE;
+
+		pointer: .name .COLON .name
+				---
+					valid: "a:b";
+					invalid: !"a:";
+				;
+
+		name: .letter+;
+		letter: .LL_A
+				| .LL_B
+				| .LL_C
+				;
+
+		LL_A: "a";
+		LL_B: "b";
+		LL_C: "c";
+		COLON: ":";
+		SPACE: " ";
+	`)
+
+	retGrammar, _, err := grammars.NewAdapter().ToGrammar(grammarInput)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	application, err := NewBuilder(
+		grammars.NewRepositoryMemory(map[string]grammars.Grammar{}),
+	).Create().WithElement(elements.Element{
+		ElementFn: func(input any) (any, error) {
+			return string(input.([]byte)), nil
+		},
+	}).Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	amount := 16
+	errs := make(chan error, amount)
+	waitGroup := sync.WaitGroup{}
+	for i := 0; i < amount; i++ {
+		waitGroup.Add(1)
+		go func(idx int) {
+			defer waitGroup.Done()
+			expected := fmt.Sprintf("%s:%s", strings.Repeat("a", idx+1), strings.Repeat("bc", idx+1))
+			retOutput, _, err := application.Execute([]byte(expected), retGrammar)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			if retOutput != expected {
+				errs <- errors.New(fmt.Sprintf("the output was expected to be '%s', '%v' returned", expected, retOutput))
+				return
+			}
+
+			retResults, err := application.RunSuites(retGrammar)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			if !retResults.IsSuccess() {
+				errs <- errors.New("the suites were expected to succeed")
+			}
+		}(i)
+	}
+
+	waitGroup.Wait()
+	close(errs)
+	for oneErr := range errs {
+		t.Errorf("the error was expected to be nil, error returned: %s", oneErr.Error())
+		return
+	}
+}
@@ -2,6 +2,9 @@ package engine
 
 import (
 	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/asts/printers"
+	"github.com/steve-care-software/grammars/domain/engine/results"
+	"github.com/steve-care-software/grammars/domain/engine/snapshots"
 	"github.com/steve-care-software/grammars/domain/engine/walkers"
 	"github.com/steve-care-software/grammars/domain/engine/walkers/elements"
 )
@@ -11,7 +14,13 @@ type builder struct {
 	astAdapter      asts.Adapter
 	elementAdapter  elements.Adapter
 	tokensBuilder   asts.TokensBuilder
+	resultsBuilder  results.Builder
+	resultBuilder   results.ResultBuilder
+	snapshotAdapter snapshots.Adapter
+	printer         printers.Printer
 	pElement        *elements.Element
+	goldenDirectory string
+	isGoldenUpdate  bool
 }
 
 func createBuilder(
@@ -19,13 +28,23 @@ func createBuilder(
 	astAdapter asts.Adapter,
 	elementAdapter elements.Adapter,
 	tokensBuilder asts.TokensBuilder,
+	resultsBuilder results.Builder,
+	resultBuilder results.ResultBuilder,
+	snapshotAdapter snapshots.Adapter,
+	printer printers.Printer,
 ) Builder {
 	out := builder{
 		elementsAdapter: elementsAdapter,
 		astAdapter:      astAdapter,
 		elementAdapter:  elementAdapter,
 		tokensBuilder:   tokensBuilder,
+		resultsBuilder:  resultsBuilder,
+		resultBuilder:   resultBuilder,
+		snapshotAdapter: snapshotAdapter,
+		printer:         printer,
 		pElement:        nil,
+		goldenDirectory: "",
+		isGoldenUpdate:  false,
 	}
 
 	return &out
@@ -38,6 +57,10 @@ func (app *builder) Create() Builder {
 		app.astAdapter,
 		app.elementAdapter,
 		app.tokensBuilder,
+		app.resultsBuilder,
+		app.resultBuilder,
+		app.snapshotAdapter,
+		app.printer,
 	)
 }
 
@@ -47,6 +70,18 @@ func (app *builder) WithElement(ins elements.Element) Builder {
 	return app
 }
 
+// WithGoldenDirectory adds a golden directory to the builder, used to resolve the relative golden file paths of the suites
+func (app *builder) WithGoldenDirectory(goldenDirectory string) Builder {
+	app.goldenDirectory = goldenDirectory
+	return app
+}
+
+// UpdateGoldens flags the builder so that the suites rewrite their golden files instead of comparing them
+func (app *builder) UpdateGoldens() Builder {
+	app.isGoldenUpdate = true
+	return app
+}
+
 // Now builds a new Application instance
 func (app *builder) Now() (Application, error) {
 	var walker walkers.Walker
@@ -63,6 +98,12 @@ func (app *builder) Now() (Application, error) {
 		app.elementsAdapter,
 		app.astAdapter,
 		app.tokensBuilder,
+		app.resultsBuilder,
+		app.resultBuilder,
+		app.snapshotAdapter,
+		app.printer,
 		walker,
+		app.goldenDirectory,
+		app.isGoldenUpdate,
 	), nil
 }
@@ -2,17 +2,34 @@ package engine
 
 import (
 	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/asts/printers"
+	"github.com/steve-care-software/grammars/domain/engine/coverages"
 	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/results"
+	"github.com/steve-care-software/grammars/domain/engine/snapshots"
 	"github.com/steve-care-software/grammars/domain/engine/walkers/elements"
+	"github.com/steve-care-software/grammars/domain/engine/walkers/typings"
 )
 
 // NewBuilder creates a new application builder
 func NewBuilder(
 	grammarRepository grammars.Repository,
+) Builder {
+	return NewBuilderWithCoverage(
+		grammarRepository,
+		nil,
+	)
+}
+
+// NewBuilderWithCoverage creates a new application builder whose parsed inputs, including the suites, are recorded in the provided coverage collector
+func NewBuilderWithCoverage(
+	grammarRepository grammars.Repository,
+	coverage coverages.Collector,
 ) Builder {
 	elementsAdapter := asts.NewElementsAdapter()
-	astAdapter := asts.NewAdapter(
+	astAdapter := asts.NewAdapterWithCoverage(
 		grammarRepository,
+		coverage,
 	)
 
 	elementAdapter := elements.NewAdapter(
@@ -20,11 +37,32 @@ func NewBuilder(
 	)
 
 	tokensBuilder := asts.NewTokensBuilder()
+	resultsBuilder := results.NewBuilder()
+	resultBuilder := results.NewResultBuilder()
+	snapshotAdapter := snapshots.NewAdapter()
+	printer := printers.NewCompactPrinter()
 	return createBuilder(
 		elementsAdapter,
 		astAdapter,
 		elementAdapter,
 		tokensBuilder,
+		resultsBuilder,
+		resultBuilder,
+		snapshotAdapter,
+		printer,
+	)
+}
+
+// NewTypedBuilder creates a new typed application builder
+func NewTypedBuilder[T any](
+	grammarRepository grammars.Repository,
+) TypedBuilder[T] {
+	builder := NewBuilder(
+		grammarRepository,
+	)
+
+	return createTypedBuilder[T](
+		builder,
 	)
 }
 
@@ -32,14 +70,40 @@ func NewBuilder(
 type Builder interface {
 	Create() Builder
 	WithElement(ins elements.Element) Builder
+	WithGoldenDirectory(goldenDirectory string) Builder
+	UpdateGoldens() Builder
 	Now() (Application, error)
 }
 
-// Application represents the interpreter application
+// Application represents the interpreter application, it is safe for concurrent use as long as the functions of its walker are
 type Application interface {
 	// Execute executes the parser
 	Execute(input []byte, grammar grammars.Grammar) (any, []byte, error)
 
 	// Suites executes all the test suites of the grammar
 	Suites(grammar grammars.Grammar) error
+
+	// RunSuites executes every test suite of every block of the grammar, without stopping on failures, and returns their results
+	RunSuites(grammar grammars.Grammar) (results.Results, error)
+}
+
+// TypedBuilder represents a typed application builder
+type TypedBuilder[T any] interface {
+	Create() TypedBuilder[T]
+	WithWalker(walker typings.Walker[T]) TypedBuilder[T]
+	WithGoldenDirectory(goldenDirectory string) TypedBuilder[T]
+	UpdateGoldens() TypedBuilder[T]
+	Now() (TypedApplication[T], error)
+}
+
+// TypedApplication represents an interpreter application whose walker returns T
+type TypedApplication[T any] interface {
+	// Execute executes the parser
+	Execute(input []byte, grammar grammars.Grammar) (T, []byte, error)
+
+	// Suites executes all the test suites of the grammar
+	Suites(grammar grammars.Grammar) error
+
+	// RunSuites executes every test suite of every block of the grammar, without stopping on failures, and returns their results
+	RunSuites(grammar grammars.Grammar) (results.Results, error)
 }
@@ -0,0 +1,50 @@
+package engine
+
+import (
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/results"
+	"github.com/steve-care-software/grammars/domain/engine/walkers/typings"
+)
+
+type typedApplication[T any] struct {
+	application Application
+	walker      typings.Walker[T]
+}
+
+func createTypedApplication[T any](
+	application Application,
+	walker typings.Walker[T],
+) TypedApplication[T] {
+	out := typedApplication[T]{
+		application: application,
+		walker:      walker,
+	}
+
+	return &out
+}
+
+// Execute executes the parser application
+func (app *typedApplication[T]) Execute(input []byte, grammar grammars.Grammar) (T, []byte, error) {
+	var zero T
+	retValue, retRemaining, err := app.application.Execute(input, grammar)
+	if err != nil {
+		return zero, nil, err
+	}
+
+	retOutput, err := app.walker.Cast(retValue)
+	if err != nil {
+		return zero, nil, err
+	}
+
+	return retOutput, retRemaining, nil
+}
+
+// Suites executes all the test suites of the grammar
+func (app *typedApplication[T]) Suites(grammar grammars.Grammar) error {
+	return app.application.Suites(grammar)
+}
+
+// RunSuites executes every test suite of every block of the grammar, without stopping on failures, and returns their results
+func (app *typedApplication[T]) RunSuites(grammar grammars.Grammar) (results.Results, error) {
+	return app.application.RunSuites(grammar)
+}
@@ -0,0 +1,177 @@
+package engine
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/walkers/typings"
+)
+
+type testAssignment struct {
+	Name    string
+	Letters []string
+	Number  int
+}
+
+func TestTypedApplication_execute_Success(t *testing.T) {
+	retGrammar, err := typedGrammar()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	toString := typings.Value(func(input []byte) (string, error) {
+		return string(input), nil
+	})
+
+	lettersField := typings.Select("letter", typedScript("letter[0]"), typings.Token(toString, func(list []string) ([]string, error) {
+		return list, nil
+	}))
+
+	nameField := typings.Select("name", typedScript("name[0][0]"), typings.Element(toString))
+	lettersOfNameField := typings.Select("name", typedScript("name[0][0]"), typings.Element(typings.Map(func(values typings.Values) ([]string, error) {
+		return lettersField.One(values)
+	}, lettersField)))
+
+	numberField := typings.Select("number", typedScript("number[0][0]"), typings.Element(typings.Value(func(input []byte) (int, error) {
+		return strconv.Atoi(string(input))
+	})))
+
+	walker := typings.Map(func(values typings.Values) (testAssignment, error) {
+		output := testAssignment{}
+		name, err := nameField.One(values)
+		if err != nil {
+			return output, err
+		}
+
+		number, err := numberField.One(values)
+		if err != nil {
+			return output, err
+		}
+
+		output.Name = name
+		output.Number = number
+		return output, nil
+	}, nameField, numberField)
+
+	application, err := NewTypedBuilder[testAssignment](
+		grammars.NewRepositoryMemory(map[string]grammars.Grammar{}),
+	).Create().WithWalker(walker).Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retAssignment, _, err := application.Execute([]byte("aba = 12;"), retGrammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if retAssignment.Name != "aba" || retAssignment.Number != 12 {
+		t.Errorf("the assignment was expected to be (aba, 12), (%s, %d) returned", retAssignment.Name, retAssignment.Number)
+		return
+	}
+
+	lettersWalker := typings.Map(func(values typings.Values) (testAssignment, error) {
+		letters, err := lettersOfNameField.One(values)
+		if err != nil {
+			return testAssignment{}, err
+		}
+
+		return testAssignment{
+			Letters: letters,
+		}, nil
+	}, lettersOfNameField)
+
+	lettersApplication, err := NewTypedBuilder[testAssignment](
+		grammars.NewRepositoryMemory(map[string]grammars.Grammar{}),
+	).Create().WithWalker(lettersWalker).Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retLetters, _, err := lettersApplication.Execute([]byte("ab = 1;"), retGrammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if strings.Join(retLetters.Letters, ",") != "a,b" {
+		t.Errorf("the letters were expected to be (a,b), (%s) returned", strings.Join(retLetters.Letters, ","))
+		return
+	}
+}
+
+func TestTypedApplication_withMismatchedNode_returnsError(t *testing.T) {
+	toString := typings.Value(func(input []byte) (string, error) {
+		return string(input), nil
+	})
+
+	// the script selects a token, but the node parses an element:
+	nameField := typings.Select("name", typedScript("name[0]"), typings.Element(toString))
+	walker := typings.Map(func(values typings.Values) (string, error) {
+		return nameField.One(values)
+	}, nameField)
+
+	_, err := NewTypedBuilder[string](
+		grammars.NewRepositoryMemory(map[string]grammars.Grammar{}),
+	).Create().WithWalker(walker).Now()
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func typedScript(chain string) []byte {
+	return []byte(`
+		v1;
+		name: mySelector;
+		` + chain + `;
+	`)
+}
+
+func typedGrammar() (grammars.Grammar, error) {
+	retGrammar, _, err := grammars.NewAdapter().ToGrammar([]byte(`
+		v1;
+		> .assignment;
+		# .SPACE;
+
+		assignment: .name .EQUAL .number .SEMICOLON
+					;
+
+		name: .letter+
+			;
+
+		letter: .LL_A
+				| .LL_B
+				;
+
+		number: .digit+
+				;
+
+		digit: .N_ONE
+			| .N_TWO
+			;
+
+		EQUAL: "=";
+		SEMICOLON: ";";
+		LL_A: "a";
+		LL_B: "b";
+		N_ONE: "1";
+		N_TWO: "2";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return retGrammar, nil
+}
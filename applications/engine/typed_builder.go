@@ -0,0 +1,82 @@
+package engine
+
+import (
+	"errors"
+
+	"github.com/steve-care-software/grammars/domain/engine/walkers/typings"
+)
+
+type typedBuilder[T any] struct {
+	builder         Builder
+	walker          typings.Walker[T]
+	goldenDirectory string
+	isGoldenUpdate  bool
+}
+
+func createTypedBuilder[T any](
+	builder Builder,
+) TypedBuilder[T] {
+	out := typedBuilder[T]{
+		builder:         builder,
+		walker:          nil,
+		goldenDirectory: "",
+		isGoldenUpdate:  false,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *typedBuilder[T]) Create() TypedBuilder[T] {
+	return createTypedBuilder[T](
+		app.builder,
+	)
+}
+
+// WithWalker adds a walker to the builder
+func (app *typedBuilder[T]) WithWalker(walker typings.Walker[T]) TypedBuilder[T] {
+	app.walker = walker
+	return app
+}
+
+// WithGoldenDirectory adds a golden directory to the builder, used to resolve the relative golden file paths of the suites
+func (app *typedBuilder[T]) WithGoldenDirectory(goldenDirectory string) TypedBuilder[T] {
+	app.goldenDirectory = goldenDirectory
+	return app
+}
+
+// UpdateGoldens flags the builder so that the suites rewrite their golden files instead of comparing them
+func (app *typedBuilder[T]) UpdateGoldens() TypedBuilder[T] {
+	app.isGoldenUpdate = true
+	return app
+}
+
+// Now builds a new TypedApplication instance
+func (app *typedBuilder[T]) Now() (TypedApplication[T], error) {
+	if app.walker == nil {
+		return nil, errors.New("the walker is mandatory in order to build a TypedApplication instance")
+	}
+
+	element, err := app.walker.Element()
+	if err != nil {
+		return nil, err
+	}
+
+	builder := app.builder.Create().
+		WithElement(element).
+		WithGoldenDirectory(app.goldenDirectory)
+
+	if app.isGoldenUpdate {
+		builder.UpdateGoldens()
+	}
+
+	application, err := builder.Now()
+	if err != nil {
+		return nil, err
+	}
+
+	return createTypedApplication(
+		application,
+		app.walker,
+	), nil
+}
@@ -0,0 +1,69 @@
+package watchers
+
+import "github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+
+type event struct {
+	file      string
+	path      []string
+	version   versions.Version
+	err       error
+	isRemoved bool
+}
+
+func createEvent(
+	file string,
+	path []string,
+	version versions.Version,
+	err error,
+	isRemoved bool,
+) Event {
+	out := event{
+		file:      file,
+		path:      path,
+		version:   version,
+		err:       err,
+		isRemoved: isRemoved,
+	}
+
+	return &out
+}
+
+// File returns the file, relative to the root directory
+func (obj *event) File() string {
+	return obj.file
+}
+
+// HasPath returns true if there is a path, false otherwise
+func (obj *event) HasPath() bool {
+	return obj.path != nil
+}
+
+// Path returns the grammar path, if any
+func (obj *event) Path() []string {
+	return obj.path
+}
+
+// HasVersion returns true if there is a version, false otherwise
+func (obj *event) HasVersion() bool {
+	return obj.version != nil
+}
+
+// Version returns the grammar version, if any
+func (obj *event) Version() versions.Version {
+	return obj.version
+}
+
+// IsRemoved returns true if the grammar file was removed, false otherwise
+func (obj *event) IsRemoved() bool {
+	return obj.isRemoved
+}
+
+// IsSuccess returns true if the grammar was reloaded, false otherwise
+func (obj *event) IsSuccess() bool {
+	return obj.err == nil
+}
+
+// Error returns the reason of the reload failure, if any
+func (obj *event) Error() error {
+	return obj.err
+}
@@ -0,0 +1,85 @@
+package watchers
+
+import (
+	"errors"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+)
+
+type eventBuilder struct {
+	file      string
+	path      []string
+	version   versions.Version
+	err       error
+	isRemoved bool
+}
+
+func createEventBuilder() EventBuilder {
+	out := eventBuilder{
+		file:      "",
+		path:      nil,
+		version:   nil,
+		err:       nil,
+		isRemoved: false,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *eventBuilder) Create() EventBuilder {
+	return createEventBuilder()
+}
+
+// WithFile adds a file to the builder
+func (app *eventBuilder) WithFile(file string) EventBuilder {
+	app.file = file
+	return app
+}
+
+// WithPath adds a path to the builder
+func (app *eventBuilder) WithPath(path []string) EventBuilder {
+	app.path = path
+	return app
+}
+
+// WithVersion adds a version to the builder
+func (app *eventBuilder) WithVersion(version versions.Version) EventBuilder {
+	app.version = version
+	return app
+}
+
+// WithError adds an error to the builder
+func (app *eventBuilder) WithError(err error) EventBuilder {
+	app.err = err
+	return app
+}
+
+// IsRemoved flags the builder as removed
+func (app *eventBuilder) IsRemoved() EventBuilder {
+	app.isRemoved = true
+	return app
+}
+
+// Now builds a new Event instance
+func (app *eventBuilder) Now() (Event, error) {
+	if app.file == "" {
+		return nil, errors.New("the file is mandatory in order to build an Event instance")
+	}
+
+	if app.path != nil && len(app.path) <= 0 {
+		app.path = nil
+	}
+
+	if app.isRemoved && app.err != nil {
+		return nil, errors.New("a removed Event instance cannot contain an error")
+	}
+
+	return createEvent(
+		app.file,
+		app.path,
+		app.version,
+		app.err,
+		app.isRemoved,
+	), nil
+}
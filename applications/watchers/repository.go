@@ -0,0 +1,48 @@
+package watchers
+
+import (
+	"errors"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements/references"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+)
+
+type repository struct {
+	watcher *watcher
+}
+
+func createRepository(
+	watcher *watcher,
+) grammars.Repository {
+	out := repository{
+		watcher: watcher,
+	}
+
+	return &out
+}
+
+// Init returns an error, the repository of a watcher is read-only
+func (app *repository) Init() error {
+	return errors.New("the repository of a watcher is read-only, it cannot be initialized")
+}
+
+// List lists the grammar paths and their sorted versions that are currently loaded
+func (app *repository) List() (map[string][]versions.Version, error) {
+	return app.watcher.current.Load().repository.List()
+}
+
+// Insert returns an error, the repository of a watcher is read-only
+func (app *repository) Insert(path []string, grammar grammars.Grammar) error {
+	return errors.New("the repository of a watcher is read-only, the grammars must be written in its directory")
+}
+
+// Retrieve retrieves a grammar that is currently loaded
+func (app *repository) Retrieve(reference references.Reference) (grammars.Grammar, error) {
+	return app.watcher.current.Load().repository.Retrieve(reference)
+}
+
+// Delete returns an error, the repository of a watcher is read-only
+func (app *repository) Delete(reference references.Reference) error {
+	return errors.New("the repository of a watcher is read-only, the grammars must be removed from its directory")
+}
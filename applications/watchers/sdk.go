@@ -0,0 +1,74 @@
+package watchers
+
+import (
+	"time"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+)
+
+const pathSeparator = "/"
+
+// NewWatcher creates a new watcher over the grammar files of the root directory, laid out as the file-system repository stores them
+func NewWatcher(
+	rootDirectory string,
+) Watcher {
+	grammarAdapter := grammars.NewAdapter()
+	eventBuilder := NewEventBuilder()
+	return createWatcher(
+		grammarAdapter,
+		eventBuilder,
+		rootDirectory,
+	)
+}
+
+// NewEventBuilder creates a new event builder
+func NewEventBuilder() EventBuilder {
+	return createEventBuilder()
+}
+
+// SubscriberFn receives the events of a watcher
+type SubscriberFn func(event Event)
+
+// Watcher watches a grammar directory and reloads the grammars whose file changed, it is safe for concurrent use
+type Watcher interface {
+	// Repository returns a read-only repository that always serves the latest grammars that passed validation
+	Repository() grammars.Repository
+
+	// Subscribe registers a function notified of every reload success or failure
+	Subscribe(fn SubscriberFn)
+
+	// Poll scans the directory once: the changed grammars are parsed, their references resolved and their suites executed,
+	// then they are swapped in atomically if everything passes. The failed grammars stay pending until they
+	// pass, and the live grammars that depend on a changed or removed grammar are validated again, then evicted if they fail
+	Poll() error
+
+	// Start polls the directory at every interval until Stop is called
+	Start(interval time.Duration) error
+
+	// Stop stops polling the directory
+	Stop()
+}
+
+// EventBuilder represents the event builder
+type EventBuilder interface {
+	Create() EventBuilder
+	WithFile(file string) EventBuilder
+	WithPath(path []string) EventBuilder
+	WithVersion(version versions.Version) EventBuilder
+	WithError(err error) EventBuilder
+	IsRemoved() EventBuilder
+	Now() (Event, error)
+}
+
+// Event represents the reload of a grammar file
+type Event interface {
+	File() string
+	HasPath() bool
+	Path() []string
+	HasVersion() bool
+	Version() versions.Version
+	IsRemoved() bool
+	IsSuccess() bool
+	Error() error
+}
@@ -0,0 +1,519 @@
+package watchers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/steve-care-software/grammars/applications/engine"
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+	"github.com/steve-care-software/grammars/domain/engine/resolvers"
+)
+
+type liveGrammar struct {
+	path    []string
+	grammar grammars.Grammar
+}
+
+type snapshot struct {
+	grammars   map[string]liveGrammar
+	repository grammars.Repository
+}
+
+type candidate struct {
+	file    string
+	hash    [sha256.Size]byte
+	path    []string
+	version versions.Version
+	grammar grammars.Grammar
+	err     error
+}
+
+type watcher struct {
+	pollMutex      sync.Mutex
+	subscribeMutex sync.RWMutex
+	startMutex     sync.Mutex
+	grammarAdapter grammars.Adapter
+	eventBuilder   EventBuilder
+	rootDirectory  string
+	current        atomic.Pointer[snapshot]
+	hashes         map[string][sha256.Size]byte
+	failures       map[string][sha256.Size]byte
+	subscribers    []SubscriberFn
+	stop           chan struct{}
+	done           sync.WaitGroup
+}
+
+func createWatcher(
+	grammarAdapter grammars.Adapter,
+	eventBuilder EventBuilder,
+	rootDirectory string,
+) Watcher {
+	out := watcher{
+		grammarAdapter: grammarAdapter,
+		eventBuilder:   eventBuilder,
+		rootDirectory:  rootDirectory,
+		hashes:         map[string][sha256.Size]byte{},
+		failures:       map[string][sha256.Size]byte{},
+		subscribers:    []SubscriberFn{},
+		stop:           nil,
+	}
+
+	out.current.Store(&snapshot{
+		grammars:   map[string]liveGrammar{},
+		repository: grammars.NewRepositoryMemory(map[string]grammars.Grammar{}),
+	})
+
+	return &out
+}
+
+// Repository returns a read-only repository that always serves the latest grammars that passed validation
+func (app *watcher) Repository() grammars.Repository {
+	return createRepository(app)
+}
+
+// Subscribe registers a function notified of every reload success or failure
+func (app *watcher) Subscribe(fn SubscriberFn) {
+	app.subscribeMutex.Lock()
+	defer app.subscribeMutex.Unlock()
+	app.subscribers = append(app.subscribers, fn)
+}
+
+// Poll scans the directory once and swaps in the changed grammars that pass validation
+func (app *watcher) Poll() error {
+	app.pollMutex.Lock()
+	defer app.pollMutex.Unlock()
+	files, err := app.scan()
+	if err != nil {
+		return err
+	}
+
+	current := app.current.Load()
+	next := map[string]liveGrammar{}
+	for file, oneGrammar := range current.grammars {
+		next[file] = oneGrammar
+	}
+
+	events := []Event{}
+	changed := map[string]bool{}
+	removed := []string{}
+	for file := range app.hashes {
+		if _, ok := files[file]; !ok {
+			removed = append(removed, file)
+		}
+	}
+
+	for file := range app.failures {
+		if _, ok := files[file]; !ok {
+			removed = append(removed, file)
+		}
+	}
+
+	sort.Strings(removed)
+	for _, oneFile := range removed {
+		delete(app.hashes, oneFile)
+		delete(app.failures, oneFile)
+		builder := app.eventBuilder.Create().
+			WithFile(oneFile).
+			IsRemoved()
+
+		if live, ok := next[oneFile]; ok {
+			builder.WithPath(live.path).WithVersion(live.grammar.Version())
+			delete(next, oneFile)
+			changed[strings.Join(live.path, pathSeparator)] = true
+		}
+
+		event, err := builder.Now()
+		if err != nil {
+			return err
+		}
+
+		events = append(events, event)
+	}
+
+	// the failed files are not hashed, so they stay pending and are validated again at every poll:
+	candidates := []*candidate{}
+	for file, content := range files {
+		hash := sha256.Sum256(content)
+		if previous, ok := app.hashes[file]; ok && previous == hash {
+			continue
+		}
+
+		candidates = append(candidates, app.parse(file, hash, content))
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].file < candidates[j].file
+	})
+
+	// a grammar can reference another changed grammar, so the candidates are validated until no more of them pass:
+	pending := candidates
+	for {
+		remaining := []*candidate{}
+		for _, oneCandidate := range pending {
+			if oneCandidate.grammar == nil {
+				continue
+			}
+
+			oneCandidate.err = app.validate(oneCandidate, next)
+			if oneCandidate.err != nil {
+				remaining = append(remaining, oneCandidate)
+				continue
+			}
+
+			next[oneCandidate.file] = liveGrammar{
+				path:    oneCandidate.path,
+				grammar: oneCandidate.grammar,
+			}
+
+			changed[strings.Join(oneCandidate.path, pathSeparator)] = true
+		}
+
+		if len(remaining) == len(pending) {
+			break
+		}
+
+		pending = remaining
+	}
+
+	evicted, err := app.revalidate(current, next, changed, candidates, files)
+	if err != nil {
+		return err
+	}
+
+	for _, oneCandidate := range append(candidates, evicted...) {
+		if oneCandidate.err == nil {
+			app.hashes[oneCandidate.file] = oneCandidate.hash
+			delete(app.failures, oneCandidate.file)
+		} else {
+			// a failure is only notified again once the content of its file changes:
+			if previous, ok := app.failures[oneCandidate.file]; ok && previous == oneCandidate.hash {
+				continue
+			}
+
+			app.failures[oneCandidate.file] = oneCandidate.hash
+		}
+
+		builder := app.eventBuilder.Create().
+			WithFile(oneCandidate.file).
+			WithPath(oneCandidate.path)
+
+		if oneCandidate.version != nil {
+			builder.WithVersion(oneCandidate.version)
+		}
+
+		if oneCandidate.err != nil {
+			builder.WithError(oneCandidate.err)
+		}
+
+		event, err := builder.Now()
+		if err != nil {
+			return err
+		}
+
+		events = append(events, event)
+	}
+
+	if len(changed) > 0 {
+		retSnapshot, err := createSnapshot(next)
+		if err != nil {
+			return err
+		}
+
+		app.current.Store(retSnapshot)
+	}
+
+	app.notify(events)
+	return nil
+}
+
+// Start polls the directory at every interval until Stop is called
+func (app *watcher) Start(interval time.Duration) error {
+	app.startMutex.Lock()
+	defer app.startMutex.Unlock()
+	if app.stop != nil {
+		return errors.New("the watcher has already been started")
+	}
+
+	if interval <= 0 {
+		return errors.New("the interval must be greater than zero in order to start the watcher")
+	}
+
+	stop := make(chan struct{})
+	app.stop = stop
+	app.done.Add(1)
+	go func() {
+		defer app.done.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			err := app.Poll()
+			if err != nil {
+				event, _ := app.eventBuilder.Create().
+					WithFile(app.rootDirectory).
+					WithError(err).
+					Now()
+
+				app.notify([]Event{event})
+			}
+
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Stop stops polling the directory
+func (app *watcher) Stop() {
+	app.startMutex.Lock()
+	defer app.startMutex.Unlock()
+	if app.stop == nil {
+		return
+	}
+
+	close(app.stop)
+	app.done.Wait()
+	app.stop = nil
+}
+
+func (app *watcher) parse(file string, hash [sha256.Size]byte, content []byte) *candidate {
+	output := candidate{
+		file: file,
+		hash: hash,
+	}
+
+	segments := strings.Split(file, pathSeparator)
+	name := segments[len(segments)-1]
+	output.path = segments[:len(segments)-1]
+	version, err := grammars.FileNameToVersion(name)
+	if err != nil {
+		str := fmt.Sprintf("the file name does not contain a valid version: %s", err.Error())
+		output.err = errors.New(str)
+		return &output
+	}
+
+	output.version = version
+	grammar, remaining, err := app.grammarAdapter.ToGrammar(content)
+	if err != nil {
+		str := fmt.Sprintf("the grammar could not be parsed: %s", err.Error())
+		output.err = errors.New(str)
+		return &output
+	}
+
+	if len(bytes.TrimSpace(remaining)) > 0 {
+		output.err = errors.New("the grammar was expected to contain no remaining data after its definition")
+		return &output
+	}
+
+	if grammar.Version().Compare(version) != 0 {
+		str := fmt.Sprintf("the grammar was expected to declare the version (%s) of its file name, %s declared", version.String(), grammar.Version().String())
+		output.err = errors.New(str)
+		return &output
+	}
+
+	output.grammar = grammar
+	return &output
+}
+
+func (app *watcher) validate(candidateIns *candidate, live map[string]liveGrammar) error {
+	next := map[string]liveGrammar{}
+	for file, oneGrammar := range live {
+		next[file] = oneGrammar
+	}
+
+	next[candidateIns.file] = liveGrammar{
+		path:    candidateIns.path,
+		grammar: candidateIns.grammar,
+	}
+
+	retSnapshot, err := createSnapshot(next)
+	if err != nil {
+		return err
+	}
+
+	// the references are resolved even if the grammar has no suite to exercise them:
+	_, err = resolvers.NewResolver(retSnapshot.repository).Resolve(candidateIns.grammar)
+	if err != nil {
+		str := fmt.Sprintf("the references of the grammar could not be resolved: %s", err.Error())
+		return errors.New(str)
+	}
+
+	application, err := engine.NewBuilder(retSnapshot.repository).Create().Now()
+	if err != nil {
+		return err
+	}
+
+	err = application.Suites(candidateIns.grammar)
+	if err != nil {
+		str := fmt.Sprintf("the suites of the grammar failed: %s", err.Error())
+		return errors.New(str)
+	}
+
+	return nil
+}
+
+// revalidate validates again the live grammars that depend on a changed path, the ones that fail are evicted
+// from the next snapshot, and returned when they were not candidates of the poll
+func (app *watcher) revalidate(
+	current *snapshot,
+	next map[string]liveGrammar,
+	changed map[string]bool,
+	candidates []*candidate,
+	files map[string][]byte,
+) ([]*candidate, error) {
+	byFile := map[string]*candidate{}
+	for _, oneCandidate := range candidates {
+		byFile[oneCandidate.file] = oneCandidate
+	}
+
+	evicted := []*candidate{}
+	resolver := resolvers.NewResolver(current.repository)
+	for {
+		liveFiles := []string{}
+		for file := range next {
+			liveFiles = append(liveFiles, file)
+		}
+
+		sort.Strings(liveFiles)
+		isEvicted := false
+		for _, oneFile := range liveFiles {
+			live := next[oneFile]
+			if !dependsOn(resolver, live.grammar, changed) {
+				continue
+			}
+
+			err := app.validate(&candidate{
+				file:    oneFile,
+				path:    live.path,
+				grammar: live.grammar,
+			}, next)
+
+			if err == nil {
+				continue
+			}
+
+			str := fmt.Sprintf("the grammar no longer passes validation once its dependencies changed: %s", err.Error())
+			delete(next, oneFile)
+			delete(app.hashes, oneFile)
+			changed[strings.Join(live.path, pathSeparator)] = true
+			isEvicted = true
+			if oneCandidate, ok := byFile[oneFile]; ok {
+				oneCandidate.err = errors.New(str)
+				continue
+			}
+
+			evicted = append(evicted, &candidate{
+				file:    oneFile,
+				hash:    sha256.Sum256(files[oneFile]),
+				path:    live.path,
+				version: live.grammar.Version(),
+				err:     errors.New(str),
+			})
+		}
+
+		if !isEvicted {
+			return evicted, nil
+		}
+	}
+}
+
+func (app *watcher) scan() (map[string][]byte, error) {
+	output := map[string][]byte{}
+	err := filepath.WalkDir(app.rootDirectory, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		name := entry.Name()
+		if entry.IsDir() || !grammars.IsFileName(name) {
+			return nil
+		}
+
+		relative, err := filepath.Rel(app.rootDirectory, path)
+		if err != nil {
+			return err
+		}
+
+		// a grammar file must be inside a directory, that is its path:
+		if filepath.Dir(relative) == "." {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			// the file was removed between the walk and the read, the next poll will notice it:
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+
+			return err
+		}
+
+		output[filepath.ToSlash(relative)] = content
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (app *watcher) notify(events []Event) {
+	app.subscribeMutex.RLock()
+	subscribers := append([]SubscriberFn{}, app.subscribers...)
+	app.subscribeMutex.RUnlock()
+	for _, oneEvent := range events {
+		for _, oneSubscriber := range subscribers {
+			oneSubscriber(oneEvent)
+		}
+	}
+}
+
+func createSnapshot(live map[string]liveGrammar) (*snapshot, error) {
+	repository := grammars.NewRepositoryMemory(map[string]grammars.Grammar{})
+	for _, oneGrammar := range live {
+		err := repository.Insert(oneGrammar.path, oneGrammar.grammar)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &snapshot{
+		grammars:   live,
+		repository: repository,
+	}, nil
+}
+
+// dependsOn returns true if one of the grammars the grammar depends on, directly or not, is at a changed path
+func dependsOn(resolver resolvers.Resolver, grammar grammars.Grammar, changed map[string]bool) bool {
+	lock, err := resolver.Resolve(grammar)
+	if err != nil {
+		// the dependencies can not be resolved in the previous snapshot, so the grammar is validated again:
+		return true
+	}
+
+	for _, oneDependency := range lock.Dependencies() {
+		if changed[strings.Join(oneDependency.Path(), pathSeparator)] {
+			return true
+		}
+	}
+
+	return false
+}
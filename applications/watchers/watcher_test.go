@@ -0,0 +1,467 @@
+package watchers
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements/references"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+)
+
+const validGrammar = `
+	v1;
+	> .assignment;
+	# .SPACE;
+
+	assignment: .VARIABLE .EQUAL .N_ZERO
+				---
+				valid: "myVariable = 0";
+				;
+
+	VARIABLE: "myVariable";
+	EQUAL: "=";
+	N_ZERO: "0";
+	SPACE: " ";
+`
+
+func TestWatcher_Success(t *testing.T) {
+	rootDirectory := t.TempDir()
+	grammar, _, err := grammars.NewAdapter().ToGrammar([]byte(validGrammar))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = grammars.NewRepositoryFile(rootDirectory).Insert([]string{"languages", "assignments"}, grammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	events := []Event{}
+	watcher := NewWatcher(rootDirectory)
+	watcher.Subscribe(func(event Event) {
+		events = append(events, event)
+	})
+
+	err = watcher.Poll()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(events) != 1 || !events[0].IsSuccess() || events[0].File() != "languages/assignments/v1.0.0.grammar" {
+		t.Errorf("the watcher was expected to notify the successful load of the grammar")
+		return
+	}
+
+	reference, err := reference()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	repository := watcher.Repository()
+	retGrammar, err := repository.Retrieve(reference)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// an unchanged directory does not notify anything:
+	err = watcher.Poll()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(events) != 1 {
+		t.Errorf("the watcher was not expected to notify unchanged files")
+		return
+	}
+
+	// a grammar whose suite fails is not swapped in:
+	file := filepath.Join(rootDirectory, "languages", "assignments", "v1.0.0.grammar")
+	err = os.WriteFile(file, []byte(strings.Replace(validGrammar, `valid: "myVariable = 0"`, `valid: "myVariable = 1"`, 1)), os.ModePerm)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = watcher.Poll()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(events) != 2 || events[1].IsSuccess() {
+		t.Errorf("the watcher was expected to notify the failed reload of the grammar")
+		return
+	}
+
+	failedGrammar, err := repository.Retrieve(reference)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if failedGrammar != retGrammar {
+		t.Errorf("the repository was expected to keep serving the previous grammar")
+		return
+	}
+
+	// a grammar that passes its suites is swapped in:
+	err = os.WriteFile(file, []byte(strings.Replace(validGrammar, `N_ZERO: "0";`, `N_ZERO: "0"; N_ONE: "1";`, 1)), os.ModePerm)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = watcher.Poll()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(events) != 3 || !events[2].IsSuccess() {
+		t.Errorf("the watcher was expected to notify the successful reload of the grammar")
+		return
+	}
+
+	reloadedGrammar, err := repository.Retrieve(reference)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if reloadedGrammar == retGrammar {
+		t.Errorf("the repository was expected to serve the reloaded grammar")
+		return
+	}
+
+	// a removed grammar is no longer served:
+	err = os.Remove(file)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = watcher.Poll()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(events) != 4 || !events[3].IsRemoved() {
+		t.Errorf("the watcher was expected to notify the removal of the grammar")
+		return
+	}
+
+	_, err = repository.Retrieve(reference)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestWatcher_withDependencies_Success(t *testing.T) {
+	rootDirectory := t.TempDir()
+	directory := filepath.Join(rootDirectory, "languages", "programs")
+	err := os.MkdirAll(directory, os.ModePerm)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = os.WriteFile(filepath.Join(directory, "v1.0.0.grammar"), []byte(`
+		v1;
+		> .program;
+		# .SPACE;
+
+		program: .assignment[languages/assignments, ^1] .SEMICOLON
+				---
+				valid: "myVariable = 0;";
+				;
+
+		SEMICOLON: ";";
+		SPACE: " ";
+	`), os.ModePerm)
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	events := []Event{}
+	watcher := NewWatcher(rootDirectory)
+	watcher.Subscribe(func(event Event) {
+		events = append(events, event)
+	})
+
+	// the grammar fails while its dependency is missing, and its failure is only notified once:
+	for i := 0; i < 2; i++ {
+		err = watcher.Poll()
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+	}
+
+	if len(events) != 1 || events[0].IsSuccess() {
+		t.Errorf("the watcher was expected to notify the failed load of the grammar once, %d events notified", len(events))
+		return
+	}
+
+	// the failed grammar stays pending, so it is loaded along with its dependency:
+	grammar, _, err := grammars.NewAdapter().ToGrammar([]byte(validGrammar))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = grammars.NewRepositoryFile(rootDirectory).Insert([]string{"languages", "assignments"}, grammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = watcher.Poll()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(events) != 3 || !events[1].IsSuccess() || !events[2].IsSuccess() {
+		t.Errorf("the watcher was expected to notify the successful load of both grammars")
+		return
+	}
+
+	constraint, err := versions.NewAdapter().ToConstraint([]byte("1.0.0"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	programReference, err := references.NewBuilder().Create().
+		WithPath([]string{"languages", "programs"}).
+		WithName("program").
+		WithConstraint(constraint).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = watcher.Repository().Retrieve(programReference)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// removing the dependency evicts the grammar that depends on it:
+	err = os.Remove(filepath.Join(rootDirectory, "languages", "assignments", "v1.0.0.grammar"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = watcher.Poll()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(events) != 5 || !events[3].IsRemoved() || events[4].IsSuccess() || events[4].File() != "languages/programs/v1.0.0.grammar" {
+		t.Errorf("the watcher was expected to notify the removal of the dependency and the failure of its dependent")
+		return
+	}
+
+	_, err = watcher.Repository().Retrieve(programReference)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestWatcher_withMissingReference_returnsFailure(t *testing.T) {
+	rootDirectory := t.TempDir()
+	directory := filepath.Join(rootDirectory, "languages", "programs")
+	err := os.MkdirAll(directory, os.ModePerm)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// the grammar has no suite, so only the resolution of its references can reject it:
+	err = os.WriteFile(filepath.Join(directory, "v1.0.0.grammar"), []byte(`
+		v1;
+		> .program;
+		# .SPACE;
+
+		program: .assignment[languages/assignments, ^1] .SEMICOLON
+				;
+
+		SEMICOLON: ";";
+		SPACE: " ";
+	`), os.ModePerm)
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	events := []Event{}
+	watcher := NewWatcher(rootDirectory)
+	watcher.Subscribe(func(event Event) {
+		events = append(events, event)
+	})
+
+	err = watcher.Poll()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(events) != 1 || events[0].IsSuccess() {
+		t.Errorf("the watcher was expected to notify the failed load of the grammar")
+		return
+	}
+
+	list, err := watcher.Repository().List()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(list) != 0 {
+		t.Errorf("the repository was expected to contain no grammar, %d paths returned", len(list))
+		return
+	}
+
+	// the grammar stays pending, so it is loaded once its reference resolves:
+	grammar, _, err := grammars.NewAdapter().ToGrammar([]byte(validGrammar))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = grammars.NewRepositoryFile(rootDirectory).Insert([]string{"languages", "assignments"}, grammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = watcher.Poll()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(events) != 3 || !events[1].IsSuccess() || !events[2].IsSuccess() {
+		t.Errorf("the watcher was expected to notify the successful load of both grammars")
+		return
+	}
+}
+
+func TestWatcher_withVersionMismatch_returnsFailure(t *testing.T) {
+	rootDirectory := t.TempDir()
+	directory := filepath.Join(rootDirectory, "languages", "assignments")
+	err := os.MkdirAll(directory, os.ModePerm)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = os.WriteFile(filepath.Join(directory, "v2.0.0.grammar"), []byte(validGrammar), os.ModePerm)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	events := []Event{}
+	watcher := NewWatcher(rootDirectory)
+	watcher.Subscribe(func(event Event) {
+		events = append(events, event)
+	})
+
+	err = watcher.Poll()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(events) != 1 || events[0].IsSuccess() {
+		t.Errorf("the watcher was expected to notify the failed load of the grammar")
+		return
+	}
+}
+
+func TestWatcher_start_Success(t *testing.T) {
+	rootDirectory := t.TempDir()
+	waitGroup := sync.WaitGroup{}
+	waitGroup.Add(1)
+	once := sync.Once{}
+	watcher := NewWatcher(rootDirectory)
+	watcher.Subscribe(func(event Event) {
+		if event.IsSuccess() {
+			once.Do(waitGroup.Done)
+		}
+	})
+
+	err := watcher.Start(time.Millisecond)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	defer watcher.Stop()
+	err = watcher.Start(time.Millisecond)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	grammar, _, err := grammars.NewAdapter().ToGrammar([]byte(validGrammar))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = grammars.NewRepositoryFile(rootDirectory).Insert([]string{"languages", "assignments"}, grammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	waitGroup.Wait()
+	reference, err := reference()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = watcher.Repository().Retrieve(reference)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+}
+
+func reference() (references.Reference, error) {
+	constraint, err := versions.NewAdapter().ToConstraint([]byte("latest"))
+	if err != nil {
+		return nil, err
+	}
+
+	return references.NewBuilder().Create().
+		WithPath([]string{"languages", "assignments"}).
+		WithName("assignment").
+		WithConstraint(constraint).
+		Now()
+}
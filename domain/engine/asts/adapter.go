@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/steve-care-software/grammars/domain/engine/coverages"
 	"github.com/steve-care-software/grammars/domain/engine/grammars"
 	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks"
 	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines"
@@ -23,6 +24,10 @@ type adapter struct {
 	elementsBuilder    ElementsBuilder
 	elementBuilder     ElementBuilder
 	constantBuilder    ConstantBuilder
+	spanBuilder        SpanBuilder
+	triviaBuilder      TriviaBuilder
+	coverage           coverages.Collector
+	isLossless         bool
 }
 
 func createAdapter(
@@ -35,6 +40,10 @@ func createAdapter(
 	elementsBuilder ElementsBuilder,
 	elementBuilder ElementBuilder,
 	constantBuilder ConstantBuilder,
+	spanBuilder SpanBuilder,
+	triviaBuilder TriviaBuilder,
+	coverage coverages.Collector,
+	isLossless bool,
 ) Adapter {
 	out := adapter{
 		grammarRepository:  grammarRepository,
@@ -46,6 +55,10 @@ func createAdapter(
 		elementsBuilder:    elementsBuilder,
 		elementBuilder:     elementBuilder,
 		constantBuilder:    constantBuilder,
+		spanBuilder:        spanBuilder,
+		triviaBuilder:      triviaBuilder,
+		coverage:           coverage,
+		isLossless:         isLossless,
 	}
 
 	return &out
@@ -53,14 +66,28 @@ func createAdapter(
 
 // ToAST takes the grammar and input and converts them to a ast instance and the remaining data
 func (app *adapter) ToAST(grammar grammars.Grammar, input []byte) (AST, []byte, error) {
+	resolved := map[AST]grammars.Grammar{}
+	ast, retRemaining, err := app.toAST(grammar, input, resolved, input)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	app.cover(grammar, resolved, ast.Root())
+	return ast, retRemaining, nil
+}
+
+// toAST converts the input using the root of the grammar, the spans of its elements are offsets in the origin and the
+// grammars of its embedded ASTs are added to the resolved map
+func (app *adapter) toAST(grammar grammars.Grammar, origin []byte, resolved map[AST]grammars.Grammar, input []byte) (AST, []byte, error) {
 	root := grammar.Root()
-	retElement, retRemaining, err := app.toElement(grammar, map[string]map[int][]byte{}, root, input, true)
+	retElement, retRemaining, err := app.toElement(grammar, origin, map[string]map[int][]byte{}, resolved, root, input, true)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	ast, err := app.builder.Create().
 		WithRoot(retElement).
+		WithVersion(grammar.Version()).
 		Now()
 
 	if err != nil {
@@ -77,9 +104,12 @@ func (app *adapter) ToASTWithRoot(grammar grammars.Grammar, rootBlockName string
 		return nil, nil, err
 	}
 
+	resolved := map[AST]grammars.Grammar{}
 	retInstruction, retInstructionRemaining, err := app.toInstruction(
 		grammar,
+		input,
 		map[string]map[int][]byte{},
+		resolved,
 		rootBlock,
 		input,
 		true,
@@ -89,8 +119,19 @@ func (app *adapter) ToASTWithRoot(grammar grammars.Grammar, rootBlockName string
 		return nil, nil, err
 	}
 
+	start := app.offset(input, app.filterOmissions(grammar, input))
+	span, err := app.spanBuilder.Create().
+		WithStart(start).
+		WithEnd(app.instructionEnd(retInstruction, start)).
+		Now()
+
+	if err != nil {
+		return nil, nil, err
+	}
+
 	element, err := app.elementBuilder.Create().
 		WithInstruction(retInstruction).
+		WithSpan(span).
 		Now()
 
 	if err != nil {
@@ -99,18 +140,24 @@ func (app *adapter) ToASTWithRoot(grammar grammars.Grammar, rootBlockName string
 
 	ast, err := app.builder.Create().
 		WithRoot(element).
+		WithVersion(grammar.Version()).
 		Now()
 
 	if err != nil {
 		return nil, nil, err
 	}
 
+	app.cover(grammar, resolved, element)
 	return ast, retInstructionRemaining, nil
 }
 
+// toInstruction converts the input using the first matching line of the block, the parentValues contain the input
+// currently parsed by every line of every block in order to stop left recursions, they are created on every call
 func (app *adapter) toInstruction(
 	grammar grammars.Grammar,
+	origin []byte,
 	parentValues map[string]map[int][]byte,
+	resolved map[AST]grammars.Grammar,
 	block blocks.Block,
 	input []byte,
 	filterForOmission bool,
@@ -131,7 +178,9 @@ func (app *adapter) toInstruction(
 		parentValues[name][idx] = input
 		retTokens, retRemaining, err := app.toTokens(
 			grammar,
+			origin,
 			parentValues,
+			resolved,
 			oneLine,
 			input,
 			filterForOmission,
@@ -175,7 +224,9 @@ func (app *adapter) toInstruction(
 
 func (app *adapter) toTokens(
 	grammar grammars.Grammar,
+	origin []byte,
 	parentValues map[string]map[int][]byte,
+	resolved map[AST]grammars.Grammar,
 	line lines.Line,
 	input []byte,
 	filterForOmission bool,
@@ -187,7 +238,9 @@ func (app *adapter) toTokens(
 		name := oneToken.Name()
 		retToken, retRemaining, err := app.toToken(
 			grammar,
+			origin,
 			parentValues,
+			resolved,
 			oneToken,
 			remaining,
 			filterForOmission,
@@ -216,7 +269,9 @@ func (app *adapter) toTokens(
 
 func (app *adapter) toToken(
 	grammar grammars.Grammar,
+	origin []byte,
 	parentValues map[string]map[int][]byte,
+	resolved map[AST]grammars.Grammar,
 	token tokens.Token,
 	input []byte,
 	filterForOmission bool,
@@ -251,7 +306,9 @@ func (app *adapter) toToken(
 					escapeElement := reverse.Escape()
 					_, retRemainingAfterEscape, err := app.toElement(
 						grammar,
+						origin,
 						parentValues,
+						resolved,
 						escapeElement,
 						retRemaining,
 						filterForOmission,
@@ -266,7 +323,9 @@ func (app *adapter) toToken(
 
 				_, retRemainingAfterElement, err := app.toElement(
 					grammar,
+					origin,
 					parentValues,
+					resolved,
 					element,
 					retRemaining,
 					filterForOmission,
@@ -307,14 +366,40 @@ func (app *adapter) toToken(
 				return nil, nil, err
 			}
 
-			retElement, err := app.elementBuilder.Create().
-				WithConstant(constant).
+			span, err := app.spanBuilder.Create().
+				WithStart(app.offset(origin, remaining)).
+				WithEnd(app.offset(origin, retRemaining)).
 				Now()
 
 			if err != nil {
 				return nil, nil, err
 			}
 
+			builder := app.elementBuilder.Create().
+				WithConstant(constant).
+				WithSpan(span)
+
+			if app.isLossless {
+				source := remaining[:len(remaining)-len(retRemaining)]
+				if !bytes.Equal(source, accumulated) {
+					trivia, err := app.triviaBuilder.Create().
+						WithSource(source).
+						Now()
+
+					if err != nil {
+						return nil, nil, err
+					}
+
+					builder.WithTrivia(trivia)
+				}
+			}
+
+			retElement, err := builder.Now()
+
+			if err != nil {
+				return nil, nil, err
+			}
+
 			elementsList = append(elementsList, retElement)
 			remaining = retRemaining
 			cpt++
@@ -323,7 +408,9 @@ func (app *adapter) toToken(
 
 		retElement, retRemaining, err := app.toElement(
 			grammar,
+			origin,
 			parentValues,
+			resolved,
 			element,
 			remaining,
 			filterForOmission,
@@ -355,7 +442,12 @@ func (app *adapter) toToken(
 	}
 
 	name := token.Name()
-	retToken, err := app.tokenBuilder.Create().WithName(name).WithElements(elements).Now()
+	builder := app.tokenBuilder.Create().WithName(name).WithElements(elements)
+	if token.HasUnique() {
+		builder.WithUnique(token.Unique())
+	}
+
+	retToken, err := builder.Now()
 	if err != nil {
 		return nil, nil, err
 	}
@@ -365,7 +457,9 @@ func (app *adapter) toToken(
 
 func (app *adapter) toElement(
 	grammar grammars.Grammar,
+	origin []byte,
 	parentValues map[string]map[int][]byte,
+	resolved map[AST]grammars.Grammar,
 	element elements.Element,
 	input []byte,
 	filterForOmission bool,
@@ -378,6 +472,10 @@ func (app *adapter) toElement(
 		)
 	}
 
+	content := remaining
+	start := app.offset(origin, remaining)
+	end := start
+	var value []byte
 	builder := app.elementBuilder.Create()
 	if element.IsRule() {
 		ruleName := element.Rule()
@@ -397,8 +495,11 @@ func (app *adapter) toElement(
 			return nil, nil, err
 		}
 
+		value = ruleBytes
+
 		builder.WithConstant(constant)
 		remaining = retRemaining
+		end = app.offset(origin, remaining)
 	}
 
 	if element.IsBlock() {
@@ -410,7 +511,9 @@ func (app *adapter) toElement(
 
 		retInstruction, retInstructionRemaining, err := app.toInstruction(
 			grammar,
+			origin,
 			parentValues,
+			resolved,
 			block,
 			remaining,
 			filterForOmission,
@@ -422,6 +525,7 @@ func (app *adapter) toElement(
 
 		builder.WithInstruction(retInstruction)
 		remaining = retInstructionRemaining
+		end = app.instructionEnd(retInstruction, start)
 	}
 
 	if element.IsConstant() {
@@ -442,8 +546,11 @@ func (app *adapter) toElement(
 			return nil, nil, err
 		}
 
+		value = retValue
+
 		builder.WithConstant(constant)
 		remaining = retRemaining
+		end = app.offset(origin, remaining)
 	}
 
 	if element.IsReference() {
@@ -453,30 +560,88 @@ func (app *adapter) toElement(
 			return nil, nil, err
 		}
 
-		retAST, retRemaining, err := app.ToAST(retGrammar, remaining)
+		retAST, retRemaining, err := app.toAST(retGrammar, origin, resolved, remaining)
 		if err != nil {
 			return nil, nil, err
 		}
 
+		resolved[retAST] = retGrammar
 		builder.WithAST(retAST)
 		remaining = retRemaining
+		end = retAST.Root().Span().End()
 	}
 
-	ins, err := builder.Now()
+	span, err := app.spanBuilder.Create().
+		WithStart(start).
+		WithEnd(end).
+		Now()
+
 	if err != nil {
 		return nil, nil, err
 	}
 
+	builder.WithSpan(span)
+	trailing := []byte{}
 	if filterForOmission {
-		remaining = app.filterOmissions(
+		filtered := app.filterOmissions(
 			grammar,
 			remaining,
 		)
+
+		trailing = remaining[:len(remaining)-len(filtered)]
+		remaining = filtered
+	}
+
+	if app.isLossless {
+		// the trivia contains the omitted bytes consumed around the element, and the source of a constant when it differs from its value:
+		leading := input[:len(input)-len(content)]
+		source := []byte{}
+		if value != nil && !bytes.Equal(origin[start:end], value) {
+			source = origin[start:end]
+		}
+
+		if len(leading) > 0 || len(trailing) > 0 || len(source) > 0 {
+			trivia, err := app.triviaBuilder.Create().
+				WithLeading(leading).
+				WithTrailing(trailing).
+				WithSource(source).
+				Now()
+
+			if err != nil {
+				return nil, nil, err
+			}
+
+			builder.WithTrivia(trivia)
+		}
+	}
+
+	ins, err := builder.Now()
+	if err != nil {
+		return nil, nil, err
 	}
 
 	return ins, remaining, nil
 }
 
+// offset returns the offset of the remaining bytes in the origin, the remaining bytes are always a suffix of the origin
+func (app *adapter) offset(origin []byte, remaining []byte) uint {
+	return uint(len(origin) - len(remaining))
+}
+
+// instructionEnd returns the end of the span of the last element of the instruction, or the start if it contains no element
+func (app *adapter) instructionEnd(instruction Instruction, start uint) uint {
+	end := start
+	for _, oneToken := range instruction.Tokens().List() {
+		for _, oneElement := range oneToken.Elements().List() {
+			if oneElement.HasSpan() && oneElement.Span().End() > end {
+				end = oneElement.Span().End()
+			}
+		}
+	}
+
+	return end
+}
+
 func (app *adapter) constantNameToBytes(
 	grammar grammars.Grammar,
 	name string,
@@ -592,7 +757,9 @@ func (app *adapter) filterOmissions(
 	for _, oneOmission := range omissionsList {
 		_, retRemaining, err := app.toElement(
 			grammar,
+			input,
 			map[string]map[int][]byte{},
+			map[AST]grammars.Grammar{},
 			oneOmission,
 			remaining,
 			false,
@@ -611,3 +778,100 @@ func (app *adapter) filterOmissions(
 
 	return remaining
 }
+
+// cover records the coverage of the parsed element, using the grammars that resolved its embedded ASTs, it never fails
+// since the element was already parsed successfully
+func (app *adapter) cover(
+	grammar grammars.Grammar,
+	resolved map[AST]grammars.Grammar,
+	element Element,
+) {
+	if app.coverage == nil {
+		return
+	}
+
+	app.coverElement(grammar, resolved, element)
+}
+
+func (app *adapter) coverElement(
+	grammar grammars.Grammar,
+	resolved map[AST]grammars.Grammar,
+	element Element,
+) {
+	if element.IsInstruction() {
+		app.coverInstruction(grammar, resolved, element.Instruction())
+		return
+	}
+
+	if !element.IsAST() {
+		return
+	}
+
+	ast := element.AST()
+	if retGrammar, ok := resolved[ast]; ok {
+		app.coverElement(retGrammar, resolved, ast.Root())
+	}
+}
+
+func (app *adapter) coverInstruction(
+	grammar grammars.Grammar,
+	resolved map[AST]grammars.Grammar,
+	instruction Instruction,
+) {
+	blockName := instruction.Block()
+	block, err := grammar.Blocks().Fetch(blockName)
+	if err != nil {
+		return
+	}
+
+	lineIndex := instruction.Line()
+	linesList := block.Lines().List()
+	if lineIndex >= uint(len(linesList)) {
+		return
+	}
+
+	app.coverage.HitBlock(grammar, blockName)
+	app.coverage.HitLine(grammar, blockName, lineIndex)
+
+	line := linesList[lineIndex]
+	tokens := instruction.Tokens()
+	grammarTokensList := line.Tokens().List()
+	grammarTokenIndex := 0
+	for _, oneToken := range tokens.List() {
+		// tokens without elements are not part of the instruction, so match them in order by name:
+		name := oneToken.Name()
+		for grammarTokenIndex < len(grammarTokensList) && grammarTokensList[grammarTokenIndex].Name() != name {
+			grammarTokenIndex++
+		}
+
+		if grammarTokenIndex >= len(grammarTokensList) {
+			return
+		}
+
+		app.coverage.HitToken(grammar, blockName, lineIndex, uint(grammarTokenIndex))
+		for _, oneElement := range oneToken.Elements().List() {
+			app.coverElement(grammar, resolved, oneElement)
+		}
+
+		grammarTokenIndex++
+	}
+
+	if !line.HasBalance() {
+		return
+	}
+
+	for idx, oneSelectors := range line.Balance().Lines() {
+		isValid := true
+		for _, oneSelector := range oneSelectors.List() {
+			if !tokens.IsSelectorValid(oneSelector) {
+				isValid = false
+				break
+			}
+		}
+
+		if isValid {
+			app.coverage.HitBalance(grammar, blockName, lineIndex, uint(idx))
+			break
+		}
+	}
+}
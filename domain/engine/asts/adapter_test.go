@@ -2,10 +2,15 @@ package asts
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/steve-care-software/grammars/domain/engine/coverages"
 	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements/references"
 )
 
 func TestParserAdapter_withBalance_Success(t *testing.T) {
@@ -125,6 +130,319 @@ func TestParserAdapter_withBalance_Success(t *testing.T) {
 
 }
 
+func TestParserAdapter_withVersionConstraint_Success(t *testing.T) {
+	grammarParserAdapter := grammars.NewAdapter()
+	repository := grammars.NewRepositoryMemory(map[string]grammars.Grammar{})
+	for _, oneVersion := range []string{"1", "1.2", "2"} {
+		retGrammar, _, err := grammarParserAdapter.ToGrammar([]byte(fmt.Sprintf(`
+			v%s;
+			> .value;
+			# .SPACE;
+
+			value: .N_ZERO;
+
+			N_ZERO: "0";
+			SPACE: " ";
+		`, oneVersion)))
+
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		err = repository.Insert([]string{"my", "grammars", "value.grammar"}, retGrammar)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+	}
+
+	constraints := map[string]string{
+		"^1":     "1.2.0",
+		"~1.0":   "1.0.0",
+		"1":      "1.0.0",
+		"1.2":    "1.2.0",
+		"latest": "2.0.0",
+	}
+
+	parserAdapter := NewAdapter(repository)
+	for constraint, expected := range constraints {
+		retGrammar, _, err := grammarParserAdapter.ToGrammar([]byte(fmt.Sprintf(`
+			v1;
+			> .assignment;
+			# .SPACE;
+
+			assignment: .VARIABLE .EQUAL .value[/my/grammars/value.grammar, %s];
+
+			VARIABLE: "myVariable";
+			EQUAL: "=";
+			SPACE: " ";
+		`, constraint)))
+
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retAST, _, err := parserAdapter.ToAST(retGrammar, []byte("myVariable = 0"))
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if !retAST.HasVersion() || retAST.Version().String() != "1.0.0" {
+			t.Errorf("the AST was expected to record the version of its grammar")
+			return
+		}
+
+		retToken, err := retAST.Root().Instruction().Tokens().Fetch("value", 0)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retElement, err := retToken.Elements().Fetch(0)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if !retElement.IsAST() || !retElement.AST().HasVersion() {
+			t.Errorf("the element was expected to be an AST that records its version")
+			return
+		}
+
+		if retElement.AST().Version().String() != expected {
+			t.Errorf("the constraint (%s) was expected to resolve to the version %s, %s returned", constraint, expected, retElement.AST().Version().String())
+			return
+		}
+	}
+
+	retGrammar, _, err := grammarParserAdapter.ToGrammar([]byte(`
+		v1;
+		> .assignment;
+		# .SPACE;
+
+		assignment: .VARIABLE .EQUAL .value[/my/grammars/value.grammar, ^3];
+
+		VARIABLE: "myVariable";
+		EQUAL: "=";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, _, err = parserAdapter.ToAST(retGrammar, []byte("myVariable = 0"))
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestParserAdapter_withSpans_Success(t *testing.T) {
+	grammarParserAdapter := grammars.NewAdapter()
+	valueGrammar, _, err := grammarParserAdapter.ToGrammar([]byte(`
+		v1;
+		> .value;
+		# .SPACE;
+
+		value: .N_ZERO;
+
+		N_ZERO: "0";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	repository := grammars.NewRepositoryMemory(map[string]grammars.Grammar{})
+	err = repository.Insert([]string{"my", "grammars", "value.grammar"}, valueGrammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retGrammar, _, err := grammarParserAdapter.ToGrammar([]byte(`
+		v1;
+		> .assignment;
+		# .SPACE;
+
+		assignment: .VARIABLE .EQUAL .value[/my/grammars/value.grammar, 1];
+
+		VARIABLE: "myVariable";
+		EQUAL: "=";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retAST, _, err := NewAdapter(repository).ToAST(retGrammar, []byte("  myVariable =  0 "))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := map[string][2]uint{
+		"VARIABLE": {2, 12},
+		"EQUAL":    {13, 14},
+		"value":    {16, 17},
+	}
+
+	root := retAST.Root()
+	if !root.HasSpan() || root.Span().Start() != 2 || root.Span().End() != 17 {
+		t.Errorf("the root was expected to span the input without its omitted bytes")
+		return
+	}
+
+	for name, oneExpected := range expected {
+		retToken, err := root.Instruction().Tokens().Fetch(name, 0)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retElement, err := retToken.Elements().Fetch(0)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		span := retElement.Span()
+		if span.Start() != oneExpected[0] || span.End() != oneExpected[1] {
+			t.Errorf("the token (%s) was expected to span [%d, %d), [%d, %d) returned", name, oneExpected[0], oneExpected[1], span.Start(), span.End())
+			return
+		}
+
+		// the spans of an embedded AST are offsets in the same input:
+		if retElement.IsAST() {
+			retRootSpan := retElement.AST().Root().Span()
+			if retRootSpan.Start() != oneExpected[0] || retRootSpan.End() != oneExpected[1] {
+				t.Errorf("the embedded AST was expected to span [%d, %d), [%d, %d) returned", oneExpected[0], oneExpected[1], retRootSpan.Start(), retRootSpan.End())
+				return
+			}
+		}
+	}
+}
+
+func TestLosslessAdapter_Success(t *testing.T) {
+	grammarParserAdapter := grammars.NewAdapter()
+	valueGrammar, _, err := grammarParserAdapter.ToGrammar([]byte(`
+		v1;
+		> .value;
+		# .SPACE .TAB;
+
+		value: .N_ZERO .N_ZERO*;
+
+		N_ZERO: "0";
+		SPACE: " ";
+		TAB: "	";
+	`))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	repository := grammars.NewRepositoryMemory(map[string]grammars.Grammar{})
+	err = repository.Insert([]string{"my", "grammars", "value.grammar"}, valueGrammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retGrammar, _, err := grammarParserAdapter.ToGrammar([]byte(`
+		v1;
+		> .line;
+		# .SPACE .EOL;
+
+		line: !.SEMICOLON .SEMICOLON .assignment+
+			;
+
+		assignment: .OPEN_PARENTHESIS ._myConstant .EQUAL .value[/my/grammars/value.grammar, 1] .CLOSE_PARENTHESIS
+					;
+
+		_myConstant: .N_ONE .N_TWO[2];
+
+		N_ONE: "1";
+		N_TWO: "2";
+		EQUAL: "=";
+		SEMICOLON: ";";
+		OPEN_PARENTHESIS: "(";
+		CLOSE_PARENTHESIS: ")";
+		SPACE: " ";
+		EOL: "
+";
+	`))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	remaining := []byte("!remaining")
+	parsed := []byte("  a comment ;\n ( 1 2\n2 =\t0 \t0 ) (122= 0)\n ")
+	input := append(append([]byte{}, parsed...), remaining...)
+	retAST, retRemaining, err := NewLosslessAdapter(repository).ToAST(retGrammar, input)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !bytes.Equal(remaining, retRemaining) {
+		t.Errorf("the remaining was expected to be '%s', '%s' returned", remaining, retRemaining)
+		return
+	}
+
+	elements, err := NewElementsBuilder().Create().WithList([]Element{retAST.Root()}).Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retBytes, err := NewElementsAdapter().ToBytes(elements)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !bytes.Equal(parsed, retBytes) {
+		t.Errorf("the bytes were expected to be '%s', '%s' returned", parsed, retBytes)
+		return
+	}
+
+	// the default adapter does not keep the omitted bytes:
+	retDefaultAST, _, err := NewAdapter(repository).ToAST(retGrammar, input)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	elements, err = NewElementsBuilder().Create().WithList([]Element{retDefaultAST.Root()}).Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retBytes, err = NewElementsAdapter().ToBytes(elements)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if string(retBytes) != "a comment;(122=00)(122=0)" {
+		t.Errorf("the bytes were expected to be '%s', '%s' returned", "a comment;(122=00)(122=0)", retBytes)
+		return
+	}
+}
+
 func TestParserAdapter_Success(t *testing.T) {
 	grammarInput := []byte(`
 		v1;
@@ -247,3 +565,332 @@ func TestParserAdapter_withFiniteRecursivity_Success(t *testing.T) {
 	fmt.Printf("\n%v\n", retAST)
 
 }
+
+func TestParserAdapter_withUniques_Success(t *testing.T) {
+	grammarInput := []byte(`
+		v1;
+		> .program;
+		# .SPACE;
+
+		program: .declaration+ .usage*
+				;
+
+		declaration: .LET #.program .name .SEMICOLON
+					;
+
+		usage: .USE $.program .name .SEMICOLON
+				;
+
+		name: .LL_A
+			| .LL_B
+			| .LL_C
+			;
+
+		LET: "let";
+		USE: "use";
+		SEMICOLON: ";";
+		LL_A: "a";
+		LL_B: "b";
+		LL_C: "c";
+		SPACE: " ";
+	`)
+
+	grammarParserAdapter := grammars.NewAdapter()
+	retGrammar, _, err := grammarParserAdapter.ToGrammar(grammarInput)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	parserAdapter := NewAdapter(
+		grammars.NewRepositoryMemory(map[string]grammars.Grammar{}),
+	)
+
+	_, retRemaining, err := parserAdapter.ToAST(retGrammar, []byte("let a; let b; use a; use b; use a;"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(retRemaining) > 0 {
+		t.Errorf("the remaining was expected to be empty, '%s' returned", retRemaining)
+		return
+	}
+
+	_, _, err = parserAdapter.ToAST(retGrammar, []byte("let a; let b; let a;"))
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	expectedPaths := "program#0.declaration[0]/declaration#0.name[0] and program#0.declaration[2]/declaration#0.name[0]"
+	if !strings.Contains(err.Error(), expectedPaths) {
+		t.Errorf("the error was expected to point at the duplicate tokens (%s), returned: %s", expectedPaths, err.Error())
+		return
+	}
+
+	_, _, err = parserAdapter.ToAST(retGrammar, []byte("let a; use c;"))
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	expectedPath := "program#0.usage[0]/usage#0.name[0]"
+	if !strings.Contains(err.Error(), expectedPath) {
+		t.Errorf("the error was expected to point at the unmatched token (%s), returned: %s", expectedPath, err.Error())
+		return
+	}
+}
+
+func TestValidate_Success(t *testing.T) {
+	grammarInput := []byte(`
+		v1;
+		> .program;
+		# .SPACE;
+
+		program: .declaration+
+				;
+
+		declaration: .LET #.program .name .SEMICOLON
+					;
+
+		name: .LL_A
+			| .LL_B
+			;
+
+		LET: "let";
+		SEMICOLON: ";";
+		LL_A: "a";
+		LL_B: "b";
+		SPACE: " ";
+	`)
+
+	retGrammar, _, err := grammars.NewAdapter().ToGrammar(grammarInput)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retAST, _, err := NewAdapter(
+		grammars.NewRepositoryMemory(map[string]grammars.Grammar{}),
+	).ToAST(retGrammar, []byte("let a; let b;"))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	root := retAST.Root()
+	err = Validate(root)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// rebuild the program with the first declaration twice, without using the AST builder:
+	declarationToken, err := root.Instruction().Tokens().Fetch("declaration", 0)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	first := declarationToken.Elements().List()[0]
+	retElements, err := NewElementsBuilder().Create().WithList([]Element{first, first}).Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retToken, err := NewTokenBuilder().Create().WithName("declaration").WithElements(retElements).Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retTokens, err := NewTokensBuilder().Create().WithList([]Token{retToken}).Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retInstruction, err := NewInstructionBuilder().Create().WithBlock("program").WithLine(0).WithTokens(retTokens).Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retRoot, err := NewElementBuilder().Create().WithInstruction(retInstruction).Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = Validate(retRoot)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestParserAdapter_concurrent_Success(t *testing.T) {
+	valueGrammar, _, err := grammars.NewAdapter().ToGrammar([]byte(`
+		v1;
+		> .value;
+		# .SPACE;
+
+		value: .N_ONE .N_TWO*
+			;
+
+		N_ONE: "1";
+		N_TWO: "2";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	grammar, _, err := grammars.NewAdapter().ToGrammar([]byte(`
+		v1;
+		> .program;
+		# .SPACE;
+
+		program: .assignment+
+				;
+
+		assignment: .name .EQUAL .value[my/value, 1] .SEMICOLON
+					;
+
+		name: .LL_A
+			| .LL_B
+			;
+
+		LL_A: "a";
+		LL_B: "b";
+		EQUAL: "=";
+		SEMICOLON: ";";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// a single adapter and repository are shared by every goroutine:
+	parserAdapter := NewAdapterWithCoverage(
+		grammars.NewRepositoryMemory(map[string]grammars.Grammar{
+			"my/value": valueGrammar,
+		}),
+		coverages.NewCollector(),
+	)
+
+	amount := 16
+	errs := make(chan error, amount)
+	waitGroup := sync.WaitGroup{}
+	for i := 0; i < amount; i++ {
+		waitGroup.Add(1)
+		go func(idx int) {
+			defer waitGroup.Done()
+			input := []byte(fmt.Sprintf("a = 1%s; b = 1;", strings.Repeat("2", idx)))
+			_, remaining, err := parserAdapter.ToAST(grammar, input)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			if len(remaining) > 0 {
+				errs <- errors.New(fmt.Sprintf("the input (%s) was expected to be fully parsed, remaining: %s", input, remaining))
+			}
+		}(i)
+	}
+
+	waitGroup.Wait()
+	close(errs)
+	for oneErr := range errs {
+		t.Errorf("the error was expected to be nil, error returned: %s", oneErr.Error())
+		return
+	}
+}
+
+func TestParserAdapter_withCoverage_usesResolvedGrammar_Success(t *testing.T) {
+	valueGrammar, _, err := grammars.NewAdapter().ToGrammar([]byte(`
+		v1;
+		> .value;
+		# .SPACE;
+
+		value: .N_ONE .N_TWO*
+			;
+
+		N_ONE: "1";
+		N_TWO: "2";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	grammar, _, err := grammars.NewAdapter().ToGrammar([]byte(`
+		v1;
+		> .program;
+		# .SPACE;
+
+		program: .NAME .EQUAL .value[my/value, 1] .SEMICOLON
+				;
+
+		NAME: "a";
+		EQUAL: "=";
+		SEMICOLON: ";";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// the repository only resolves the reference once, so the coverage must reuse the grammar resolved while parsing:
+	collector := coverages.NewCollector()
+	parserAdapter := NewAdapterWithCoverage(
+		&onceRepository{
+			Repository: grammars.NewRepositoryMemory(map[string]grammars.Grammar{
+				"my/value": valueGrammar,
+			}),
+		},
+		collector,
+	)
+
+	_, _, err = parserAdapter.ToAST(grammar, []byte("a = 12;"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retReport, err := collector.Report(valueGrammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if retReport.Covered() <= 0 {
+		t.Errorf("the referenced grammar was expected to be covered")
+		return
+	}
+}
+
+type onceRepository struct {
+	grammars.Repository
+	isRetrieved bool
+}
+
+func (app *onceRepository) Retrieve(reference references.Reference) (grammars.Grammar, error) {
+	if app.isRetrieved {
+		return nil, errors.New("the grammar was already retrieved")
+	}
+
+	app.isRetrieved = true
+	return app.Repository.Retrieve(reference)
+}
@@ -1,20 +1,32 @@
 package asts
 
+import "github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+
 type ast struct {
-	root Element
+	root    Element
+	version versions.Version
 }
 
 func createAST(
 	root Element,
 ) AST {
-	return createASTInternally(root)
+	return createASTInternally(root, nil)
+}
+
+func createASTWithVersion(
+	root Element,
+	version versions.Version,
+) AST {
+	return createASTInternally(root, version)
 }
 
 func createASTInternally(
 	root Element,
+	version versions.Version,
 ) AST {
 	out := ast{
-		root: root,
+		root:    root,
+		version: version,
 	}
 
 	return &out
@@ -24,3 +36,13 @@ func createASTInternally(
 func (obj *ast) Root() Element {
 	return obj.root
 }
+
+// HasVersion returns true if there is a version, false otherwise
+func (obj *ast) HasVersion() bool {
+	return obj.version != nil
+}
+
+// Version returns the version of the grammar the AST was parsed with, if any
+func (obj *ast) Version() versions.Version {
+	return obj.version
+}
@@ -3,15 +3,19 @@ package asts
 import (
 	"errors"
 	"fmt"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
 )
 
 type builder struct {
-	root Element
+	root    Element
+	version versions.Version
 }
 
 func createBuilder() Builder {
 	out := builder{
-		root: nil,
+		root:    nil,
+		version: nil,
 	}
 
 	return &out
@@ -28,18 +32,31 @@ func (app *builder) WithRoot(root Element) Builder {
 	return app
 }
 
+// WithVersion adds a version to the builder
+func (app *builder) WithVersion(version versions.Version) Builder {
+	app.version = version
+	return app
+}
+
 // Now builds a new AST
 func (app *builder) Now() (AST, error) {
 	if app.root == nil {
 		return nil, errors.New("the root is mandatory in order to build a AST instance")
 	}
 
-	_, err := app.root.Validate(map[string]BlockCount{})
+	err := Validate(app.root)
 	if err != nil {
 		str := fmt.Sprintf("there was an error while validating the AST: %s", err.Error())
 		return nil, errors.New(str)
 	}
 
+	if app.version != nil {
+		return createASTWithVersion(
+			app.root,
+			app.version,
+		), nil
+	}
+
 	return createAST(
 		app.root,
 	), nil
@@ -0,0 +1,111 @@
+package cursors
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/balances/selectors/chains"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements"
+)
+
+type adapter struct {
+	grammarElementBuilder elements.ElementBuilder
+	chainBuilder          chains.Builder
+	tokenBuilder          chains.TokenBuilder
+	elementBuilder        chains.ElementBuilder
+}
+
+func createAdapter(
+	grammarElementBuilder elements.ElementBuilder,
+	chainBuilder chains.Builder,
+	tokenBuilder chains.TokenBuilder,
+	elementBuilder chains.ElementBuilder,
+) Adapter {
+	out := adapter{
+		grammarElementBuilder: grammarElementBuilder,
+		chainBuilder:          chainBuilder,
+		tokenBuilder:          tokenBuilder,
+		elementBuilder:        elementBuilder,
+	}
+
+	return &out
+}
+
+// ToCursor returns a cursor positioned on the root element of the AST
+func (app *adapter) ToCursor(ast asts.AST) Cursor {
+	return createRootCursor(ast.Root())
+}
+
+// ToChain converts a path to a chain
+func (app *adapter) ToChain(path string) (chains.Chain, error) {
+	_, segments, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(segments) <= 0 {
+		str := fmt.Sprintf("the path (%s) was expected to designate a descendant of the root element in order to be converted to a chain", path)
+		return nil, errors.New(str)
+	}
+
+	return app.chain(segments)
+}
+
+func (app *adapter) chain(segments []segment) (chains.Chain, error) {
+	current := segments[0]
+	remaining := segments[1:]
+	tokenBuilder := app.tokenBuilder.Create().WithIndex(current.token)
+	if current.hasElement || len(remaining) > 0 {
+		elementBuilder := app.elementBuilder.Create().WithIndex(current.element)
+		if len(remaining) > 0 {
+			retChain, err := app.chain(remaining)
+			if err != nil {
+				return nil, err
+			}
+
+			elementBuilder.WithChain(retChain)
+		}
+
+		retElement, err := elementBuilder.Now()
+		if err != nil {
+			return nil, err
+		}
+
+		tokenBuilder.WithElement(retElement)
+	}
+
+	retToken, err := tokenBuilder.Now()
+	if err != nil {
+		return nil, err
+	}
+
+	retGrammarElement, err := app.grammarElement(current.name)
+	if err != nil {
+		return nil, err
+	}
+
+	return app.chainBuilder.Create().
+		WithElement(retGrammarElement).
+		WithToken(retToken).
+		Now()
+}
+
+// grammarElement builds the grammar element using the naming conventions of the grammar: constants start with an
+// underscore, rules with an uppercase letter and blocks with a lowercase letter
+func (app *adapter) grammarElement(name string) (elements.Element, error) {
+	builder := app.grammarElementBuilder.Create()
+	first, _ := utf8.DecodeRuneInString(name)
+	if strings.HasPrefix(name, constantNamePrefix) {
+		builder.WithConstant(name)
+	} else if unicode.IsUpper(first) {
+		builder.WithRule(name)
+	} else {
+		builder.WithBlock(name)
+	}
+
+	return builder.Now()
+}
@@ -0,0 +1,318 @@
+package cursors
+
+import (
+	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+)
+
+func TestAdapter_Success(t *testing.T) {
+	ast, err := parse("a -> b = 0; b -> a = 1;")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	root := ast.Root()
+	retToken, err := root.Search("edge", 0)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retEdge, err := retToken.Elements().Fetch(1)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retName, err := retEdge.Search("name", 1)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	adapter := NewAdapter()
+	cursor, err := adapter.ToCursor(ast).Locate(retName)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := "graph/edge[0][1]/name[1]"
+	if cursor.Path() != expected {
+		t.Errorf("the path was expected to be %s, %s returned", expected, cursor.Path())
+		return
+	}
+
+	if cursor.Depth() != 3 {
+		t.Errorf("the depth was expected to be %d, %d returned", 3, cursor.Depth())
+		return
+	}
+
+	if !cursor.HasParent() || cursor.Parent().Element() != retEdge {
+		t.Errorf("the parent was expected to be the second edge element")
+		return
+	}
+
+	if !cursor.HasPrevious() || cursor.Previous().Token().Name() != "ARROW" {
+		t.Errorf("the previous sibling was expected to be the ARROW token")
+		return
+	}
+
+	if !cursor.Previous().HasPrevious() || cursor.Previous().Previous().Path() != "graph/edge[0][1]/name[0]" || cursor.Previous().Previous().HasPrevious() {
+		t.Errorf("the first sibling was expected to be the first name token")
+		return
+	}
+
+	if !cursor.HasNext() || cursor.Next().Token().Name() != "EQUAL" {
+		t.Errorf("the next sibling was expected to be the EQUAL token")
+		return
+	}
+
+	if len(cursor.Siblings()) != 5 || len(cursor.Parent().Children()) != 6 {
+		t.Errorf("the edge element was expected to contain 6 tokens")
+		return
+	}
+
+	chain, err := adapter.ToChain(cursor.Path())
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, retSelectedToken, _, err := root.Instruction().Tokens().Select(chain)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if retSelectedToken != retName {
+		t.Errorf("the chain was expected to select the token of the cursor")
+		return
+	}
+
+	if !root.IsChainValid(chain) {
+		t.Errorf("the chain was expected to be valid against the root")
+		return
+	}
+}
+
+func TestAdapter_withEmbeddedAST_Success(t *testing.T) {
+	ast, err := parse("a -> b = 0; b -> a = 1;")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	adapter := NewAdapter()
+	cursor, err := adapter.ToCursor(ast).Seek("graph/edge[0][1]/bit[0]/N_ONE[0][0]")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !cursor.IsElement() || string(cursor.Element().Value()) != "1" {
+		t.Errorf("the cursor was expected to be positioned on the N_ONE constant")
+		return
+	}
+
+	expected := "graph/edge[0][1]/bit[0][0]/N_ONE[0][0]"
+	if cursor.Path() != expected {
+		t.Errorf("the path was expected to be %s, %s returned", expected, cursor.Path())
+		return
+	}
+
+	if len(cursor.Children()) != 0 || cursor.Root().Path() != "graph" {
+		t.Errorf("the constant was not expected to contain children")
+		return
+	}
+
+	chain, err := adapter.ToChain(cursor.Path())
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, _, retElement, err := ast.Root().Instruction().Tokens().Select(chain)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if retElement != cursor.Element() {
+		t.Errorf("the chain was expected to select the element of the cursor")
+		return
+	}
+
+	retCursor, err := cursor.Seek(expected)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if retCursor.Element() != cursor.Element() {
+		t.Errorf("the seeked cursor was expected to be positioned on the same element")
+		return
+	}
+}
+
+func TestAdapter_withInvalidPath_returnsError(t *testing.T) {
+	ast, err := parse("a -> b = 0;")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	paths := []string{
+		"",
+		"other/edge[0]",
+		"graph/edge",
+		"graph/edge[a]",
+		"graph/edge[0][0][0]",
+		"graph/edge[1]",
+		"graph/edge[0][5]",
+		"graph/edge[0][0]/name[2]",
+		"graph/edge[0][0]/name[1][0]/LL_A[0]",
+	}
+
+	cursor := NewAdapter().ToCursor(ast)
+	for _, onePath := range paths {
+		_, err := cursor.Seek(onePath)
+		if err == nil {
+			t.Errorf("the error was expected to be valid, nil returned (path: %s)", onePath)
+			return
+		}
+	}
+
+	_, err = NewAdapter().ToChain("graph")
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestAdapter_withRuleRootedReference_returnsError(t *testing.T) {
+	grammarAdapter := grammars.NewAdapter()
+	digitGrammar, _, err := grammarAdapter.ToGrammar([]byte(`
+		v1;
+		> .N_ONE;
+		# .SPACE;
+
+		digits: .N_ONE+
+			;
+
+		N_ONE: "1";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	repository := grammars.NewRepositoryMemory(map[string]grammars.Grammar{})
+	err = repository.Insert([]string{"lang", "digit"}, digitGrammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	grammar, _, err := grammarAdapter.ToGrammar([]byte(`
+		v1;
+		> .program;
+		# .SPACE;
+
+		program: .LET .digit[/lang/digit, ^1] .SEMICOLON
+				;
+
+		LET: "let";
+		SEMICOLON: ";";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	ast, _, err := asts.NewAdapter(repository).ToAST(grammar, []byte("let 1;"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	chain, err := NewAdapter().ToChain("program/digit[0][0]/N_ONE[0]")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, _, _, err = ast.Root().Instruction().Tokens().Select(chain)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func parse(input string) (asts.AST, error) {
+	grammarAdapter := grammars.NewAdapter()
+	bitGrammar, _, err := grammarAdapter.ToGrammar([]byte(`
+		v1;
+		> .bit;
+		# .SPACE;
+
+		bit: .N_ZERO
+		   | .N_ONE
+		   ;
+
+		N_ZERO: "0";
+		N_ONE: "1";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		return nil, err
+	}
+
+	repository := grammars.NewRepositoryMemory(map[string]grammars.Grammar{})
+	err = repository.Insert([]string{"lang", "bit"}, bitGrammar)
+	if err != nil {
+		return nil, err
+	}
+
+	grammar, _, err := grammarAdapter.ToGrammar([]byte(`
+		v1;
+		> .graph;
+		# .SPACE;
+
+		graph: .edge+
+			 ;
+
+		edge: .name .ARROW .name .EQUAL .bit[/lang/bit, ^1] .SEMICOLON
+			;
+
+		name: .LL_A
+			| .LL_B
+			;
+
+		ARROW: "->";
+		EQUAL: "=";
+		SEMICOLON: ";";
+		LL_A: "a";
+		LL_B: "b";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		return nil, err
+	}
+
+	ast, _, err := asts.NewAdapter(repository).ToAST(grammar, []byte(input))
+	if err != nil {
+		return nil, err
+	}
+
+	return ast, nil
+}
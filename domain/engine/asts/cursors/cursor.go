@@ -0,0 +1,314 @@
+package cursors
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+)
+
+type cursor struct {
+	depth    uint
+	index    uint
+	position uint
+	element  asts.Element
+	token    asts.Token
+	parent   *cursor
+}
+
+func createRootCursor(
+	element asts.Element,
+) *cursor {
+	return createCursorInternally(0, 0, 0, element, nil, nil)
+}
+
+func createCursorWithElement(
+	index uint,
+	position uint,
+	element asts.Element,
+	parent *cursor,
+) *cursor {
+	return createCursorInternally(parent.depth+1, index, position, element, nil, parent)
+}
+
+func createCursorWithToken(
+	index uint,
+	position uint,
+	token asts.Token,
+	parent *cursor,
+) *cursor {
+	return createCursorInternally(parent.depth+1, index, position, nil, token, parent)
+}
+
+func createCursorInternally(
+	depth uint,
+	index uint,
+	position uint,
+	element asts.Element,
+	token asts.Token,
+	parent *cursor,
+) *cursor {
+	out := cursor{
+		depth:    depth,
+		index:    index,
+		position: position,
+		element:  element,
+		token:    token,
+		parent:   parent,
+	}
+
+	return &out
+}
+
+// Depth returns the depth, the root element being at depth 0
+func (obj *cursor) Depth() uint {
+	return obj.depth
+}
+
+// Index returns the index of the element in its token, or the index of the token amongst the tokens of the same name
+func (obj *cursor) Index() uint {
+	return obj.index
+}
+
+// IsElement returns true if the cursor is on an element, false otherwise
+func (obj *cursor) IsElement() bool {
+	return obj.element != nil
+}
+
+// Element returns the element, if any
+func (obj *cursor) Element() asts.Element {
+	return obj.element
+}
+
+// IsToken returns true if the cursor is on a token, false otherwise
+func (obj *cursor) IsToken() bool {
+	return obj.token != nil
+}
+
+// Token returns the token, if any
+func (obj *cursor) Token() asts.Token {
+	return obj.token
+}
+
+// HasParent returns true if there is a parent, false otherwise
+func (obj *cursor) HasParent() bool {
+	return obj.parent != nil
+}
+
+// Parent returns the parent, if any
+func (obj *cursor) Parent() Cursor {
+	if obj.parent == nil {
+		return nil
+	}
+
+	return obj.parent
+}
+
+// Root returns the cursor of the root element
+func (obj *cursor) Root() Cursor {
+	return obj.root()
+}
+
+// Children returns the children
+func (obj *cursor) Children() []Cursor {
+	output := []Cursor{}
+	for _, oneChild := range obj.children() {
+		output = append(output, oneChild)
+	}
+
+	return output
+}
+
+// Siblings returns the other children of the parent
+func (obj *cursor) Siblings() []Cursor {
+	output := []Cursor{}
+	if obj.parent == nil {
+		return output
+	}
+
+	for _, oneChild := range obj.parent.children() {
+		if oneChild.position == obj.position {
+			continue
+		}
+
+		output = append(output, oneChild)
+	}
+
+	return output
+}
+
+// HasPrevious returns true if there is a previous sibling, false otherwise
+func (obj *cursor) HasPrevious() bool {
+	return obj.parent != nil && obj.position > 0
+}
+
+// Previous returns the previous sibling, if any
+func (obj *cursor) Previous() Cursor {
+	if !obj.HasPrevious() {
+		return nil
+	}
+
+	return obj.parent.children()[obj.position-1]
+}
+
+// HasNext returns true if there is a next sibling, false otherwise
+func (obj *cursor) HasNext() bool {
+	return obj.parent != nil && int(obj.position) < len(obj.parent.children())-1
+}
+
+// Next returns the next sibling, if any
+func (obj *cursor) Next() Cursor {
+	if !obj.HasNext() {
+		return nil
+	}
+
+	return obj.parent.children()[obj.position+1]
+}
+
+// Path returns the path from the root
+func (obj *cursor) Path() string {
+	if obj.parent == nil {
+		return obj.element.Name()
+	}
+
+	if obj.IsElement() {
+		return fmt.Sprintf("%s%s%d%s", obj.parent.Path(), indexPrefix, obj.index, indexSuffix)
+	}
+
+	current := segment{
+		name:  obj.token.Name(),
+		token: obj.index,
+	}
+
+	return strings.Join([]string{obj.parent.Path(), current.String()}, pathSeparator)
+}
+
+// Seek returns the cursor designated by the path, that starts from the root
+func (obj *cursor) Seek(path string) (Cursor, error) {
+	name, segments, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	current := obj.root()
+	if current.element.Name() != name {
+		str := fmt.Sprintf("the path (%s) was expected to start with the name of the root element (%s)", path, current.element.Name())
+		return nil, errors.New(str)
+	}
+
+	for idx, oneSegment := range segments {
+		retToken, err := current.childToken(oneSegment.name, oneSegment.token)
+		if err != nil {
+			return nil, err
+		}
+
+		// an intermediate segment without element index designates the first element of its token:
+		isLast := idx == len(segments)-1
+		if isLast && !oneSegment.hasElement {
+			return retToken, nil
+		}
+
+		children := retToken.children()
+		if int(oneSegment.element) >= len(children) {
+			str := fmt.Sprintf("the token (%s) contains %d elements, the element at index %d does not exists", retToken.Path(), len(children), oneSegment.element)
+			return nil, errors.New(str)
+		}
+
+		current = children[oneSegment.element]
+	}
+
+	return current, nil
+}
+
+// Locate returns the cursor of the token, searched by identity in the descendants
+func (obj *cursor) Locate(token asts.Token) (Cursor, error) {
+	retCursor := obj.locate(func(current *cursor) bool {
+		return current.token == token
+	})
+
+	if retCursor == nil {
+		str := fmt.Sprintf("the token (name: %s) is not a descendant of the cursor (path: %s)", token.Name(), obj.Path())
+		return nil, errors.New(str)
+	}
+
+	return retCursor, nil
+}
+
+// LocateElement returns the cursor of the element, searched by identity in the descendants
+func (obj *cursor) LocateElement(element asts.Element) (Cursor, error) {
+	retCursor := obj.locate(func(current *cursor) bool {
+		return current.element == element
+	})
+
+	if retCursor == nil {
+		str := fmt.Sprintf("the element (name: %s) is not a descendant of the cursor (path: %s)", element.Name(), obj.Path())
+		return nil, errors.New(str)
+	}
+
+	return retCursor, nil
+}
+
+func (obj *cursor) locate(isMatch func(current *cursor) bool) *cursor {
+	if isMatch(obj) {
+		return obj
+	}
+
+	for _, oneChild := range obj.children() {
+		retCursor := oneChild.locate(isMatch)
+		if retCursor != nil {
+			return retCursor
+		}
+	}
+
+	return nil
+}
+
+func (obj *cursor) root() *cursor {
+	if obj.parent == nil {
+		return obj
+	}
+
+	return obj.parent.root()
+}
+
+func (obj *cursor) childToken(name string, index uint) (*cursor, error) {
+	for _, oneChild := range obj.children() {
+		if oneChild.IsToken() && oneChild.token.Name() == name && oneChild.index == index {
+			return oneChild, nil
+		}
+	}
+
+	str := fmt.Sprintf("the element (%s) does not contain the token (%s) at index %d", obj.Path(), name, index)
+	return nil, errors.New(str)
+}
+
+func (obj *cursor) children() []*cursor {
+	output := []*cursor{}
+	if obj.IsToken() {
+		for idx, oneElement := range obj.token.Elements().List() {
+			output = append(output, createCursorWithElement(uint(idx), uint(idx), oneElement, obj))
+		}
+
+		return output
+	}
+
+	// the tokens of an embedded AST are the children of its element:
+	element := obj.element
+	for element.IsAST() {
+		element = element.AST().Root()
+	}
+
+	if !element.IsInstruction() {
+		return output
+	}
+
+	occurrences := map[string]uint{}
+	for idx, oneToken := range element.Instruction().Tokens().List() {
+		name := oneToken.Name()
+		output = append(output, createCursorWithToken(occurrences[name], uint(idx), oneToken, obj))
+		occurrences[name]++
+	}
+
+	return output
+}
@@ -0,0 +1,84 @@
+package cursors
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type segment struct {
+	name       string
+	token      uint
+	hasElement bool
+	element    uint
+}
+
+// parsePath returns the name of the root and the segments of the path
+func parsePath(path string) (string, []segment, error) {
+	sections := strings.Split(path, pathSeparator)
+	root := sections[0]
+	if root == "" || strings.Contains(root, indexPrefix) {
+		str := fmt.Sprintf("the path (%s) was expected to start with the name of the root element", path)
+		return "", nil, errors.New(str)
+	}
+
+	output := []segment{}
+	for idx, oneSection := range sections[1:] {
+		retSegment, err := parseSegment(oneSection)
+		if err != nil {
+			str := fmt.Sprintf("the path (%s) contains an invalid segment at index %d: %s", path, idx, err.Error())
+			return "", nil, errors.New(str)
+		}
+
+		output = append(output, retSegment)
+	}
+
+	return root, output, nil
+}
+
+func parseSegment(section string) (segment, error) {
+	position := strings.Index(section, indexPrefix)
+	if position <= 0 || !strings.HasSuffix(section, indexSuffix) {
+		str := fmt.Sprintf("the segment (%s) was expected to contain a name followed by an index, such as name[0]", section)
+		return segment{}, errors.New(str)
+	}
+
+	indexes := strings.Split(section[position+1:len(section)-1], indexSuffix+indexPrefix)
+	if len(indexes) > 2 {
+		str := fmt.Sprintf("the segment (%s) was expected to contain a token index and an optional element index", section)
+		return segment{}, errors.New(str)
+	}
+
+	values := []uint{}
+	for _, oneIndex := range indexes {
+		value, err := strconv.ParseUint(oneIndex, 10, 64)
+		if err != nil {
+			str := fmt.Sprintf("the segment (%s) contains an invalid index (%s)", section, oneIndex)
+			return segment{}, errors.New(str)
+		}
+
+		values = append(values, uint(value))
+	}
+
+	output := segment{
+		name:  section[:position],
+		token: values[0],
+	}
+
+	if len(values) > 1 {
+		output.hasElement = true
+		output.element = values[1]
+	}
+
+	return output, nil
+}
+
+func (obj segment) String() string {
+	str := fmt.Sprintf("%s%s%d%s", obj.name, indexPrefix, obj.token, indexSuffix)
+	if obj.hasElement {
+		str = fmt.Sprintf("%s%s%d%s", str, indexPrefix, obj.element, indexSuffix)
+	}
+
+	return str
+}
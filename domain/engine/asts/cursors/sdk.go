@@ -0,0 +1,68 @@
+package cursors
+
+import (
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/balances/selectors/chains"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements"
+)
+
+const pathSeparator = "/"
+const indexPrefix = "["
+const indexSuffix = "]"
+const constantNamePrefix = "_"
+
+// NewAdapter creates a new adapter
+func NewAdapter() Adapter {
+	grammarElementBuilder := elements.NewElementBuilder()
+	chainBuilder := chains.NewBuilder()
+	tokenBuilder := chains.NewTokenBuilder()
+	elementBuilder := chains.NewElementBuilder()
+	return createAdapter(
+		grammarElementBuilder,
+		chainBuilder,
+		tokenBuilder,
+		elementBuilder,
+	)
+}
+
+// Adapter represents the cursor adapter
+type Adapter interface {
+	// ToCursor returns a cursor positioned on the root element of the AST
+	ToCursor(ast asts.AST) Cursor
+
+	// ToChain converts a path to a chain, that selects the same token or element from the tokens of the root element
+	ToChain(path string) (chains.Chain, error)
+}
+
+// Cursor represents a position in an AST, either on an element or on a token.
+//
+// The path of a cursor starts with the name of the root element, followed by one segment per token: name[index] designates
+// the token at that index amongst the tokens of the same name, and name[index][element] one of its elements. The tokens of
+// an embedded AST are the children of its element, like they are in a chain.
+type Cursor interface {
+	Depth() uint
+	Index() uint
+	IsElement() bool
+	Element() asts.Element
+	IsToken() bool
+	Token() asts.Token
+	HasParent() bool
+	Parent() Cursor
+	Root() Cursor
+	Children() []Cursor
+	Siblings() []Cursor
+	HasPrevious() bool
+	Previous() Cursor
+	HasNext() bool
+	Next() Cursor
+	Path() string
+
+	// Seek returns the cursor designated by the path, that starts from the root
+	Seek(path string) (Cursor, error)
+
+	// Locate returns the cursor of the token, searched by identity in the descendants
+	Locate(token asts.Token) (Cursor, error)
+
+	// LocateElement returns the cursor of the element, searched by identity in the descendants
+	LocateElement(element asts.Element) (Cursor, error)
+}
@@ -0,0 +1,87 @@
+package diffs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+)
+
+type jsonDiff struct {
+	Changes []jsonChange `json:"changes"`
+}
+
+type jsonChange struct {
+	Kind   string `json:"kind"`
+	Path   string `json:"path"`
+	To     string `json:"to,omitempty"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+type adapter struct {
+	isSpanCompared bool
+}
+
+func createAdapter(
+	isSpanCompared bool,
+) Adapter {
+	out := adapter{
+		isSpanCompared: isSpanCompared,
+	}
+
+	return &out
+}
+
+// Compare returns the changes that transform the old AST into the new one
+func (app *adapter) Compare(from asts.AST, to asts.AST) (Diff, error) {
+	list, err := createComparer(app.isSpanCompared).compare(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return createDiff(list), nil
+}
+
+// ToText renders the diff as text, one change per line
+func (app *adapter) ToText(diff Diff) []byte {
+	buffer := bytes.Buffer{}
+	for _, oneChange := range diff.List() {
+		line := ""
+		switch oneChange.Kind() {
+		case KindInserted:
+			line = fmt.Sprintf("+ %s: %s", oneChange.Path(), oneChange.After())
+		case KindDeleted:
+			line = fmt.Sprintf("- %s: %s", oneChange.Path(), oneChange.Before())
+		case KindChanged:
+			line = fmt.Sprintf("~ %s: %s -> %s", oneChange.Path(), oneChange.Before(), oneChange.After())
+		case KindMoved:
+			line = fmt.Sprintf("> %s -> %s: %s", oneChange.Path(), oneChange.To(), oneChange.Before())
+		}
+
+		buffer.WriteString(line)
+		buffer.WriteString("\n")
+	}
+
+	return buffer.Bytes()
+}
+
+// ToJSON renders the diff as JSON
+func (app *adapter) ToJSON(diff Diff) ([]byte, error) {
+	output := jsonDiff{
+		Changes: []jsonChange{},
+	}
+
+	for _, oneChange := range diff.List() {
+		output.Changes = append(output.Changes, jsonChange{
+			Kind:   oneChange.Kind().String(),
+			Path:   oneChange.Path(),
+			To:     oneChange.To(),
+			Before: oneChange.Before(),
+			After:  oneChange.After(),
+		})
+	}
+
+	return json.Marshal(output)
+}
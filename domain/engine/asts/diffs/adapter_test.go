@@ -0,0 +1,242 @@
+package diffs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+)
+
+func TestAdapter_Success(t *testing.T) {
+	from := "a = 0; b = 1; end"
+	cases := map[string]string{
+		"a = 0; b = 1; end": "",
+		"a = 1; b = 1; end": strings.Join([]string{
+			"~ table/row[0][0]/bit[0][0]: bit#0 -> bit#1",
+			"- table/row[0][0]/bit[0][0]/N_ZERO[0]: N_ZERO (1)",
+			"+ table/row[0][0]/bit[0][0]/N_ONE[0]: N_ONE (1)",
+			"",
+		}, "\n"),
+		"b = 1; a = 0; end":        "> table/row[0][0] -> table/row[0][1]: row#0\n",
+		"a = 0; b = 1; a = 1; end": "+ table/row[0][2]: row#0\n",
+		"a = 0; b = 1;":            "- table/END[0]: END (1)\n",
+	}
+
+	fromAST, err := parse(from)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	adapter := NewAdapter()
+	for oneInput, expected := range cases {
+		toAST, err := parse(oneInput)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retDiff, err := adapter.Compare(fromAST, toAST)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if retDiff.IsEmpty() != (expected == "") {
+			t.Errorf("the diff of (%s) was expected to be empty: %t", oneInput, expected == "")
+			return
+		}
+
+		retText := string(adapter.ToText(retDiff))
+		if retText != expected {
+			t.Errorf("the diff of (%s) was expected to be:\n%s\nreturned:\n%s", oneInput, expected, retText)
+			return
+		}
+	}
+}
+
+func TestAdapter_toJSON_Success(t *testing.T) {
+	fromAST, err := parse("a = 0; b = 1; end")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	toAST, err := parse("b = 1; a = 0;")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	adapter := NewAdapter()
+	retDiff, err := adapter.Compare(fromAST, toAST)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retJSON, err := adapter.ToJSON(retDiff)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := `{"changes":[{"kind":"moved","path":"table/row[0][0]","to":"table/row[0][1]","before":"row#0"},{"kind":"deleted","path":"table/END[0]","before":"END (1)"}]}`
+	if string(retJSON) != expected {
+		t.Errorf("the JSON was expected to be:\n%s\nreturned:\n%s", expected, retJSON)
+		return
+	}
+}
+
+func TestAdapter_withSpans_Success(t *testing.T) {
+	fromAST, err := parse("a = 0;  end")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	toAST, err := parse("a = 0; end")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retDiff, err := NewAdapter().Compare(fromAST, toAST)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !retDiff.IsEmpty() {
+		t.Errorf("the spans were expected to be ignored")
+		return
+	}
+
+	retDiff, err = NewAdapterWithSpans().Compare(fromAST, toAST)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	list := retDiff.List()
+	if len(list) != 2 {
+		t.Errorf("the diff was expected to contain %d changes, %d returned", 2, len(list))
+		return
+	}
+
+	if list[0].Kind() != KindChanged || list[0].Path() != "table" || list[0].Before() != "table#0 @0..11" || list[0].After() != "table#0 @0..10" {
+		t.Errorf("the first change was expected to be the span of the root")
+		return
+	}
+}
+
+func TestAdapter_withDifferentRoots_Success(t *testing.T) {
+	fromAST, err := parse("a = 0;")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	constant, err := asts.NewConstantBuilder().Create().
+		WithName("END").
+		WithValue([]byte("end")).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	root, err := asts.NewElementBuilder().Create().
+		WithConstant(constant).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	toAST, err := asts.NewBuilder().Create().
+		WithRoot(root).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retDiff, err := NewAdapter().Compare(fromAST, toAST)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := "~ table: table#0 -> END \"end\"\n"
+	retText := string(NewAdapter().ToText(retDiff))
+	if retText != expected {
+		t.Errorf("the diff was expected to be:\n%s\nreturned:\n%s", expected, retText)
+		return
+	}
+}
+
+func parse(input string) (asts.AST, error) {
+	grammarAdapter := grammars.NewAdapter()
+	bitGrammar, _, err := grammarAdapter.ToGrammar([]byte(`
+		v1;
+		> .bit;
+		# .SPACE;
+
+		bit: .N_ZERO
+		   | .N_ONE
+		   ;
+
+		N_ZERO: "0";
+		N_ONE: "1";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		return nil, err
+	}
+
+	repository := grammars.NewRepositoryMemory(map[string]grammars.Grammar{})
+	err = repository.Insert([]string{"lang", "bit"}, bitGrammar)
+	if err != nil {
+		return nil, err
+	}
+
+	grammar, _, err := grammarAdapter.ToGrammar([]byte(`
+		v1;
+		> .table;
+		# .SPACE;
+
+		table: .row+ .END?
+			 ;
+
+		row: .name .EQUAL .bit[/lang/bit, ^1] .SEMICOLON
+		   ;
+
+		name: .LL_A
+			| .LL_B
+			;
+
+		END: "end";
+		EQUAL: "=";
+		SEMICOLON: ";";
+		LL_A: "a";
+		LL_B: "b";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		return nil, err
+	}
+
+	ast, _, err := asts.NewAdapter(repository).ToAST(grammar, []byte(input))
+	if err != nil {
+		return nil, err
+	}
+
+	return ast, nil
+}
@@ -0,0 +1,97 @@
+package diffs
+
+type change struct {
+	kind   Kind
+	path   string
+	to     string
+	before string
+	after  string
+}
+
+func createChangeWithInserted(
+	path string,
+	after string,
+) Change {
+	return createChangeInternally(KindInserted, path, "", "", after)
+}
+
+func createChangeWithDeleted(
+	path string,
+	before string,
+) Change {
+	return createChangeInternally(KindDeleted, path, "", before, "")
+}
+
+func createChangeWithChanged(
+	path string,
+	before string,
+	after string,
+) Change {
+	return createChangeInternally(KindChanged, path, "", before, after)
+}
+
+func createChangeWithMoved(
+	path string,
+	to string,
+	before string,
+) Change {
+	return createChangeInternally(KindMoved, path, to, before, "")
+}
+
+func createChangeInternally(
+	kind Kind,
+	path string,
+	to string,
+	before string,
+	after string,
+) Change {
+	out := change{
+		kind:   kind,
+		path:   path,
+		to:     to,
+		before: before,
+		after:  after,
+	}
+
+	return &out
+}
+
+// Kind returns the kind
+func (obj *change) Kind() Kind {
+	return obj.kind
+}
+
+// Path returns the path
+func (obj *change) Path() string {
+	return obj.path
+}
+
+// HasTo returns true if there is a destination path, false otherwise
+func (obj *change) HasTo() bool {
+	return obj.to != ""
+}
+
+// To returns the destination path, if any
+func (obj *change) To() string {
+	return obj.to
+}
+
+// HasBefore returns true if there is a before summary, false otherwise
+func (obj *change) HasBefore() bool {
+	return obj.before != ""
+}
+
+// Before returns the summary of the subtree in the old AST, if any
+func (obj *change) Before() string {
+	return obj.before
+}
+
+// HasAfter returns true if there is an after summary, false otherwise
+func (obj *change) HasAfter() bool {
+	return obj.after != ""
+}
+
+// After returns the summary of the subtree in the new AST, if any
+func (obj *change) After() string {
+	return obj.after
+}
@@ -0,0 +1,502 @@
+package diffs
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+)
+
+type entry struct {
+	change      Change
+	fingerprint string
+}
+
+// comparer holds the state of a single comparison
+type comparer struct {
+	isSpanCompared bool
+	fingerprints   map[asts.Element]string
+	entries        []entry
+}
+
+func createComparer(
+	isSpanCompared bool,
+) *comparer {
+	out := comparer{
+		isSpanCompared: isSpanCompared,
+		fingerprints:   map[asts.Element]string{},
+		entries:        []entry{},
+	}
+
+	return &out
+}
+
+// compare compares the ASTs and returns their changes, the deleted subtrees that are inserted elsewhere being moved
+func (app *comparer) compare(from asts.AST, to asts.AST) ([]Change, error) {
+	fromRoot := from.Root()
+	toRoot := to.Root()
+	err := app.element(fromRoot.Name(), toRoot.Name(), fromRoot, toRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	inserted := map[string][]int{}
+	for idx, oneEntry := range app.entries {
+		if oneEntry.change.Kind() == KindInserted {
+			inserted[oneEntry.fingerprint] = append(inserted[oneEntry.fingerprint], idx)
+		}
+	}
+
+	removed := map[int]bool{}
+	for idx, oneEntry := range app.entries {
+		if oneEntry.change.Kind() != KindDeleted || len(inserted[oneEntry.fingerprint]) <= 0 {
+			continue
+		}
+
+		insertedIndex := inserted[oneEntry.fingerprint][0]
+		inserted[oneEntry.fingerprint] = inserted[oneEntry.fingerprint][1:]
+		removed[insertedIndex] = true
+		app.entries[idx].change = createChangeWithMoved(
+			oneEntry.change.Path(),
+			app.entries[insertedIndex].change.Path(),
+			oneEntry.change.Before(),
+		)
+	}
+
+	output := []Change{}
+	for idx, oneEntry := range app.entries {
+		if removed[idx] {
+			continue
+		}
+
+		output = append(output, oneEntry.change)
+	}
+
+	return output, nil
+}
+
+func (app *comparer) element(fromPath string, toPath string, from asts.Element, to asts.Element) error {
+	fromFingerprint, err := app.fingerprint(from)
+	if err != nil {
+		return err
+	}
+
+	toFingerprint, err := app.fingerprint(to)
+	if err != nil {
+		return err
+	}
+
+	// the fingerprints exclude the spans, so identical subtrees are only walked to compare their spans:
+	if fromFingerprint == toFingerprint && !app.isSpanCompared {
+		return nil
+	}
+
+	fromSummary := app.summary(from)
+	toSummary := app.summary(to)
+	isSameKind := from.IsConstant() == to.IsConstant() && from.IsInstruction() == to.IsInstruction() && from.IsAST() == to.IsAST()
+	if !isSameKind || from.Name() != to.Name() {
+		app.entries = append(app.entries, entry{
+			change: createChangeWithChanged(fromPath, fromSummary, toSummary),
+		})
+
+		return nil
+	}
+
+	if fromSummary != toSummary {
+		app.entries = append(app.entries, entry{
+			change: createChangeWithChanged(fromPath, fromSummary, toSummary),
+		})
+	}
+
+	// the tokens of an embedded AST are compared at the path of its element, like the cursors navigate them:
+	if from.IsAST() {
+		return app.element(fromPath, toPath, from.AST().Root(), to.AST().Root())
+	}
+
+	if from.IsInstruction() {
+		return app.tokens(
+			fromPath,
+			toPath,
+			from.Instruction().Tokens().List(),
+			to.Instruction().Tokens().List(),
+		)
+	}
+
+	return nil
+}
+
+// tokens compares the tokens matched by name and occurrence
+func (app *comparer) tokens(fromPath string, toPath string, from []asts.Token, to []asts.Token) error {
+	fromPaths := tokenPaths(fromPath, from)
+	toPaths := tokenPaths(toPath, to)
+	toIndexes := map[string]int{}
+	for idx, onePath := range toPaths {
+		toIndexes[onePath] = idx
+	}
+
+	fromIndexes := map[string]int{}
+	for idx, oneToken := range from {
+		path := fromPaths[idx]
+		fromIndexes[path] = idx
+		toIndex, ok := toIndexes[relocate(path, fromPath, toPath)]
+		if !ok {
+			err := app.deleted(path, oneToken)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		err := app.token(path, toPaths[toIndex], oneToken, to[toIndex])
+		if err != nil {
+			return err
+		}
+	}
+
+	for idx, oneToken := range to {
+		path := toPaths[idx]
+		if _, ok := fromIndexes[relocate(path, toPath, fromPath)]; ok {
+			continue
+		}
+
+		err := app.inserted(path, oneToken)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// token aligns the elements on their longest common subsequence, then compares the remaining ones in order
+func (app *comparer) token(fromPath string, toPath string, from asts.Token, to asts.Token) error {
+	fromList := from.Elements().List()
+	toList := to.Elements().List()
+	fromFingerprints, err := app.fingerprintList(fromList)
+	if err != nil {
+		return err
+	}
+
+	toFingerprints, err := app.fingerprintList(toList)
+	if err != nil {
+		return err
+	}
+
+	pairs := align(fromFingerprints, toFingerprints)
+	fromMatched := map[int]bool{}
+	toMatched := map[int]bool{}
+	for _, onePair := range pairs {
+		fromMatched[onePair[0]] = true
+		toMatched[onePair[1]] = true
+		if !app.isSpanCompared {
+			continue
+		}
+
+		err := app.element(
+			elementPath(fromPath, onePair[0]),
+			elementPath(toPath, onePair[1]),
+			fromList[onePair[0]],
+			toList[onePair[1]],
+		)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	fromRemaining := []int{}
+	for idx := range fromList {
+		if !fromMatched[idx] {
+			fromRemaining = append(fromRemaining, idx)
+		}
+	}
+
+	toRemaining := []int{}
+	for idx := range toList {
+		if !toMatched[idx] {
+			toRemaining = append(toRemaining, idx)
+		}
+	}
+
+	// the elements that are identical to a remaining element of the other side are moved, not changed:
+	fromRemaining, fromMoved := partition(fromRemaining, fromFingerprints, toRemaining, toFingerprints)
+	toRemaining, toMoved := partition(toRemaining, toFingerprints, fromMoved, fromFingerprints)
+	for _, oneIndex := range fromMoved {
+		app.entries = append(app.entries, entry{
+			change:      createChangeWithDeleted(elementPath(fromPath, oneIndex), app.summary(fromList[oneIndex])),
+			fingerprint: fromFingerprints[oneIndex],
+		})
+	}
+
+	for _, oneIndex := range toMoved {
+		app.entries = append(app.entries, entry{
+			change:      createChangeWithInserted(elementPath(toPath, oneIndex), app.summary(toList[oneIndex])),
+			fingerprint: toFingerprints[oneIndex],
+		})
+	}
+
+	for idx, oneFromIndex := range fromRemaining {
+		if idx >= len(toRemaining) {
+			app.entries = append(app.entries, entry{
+				change:      createChangeWithDeleted(elementPath(fromPath, oneFromIndex), app.summary(fromList[oneFromIndex])),
+				fingerprint: fromFingerprints[oneFromIndex],
+			})
+
+			continue
+		}
+
+		oneToIndex := toRemaining[idx]
+		err := app.element(
+			elementPath(fromPath, oneFromIndex),
+			elementPath(toPath, oneToIndex),
+			fromList[oneFromIndex],
+			toList[oneToIndex],
+		)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, oneToIndex := range toRemaining[min(len(fromRemaining), len(toRemaining)):] {
+		app.entries = append(app.entries, entry{
+			change:      createChangeWithInserted(elementPath(toPath, oneToIndex), app.summary(toList[oneToIndex])),
+			fingerprint: toFingerprints[oneToIndex],
+		})
+	}
+
+	return nil
+}
+
+func (app *comparer) deleted(path string, token asts.Token) error {
+	fingerprint, err := app.tokenFingerprint(token)
+	if err != nil {
+		return err
+	}
+
+	app.entries = append(app.entries, entry{
+		change:      createChangeWithDeleted(path, tokenHead(token)),
+		fingerprint: fingerprint,
+	})
+
+	return nil
+}
+
+func (app *comparer) inserted(path string, token asts.Token) error {
+	fingerprint, err := app.tokenFingerprint(token)
+	if err != nil {
+		return err
+	}
+
+	app.entries = append(app.entries, entry{
+		change:      createChangeWithInserted(path, tokenHead(token)),
+		fingerprint: fingerprint,
+	})
+
+	return nil
+}
+
+// summary returns the head of the element, followed by its span when the spans are compared
+func (app *comparer) summary(element asts.Element) string {
+	head := app.head(element)
+	if app.isSpanCompared && element.HasSpan() {
+		span := element.Span()
+		return fmt.Sprintf("%s @%d..%d", head, span.Start(), span.End())
+	}
+
+	return head
+}
+
+// head returns the summary of the element, without its descendants
+func (app *comparer) head(element asts.Element) string {
+	head := ""
+	if element.IsConstant() {
+		constant := element.Constant()
+		head = fmt.Sprintf("%s %s", constant.Name(), value(constant.Value()))
+	}
+
+	if element.IsInstruction() {
+		instruction := element.Instruction()
+		head = fmt.Sprintf("%s#%d", instruction.Block(), instruction.Line())
+	}
+
+	if element.IsAST() {
+		head = astKeyname
+		ast := element.AST()
+		if ast.HasVersion() {
+			head = fmt.Sprintf("%s v%s", head, ast.Version().String())
+		}
+	}
+
+	return head
+}
+
+// fingerprint returns the hash of the subtree of the element, its spans and trivia excluded
+func (app *comparer) fingerprint(element asts.Element) (string, error) {
+	if fingerprint, ok := app.fingerprints[element]; ok {
+		return fingerprint, nil
+	}
+
+	sections := []string{
+		strconv.Quote(app.head(element)),
+	}
+
+	if element.IsAST() {
+		retFingerprint, err := app.fingerprint(element.AST().Root())
+		if err != nil {
+			return "", err
+		}
+
+		sections = append(sections, retFingerprint)
+	}
+
+	if element.IsInstruction() {
+		for _, oneToken := range element.Instruction().Tokens().List() {
+			retFingerprint, err := app.tokenFingerprint(oneToken)
+			if err != nil {
+				return "", err
+			}
+
+			sections = append(sections, retFingerprint)
+		}
+	}
+
+	if !element.IsConstant() && !element.IsInstruction() && !element.IsAST() {
+		str := fmt.Sprintf("the element (name: %s) is neither a constant, an instruction or an AST", element.Name())
+		return "", errors.New(str)
+	}
+
+	fingerprint := hash(sections)
+	app.fingerprints[element] = fingerprint
+	return fingerprint, nil
+}
+
+func (app *comparer) tokenFingerprint(token asts.Token) (string, error) {
+	sections, err := app.fingerprintList(token.Elements().List())
+	if err != nil {
+		return "", err
+	}
+
+	return hash(append([]string{strconv.Quote(token.Name())}, sections...)), nil
+}
+
+func (app *comparer) fingerprintList(list []asts.Element) ([]string, error) {
+	output := []string{}
+	for _, oneElement := range list {
+		retFingerprint, err := app.fingerprint(oneElement)
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, retFingerprint)
+	}
+
+	return output, nil
+}
+
+// align returns the pairs of indexes of both lists that belong to their longest common subsequence
+func align(from []string, to []string) [][2]int {
+	lengths := make([][]int, len(from)+1)
+	for idx := range lengths {
+		lengths[idx] = make([]int, len(to)+1)
+	}
+
+	for i := len(from) - 1; i >= 0; i-- {
+		for j := len(to) - 1; j >= 0; j-- {
+			if from[i] == to[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+				continue
+			}
+
+			lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+		}
+	}
+
+	output := [][2]int{}
+	i, j := 0, 0
+	for i < len(from) && j < len(to) {
+		if from[i] == to[j] {
+			output = append(output, [2]int{i, j})
+			i++
+			j++
+			continue
+		}
+
+		if lengths[i+1][j] >= lengths[i][j+1] {
+			i++
+			continue
+		}
+
+		j++
+	}
+
+	return output
+}
+
+// partition splits the indexes between the ones whose fingerprint is not found amongst the other indexes, and the ones that are
+func partition(indexes []int, fingerprints []string, otherIndexes []int, otherFingerprints []string) ([]int, []int) {
+	others := map[string]int{}
+	for _, oneIndex := range otherIndexes {
+		others[otherFingerprints[oneIndex]]++
+	}
+
+	remaining := []int{}
+	found := []int{}
+	for _, oneIndex := range indexes {
+		fingerprint := fingerprints[oneIndex]
+		if others[fingerprint] > 0 {
+			others[fingerprint]--
+			found = append(found, oneIndex)
+			continue
+		}
+
+		remaining = append(remaining, oneIndex)
+	}
+
+	return remaining, found
+}
+
+func tokenPaths(parent string, list []asts.Token) []string {
+	occurrences := map[string]uint{}
+	output := []string{}
+	for _, oneToken := range list {
+		name := oneToken.Name()
+		output = append(output, fmt.Sprintf("%s%s%s[%d]", parent, pathSeparator, name, occurrences[name]))
+		occurrences[name]++
+	}
+
+	return output
+}
+
+func tokenHead(token asts.Token) string {
+	return fmt.Sprintf("%s (%d)", token.Name(), len(token.Elements().List()))
+}
+
+func elementPath(tokenPath string, index int) string {
+	return fmt.Sprintf("%s[%d]", tokenPath, index)
+}
+
+// relocate replaces the parent prefix of the path
+func relocate(path string, from string, to string) string {
+	return to + strings.TrimPrefix(path, from)
+}
+
+func hash(sections []string) string {
+	sum := sha256.Sum256([]byte(strings.Join(sections, ",")))
+	return hex.EncodeToString(sum[:])
+}
+
+// value renders the bytes as an escaped string, or in hexadecimal when they are not valid UTF-8
+func value(input []byte) string {
+	if !utf8.Valid(input) {
+		return hexPrefix + hex.EncodeToString(input)
+	}
+
+	return strconv.Quote(string(input))
+}
@@ -0,0 +1,25 @@
+package diffs
+
+type diff struct {
+	list []Change
+}
+
+func createDiff(
+	list []Change,
+) Diff {
+	out := diff{
+		list: list,
+	}
+
+	return &out
+}
+
+// IsEmpty returns true if there is no change, false otherwise
+func (obj *diff) IsEmpty() bool {
+	return len(obj.list) <= 0
+}
+
+// List returns the changes
+func (obj *diff) List() []Change {
+	return obj.list
+}
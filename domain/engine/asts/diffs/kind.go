@@ -0,0 +1,17 @@
+package diffs
+
+// String returns the name of the kind
+func (obj Kind) String() string {
+	switch obj {
+	case KindInserted:
+		return "inserted"
+	case KindDeleted:
+		return "deleted"
+	case KindChanged:
+		return "changed"
+	case KindMoved:
+		return "moved"
+	}
+
+	return "unknown"
+}
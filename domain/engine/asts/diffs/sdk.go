@@ -0,0 +1,68 @@
+package diffs
+
+import "github.com/steve-care-software/grammars/domain/engine/asts"
+
+// Kind represents the kind of a change
+type Kind uint8
+
+const (
+	// KindInserted represents a subtree that only exists in the new AST
+	KindInserted Kind = iota
+
+	// KindDeleted represents a subtree that only exists in the old AST
+	KindDeleted
+
+	// KindChanged represents a block, line, constant, version or span that differs between the ASTs
+	KindChanged
+
+	// KindMoved represents a subtree that exists in both ASTs, at different paths
+	KindMoved
+)
+
+const pathSeparator = "/"
+const astKeyname = "ast"
+const hexPrefix = "0x"
+
+// NewAdapter creates a new adapter that ignores the spans of the elements
+func NewAdapter() Adapter {
+	return createAdapter(false)
+}
+
+// NewAdapterWithSpans creates a new adapter that reports the spans that differ as changes
+func NewAdapterWithSpans() Adapter {
+	return createAdapter(true)
+}
+
+// Adapter compares ASTs structurally and renders their differences
+type Adapter interface {
+	// Compare returns the changes that transform the old AST into the new one
+	Compare(from asts.AST, to asts.AST) (Diff, error)
+
+	// ToText renders the diff as text, one change per line
+	ToText(diff Diff) []byte
+
+	// ToJSON renders the diff as JSON
+	ToJSON(diff Diff) ([]byte, error)
+}
+
+// Diff represents the changes between two ASTs
+type Diff interface {
+	IsEmpty() bool
+	List() []Change
+}
+
+// Change represents a change, whose paths use the format of the cursors, such as program/usage[0][0]/name[0]
+type Change interface {
+	Kind() Kind
+
+	// Path returns the path in the old AST, or in the new AST when the subtree is inserted
+	Path() string
+	HasTo() bool
+
+	// To returns the path in the new AST of a moved subtree
+	To() string
+	HasBefore() bool
+	Before() string
+	HasAfter() bool
+	After() string
+}
@@ -8,43 +8,40 @@ type element struct {
 	constant    Constant
 	instruction Instruction
 	ast         AST
+	span        Span
+	trivia      Trivia
 }
 
-func createElementWithConstant(constant Constant) Element {
-	return createElementInternally(constant, nil, nil)
+func createElementWithConstant(constant Constant, span Span, trivia Trivia) Element {
+	return createElementInternally(constant, nil, nil, span, trivia)
 }
 
-func createElementWithInstruction(instruction Instruction) Element {
-	return createElementInternally(nil, instruction, nil)
+func createElementWithInstruction(instruction Instruction, span Span, trivia Trivia) Element {
+	return createElementInternally(nil, instruction, nil, span, trivia)
 }
 
-func createElementWithAST(ast AST) Element {
-	return createElementInternally(nil, nil, ast)
+func createElementWithAST(ast AST, span Span, trivia Trivia) Element {
+	return createElementInternally(nil, nil, ast, span, trivia)
 }
 
 func createElementInternally(
 	constant Constant,
 	instruction Instruction,
 	ast AST,
+	span Span,
+	trivia Trivia,
 ) Element {
 	out := element{
 		constant:    constant,
 		instruction: instruction,
 		ast:         ast,
+		span:        span,
+		trivia:      trivia,
 	}
 
 	return &out
 }
 
-// Validate validates an element
-func (obj *element) Validate(elementNameIndex map[string]BlockCount) (map[string]BlockCount, error) {
-	if obj.IsConstant() {
-		return elementNameIndex, nil
-	}
-
-	return obj.instruction.Validate(elementNameIndex)
-}
-
 // Name returns the name
 func (obj *element) Name() string {
 	if obj.IsConstant() {
@@ -88,12 +85,36 @@ func (obj *element) AST() AST {
 	return obj.ast
 }
 
+// HasSpan returns true if there is a span, false otherwise
+func (obj *element) HasSpan() bool {
+	return obj.span != nil
+}
+
+// Span returns the span, if any
+func (obj *element) Span() Span {
+	return obj.span
+}
+
+// HasTrivia returns true if there is a trivia, false otherwise
+func (obj *element) HasTrivia() bool {
+	return obj.trivia != nil
+}
+
+// Trivia returns the trivia, if any
+func (obj *element) Trivia() Trivia {
+	return obj.trivia
+}
+
 // Value returns the value of the elements
 func (obj *element) Value() []byte {
 	if obj.IsConstant() {
 		return obj.constant.Value()
 	}
 
+	if obj.IsAST() {
+		return obj.ast.Root().Value()
+	}
+
 	return obj.instruction.Tokens().Value()
 }
 
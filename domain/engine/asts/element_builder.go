@@ -8,6 +8,8 @@ type elementBuilder struct {
 	constant    Constant
 	instruction Instruction
 	ast         AST
+	span        Span
+	trivia      Trivia
 }
 
 func createElementBuilder() ElementBuilder {
@@ -15,6 +17,8 @@ func createElementBuilder() ElementBuilder {
 		constant:    nil,
 		instruction: nil,
 		ast:         nil,
+		span:        nil,
+		trivia:      nil,
 	}
 
 	return &out
@@ -43,18 +47,30 @@ func (app *elementBuilder) WithAST(ast AST) ElementBuilder {
 	return app
 }
 
+// WithSpan adds a span to the elementBuilder
+func (app *elementBuilder) WithSpan(span Span) ElementBuilder {
+	app.span = span
+	return app
+}
+
+// WithTrivia adds a trivia to the elementBuilder
+func (app *elementBuilder) WithTrivia(trivia Trivia) ElementBuilder {
+	app.trivia = trivia
+	return app
+}
+
 // Now builds a new Element instance
 func (app *elementBuilder) Now() (Element, error) {
 	if app.constant != nil {
-		return createElementWithConstant(app.constant), nil
+		return createElementWithConstant(app.constant, app.span, app.trivia), nil
 	}
 
 	if app.instruction != nil {
-		return createElementWithInstruction(app.instruction), nil
+		return createElementWithInstruction(app.instruction, app.span, app.trivia), nil
 	}
 
 	if app.ast != nil {
-		return createElementWithAST(app.ast), nil
+		return createElementWithAST(app.ast, app.span, app.trivia), nil
 	}
 
 	return nil, errors.New("the Element is invalid")
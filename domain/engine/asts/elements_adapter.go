@@ -75,12 +75,44 @@ func (app *elementsAdapter) elementsToBytes(
 
 func (app *elementsAdapter) elementToBytes(
 	element Element,
+) ([]byte, error) {
+	content, err := app.elementContentToBytes(
+		element,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !element.HasTrivia() {
+		return content, nil
+	}
+
+	trivia := element.Trivia()
+	if trivia.HasSource() {
+		content = trivia.Source()
+	}
+
+	output := []byte{}
+	output = append(output, trivia.Leading()...)
+	output = append(output, content...)
+	return append(output, trivia.Trailing()...), nil
+}
+
+func (app *elementsAdapter) elementContentToBytes(
+	element Element,
 ) ([]byte, error) {
 	if element.IsConstant() {
 		rule := element.Constant()
 		return rule.Value(), nil
 	}
 
+	if element.IsAST() {
+		return app.elementToBytes(
+			element.AST().Root(),
+		)
+	}
+
 	instruction := element.Instruction()
 	return app.instructionToBytes(
 		instruction,
@@ -0,0 +1,626 @@
+package encodings
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements/references"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/uniques"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+)
+
+type adapter struct {
+	encode                encodeFn
+	decode                decodeFn
+	builder               asts.Builder
+	instructionBuilder    asts.InstructionBuilder
+	tokensBuilder         asts.TokensBuilder
+	tokenBuilder          asts.TokenBuilder
+	elementsBuilder       asts.ElementsBuilder
+	elementBuilder        asts.ElementBuilder
+	constantBuilder       asts.ConstantBuilder
+	spanBuilder           asts.SpanBuilder
+	triviaBuilder         asts.TriviaBuilder
+	uniqueBuilder         uniques.Builder
+	grammarElementBuilder elements.ElementBuilder
+	referenceBuilder      references.Builder
+	versionAdapter        versions.Adapter
+}
+
+func createAdapter(
+	encode encodeFn,
+	decode decodeFn,
+	builder asts.Builder,
+	instructionBuilder asts.InstructionBuilder,
+	tokensBuilder asts.TokensBuilder,
+	tokenBuilder asts.TokenBuilder,
+	elementsBuilder asts.ElementsBuilder,
+	elementBuilder asts.ElementBuilder,
+	constantBuilder asts.ConstantBuilder,
+	spanBuilder asts.SpanBuilder,
+	triviaBuilder asts.TriviaBuilder,
+	uniqueBuilder uniques.Builder,
+	grammarElementBuilder elements.ElementBuilder,
+	referenceBuilder references.Builder,
+	versionAdapter versions.Adapter,
+) Adapter {
+	out := adapter{
+		encode:                encode,
+		decode:                decode,
+		builder:               builder,
+		instructionBuilder:    instructionBuilder,
+		tokensBuilder:         tokensBuilder,
+		tokenBuilder:          tokenBuilder,
+		elementsBuilder:       elementsBuilder,
+		elementBuilder:        elementBuilder,
+		constantBuilder:       constantBuilder,
+		spanBuilder:           spanBuilder,
+		triviaBuilder:         triviaBuilder,
+		uniqueBuilder:         uniqueBuilder,
+		grammarElementBuilder: grammarElementBuilder,
+		referenceBuilder:      referenceBuilder,
+		versionAdapter:        versionAdapter,
+	}
+
+	return &out
+}
+
+// ToBytes encodes the AST
+func (app *adapter) ToBytes(ast asts.AST) ([]byte, error) {
+	return app.encode(astToValue(ast))
+}
+
+// ToAST decodes the input to an AST
+func (app *adapter) ToAST(input []byte) (asts.AST, error) {
+	value, err := app.decode(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return app.toAST(value)
+}
+
+func (app *adapter) toAST(value any) (asts.AST, error) {
+	mp, err := toMap(value, keyAST)
+	if err != nil {
+		return nil, err
+	}
+
+	rootValue, err := fetch(mp, keyRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := app.toElement(rootValue)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := app.builder.Create().
+		WithRoot(root)
+
+	if versionValue, ok := mp[keyVersion]; ok {
+		str, err := toString(versionValue, keyVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		version, err := app.versionAdapter.ToVersion([]byte(str))
+		if err != nil {
+			return nil, err
+		}
+
+		builder.WithVersion(version)
+	}
+
+	return builder.Now()
+}
+
+func (app *adapter) toElement(value any) (asts.Element, error) {
+	mp, err := toMap(value, keyElement)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := 0
+	builder := app.elementBuilder.Create()
+	if constantValue, ok := mp[keyConstant]; ok {
+		constant, err := app.toConstant(constantValue)
+		if err != nil {
+			return nil, err
+		}
+
+		builder.WithConstant(constant)
+		amount++
+	}
+
+	if instructionValue, ok := mp[keyInstruction]; ok {
+		instruction, err := app.toInstruction(instructionValue)
+		if err != nil {
+			return nil, err
+		}
+
+		builder.WithInstruction(instruction)
+		amount++
+	}
+
+	if astValue, ok := mp[keyAST]; ok {
+		ast, err := app.toAST(astValue)
+		if err != nil {
+			return nil, err
+		}
+
+		builder.WithAST(ast)
+		amount++
+	}
+
+	if amount != 1 {
+		str := fmt.Sprintf("the element was expected to contain exactly 1 of a constant, an instruction or an AST, %d provided", amount)
+		return nil, errors.New(str)
+	}
+
+	if spanValue, ok := mp[keySpan]; ok {
+		span, err := app.toSpan(spanValue)
+		if err != nil {
+			return nil, err
+		}
+
+		builder.WithSpan(span)
+	}
+
+	if triviaValue, ok := mp[keyTrivia]; ok {
+		trivia, err := app.toTrivia(triviaValue)
+		if err != nil {
+			return nil, err
+		}
+
+		builder.WithTrivia(trivia)
+	}
+
+	return builder.Now()
+}
+
+func (app *adapter) toTrivia(value any) (asts.Trivia, error) {
+	mp, err := toMap(value, keyTrivia)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := app.triviaBuilder.Create()
+	if leadingValue, ok := mp[keyLeading]; ok {
+		leading, err := toBytes(leadingValue, keyLeading)
+		if err != nil {
+			return nil, err
+		}
+
+		builder.WithLeading(leading)
+	}
+
+	if trailingValue, ok := mp[keyTrailing]; ok {
+		trailing, err := toBytes(trailingValue, keyTrailing)
+		if err != nil {
+			return nil, err
+		}
+
+		builder.WithTrailing(trailing)
+	}
+
+	if sourceValue, ok := mp[keySource]; ok {
+		source, err := toBytes(sourceValue, keySource)
+		if err != nil {
+			return nil, err
+		}
+
+		builder.WithSource(source)
+	}
+
+	return builder.Now()
+}
+
+func (app *adapter) toSpan(value any) (asts.Span, error) {
+	mp, err := toMap(value, keySpan)
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := fetchUint(mp, keyStart)
+	if err != nil {
+		return nil, err
+	}
+
+	end, err := fetchUint(mp, keyEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	return app.spanBuilder.Create().
+		WithStart(start).
+		WithEnd(end).
+		Now()
+}
+
+func (app *adapter) toConstant(value any) (asts.Constant, error) {
+	mp, err := toMap(value, keyConstant)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := fetchString(mp, keyName)
+	if err != nil {
+		return nil, err
+	}
+
+	constantValue, err := fetch(mp, keyValue)
+	if err != nil {
+		return nil, err
+	}
+
+	bytes, err := toBytes(constantValue, keyValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return app.constantBuilder.Create().
+		WithName(name).
+		WithValue(bytes).
+		Now()
+}
+
+func (app *adapter) toInstruction(value any) (asts.Instruction, error) {
+	mp, err := toMap(value, keyInstruction)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := fetchString(mp, keyBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	line, err := fetchUint(mp, keyLine)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensList, err := fetchList(mp, keyTokens)
+	if err != nil {
+		return nil, err
+	}
+
+	list := []asts.Token{}
+	for _, oneValue := range tokensList {
+		token, err := app.toToken(oneValue)
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, token)
+	}
+
+	tokens, err := app.tokensBuilder.Create().
+		WithList(list).
+		Now()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return app.instructionBuilder.Create().
+		WithBlock(block).
+		WithLine(line).
+		WithTokens(tokens).
+		Now()
+}
+
+func (app *adapter) toToken(value any) (asts.Token, error) {
+	mp, err := toMap(value, keyTokens)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := fetchString(mp, keyName)
+	if err != nil {
+		return nil, err
+	}
+
+	elementsList, err := fetchList(mp, keyElements)
+	if err != nil {
+		return nil, err
+	}
+
+	list := []asts.Element{}
+	for _, oneValue := range elementsList {
+		element, err := app.toElement(oneValue)
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, element)
+	}
+
+	elements, err := app.elementsBuilder.Create().
+		WithList(list).
+		Now()
+
+	if err != nil {
+		return nil, err
+	}
+
+	builder := app.tokenBuilder.Create().
+		WithName(name).
+		WithElements(elements)
+
+	if uniqueValue, ok := mp[keyUnique]; ok {
+		unique, err := app.toUnique(uniqueValue)
+		if err != nil {
+			return nil, err
+		}
+
+		builder.WithUnique(unique)
+	}
+
+	return builder.Now()
+}
+
+func (app *adapter) toUnique(value any) (uniques.Unique, error) {
+	mp, err := toMap(value, keyUnique)
+	if err != nil {
+		return nil, err
+	}
+
+	mode, err := fetchString(mp, keyMode)
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := fetchUint(mp, keyIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	elementValue, err := fetch(mp, keyElement)
+	if err != nil {
+		return nil, err
+	}
+
+	element, err := app.toGrammarElement(elementValue)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := app.uniqueBuilder.Create().
+		WithElement(element).
+		WithIndex(index)
+
+	switch mode {
+	case uniqueModeMustBe:
+		builder.MustBe()
+	case uniqueModeMustNot:
+		builder.MustNot()
+	default:
+		str := fmt.Sprintf("the unique mode (%s) is invalid, %s or %s expected", mode, uniqueModeMustBe, uniqueModeMustNot)
+		return nil, errors.New(str)
+	}
+
+	return builder.Now()
+}
+
+func (app *adapter) toGrammarElement(value any) (elements.Element, error) {
+	mp, err := toMap(value, keyElement)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(mp) != 1 {
+		str := fmt.Sprintf("the grammar element was expected to contain exactly 1 of a rule, a block, a constant or a reference, %d provided", len(mp))
+		return nil, errors.New(str)
+	}
+
+	builder := app.grammarElementBuilder.Create()
+	if _, ok := mp[keyReference]; ok {
+		reference, err := app.toReference(mp[keyReference])
+		if err != nil {
+			return nil, err
+		}
+
+		return builder.WithReference(reference).Now()
+	}
+
+	for key, oneValue := range mp {
+		name, err := toString(oneValue, key)
+		if err != nil {
+			return nil, err
+		}
+
+		switch key {
+		case keyRule:
+			builder.WithRule(name)
+		case keyBlock:
+			builder.WithBlock(name)
+		case keyConstant:
+			builder.WithConstant(name)
+		default:
+			str := fmt.Sprintf("the grammar element contains an invalid key (%s)", key)
+			return nil, errors.New(str)
+		}
+	}
+
+	return builder.Now()
+}
+
+func (app *adapter) toReference(value any) (references.Reference, error) {
+	mp, err := toMap(value, keyReference)
+	if err != nil {
+		return nil, err
+	}
+
+	pathList, err := fetchList(mp, keyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	path := []string{}
+	for _, oneValue := range pathList {
+		segment, err := toString(oneValue, keyPath)
+		if err != nil {
+			return nil, err
+		}
+
+		path = append(path, segment)
+	}
+
+	name, err := fetchString(mp, keyName)
+	if err != nil {
+		return nil, err
+	}
+
+	constraintStr, err := fetchString(mp, keyConstraint)
+	if err != nil {
+		return nil, err
+	}
+
+	constraint, err := app.versionAdapter.ToConstraint([]byte(constraintStr))
+	if err != nil {
+		return nil, err
+	}
+
+	return app.referenceBuilder.Create().
+		WithPath(path).
+		WithName(name).
+		WithConstraint(constraint).
+		Now()
+}
+
+func astToValue(ast asts.AST) map[string]any {
+	output := map[string]any{
+		keyRoot: elementToValue(ast.Root()),
+	}
+
+	if ast.HasVersion() {
+		output[keyVersion] = ast.Version().String()
+	}
+
+	return output
+}
+
+func elementToValue(element asts.Element) map[string]any {
+	output := map[string]any{}
+	if element.HasSpan() {
+		span := element.Span()
+		output[keySpan] = map[string]any{
+			keyStart: uint64(span.Start()),
+			keyEnd:   uint64(span.End()),
+		}
+	}
+
+	if element.HasTrivia() {
+		trivia := element.Trivia()
+		triviaValue := map[string]any{}
+		if trivia.HasLeading() {
+			triviaValue[keyLeading] = trivia.Leading()
+		}
+
+		if trivia.HasTrailing() {
+			triviaValue[keyTrailing] = trivia.Trailing()
+		}
+
+		if trivia.HasSource() {
+			triviaValue[keySource] = trivia.Source()
+		}
+
+		output[keyTrivia] = triviaValue
+	}
+
+	if element.IsConstant() {
+		constant := element.Constant()
+		output[keyConstant] = map[string]any{
+			keyName:  constant.Name(),
+			keyValue: constant.Value(),
+		}
+
+		return output
+	}
+
+	if element.IsAST() {
+		output[keyAST] = astToValue(element.AST())
+		return output
+	}
+
+	instruction := element.Instruction()
+	tokensList := []any{}
+	for _, oneToken := range instruction.Tokens().List() {
+		tokensList = append(tokensList, tokenToValue(oneToken))
+	}
+
+	output[keyInstruction] = map[string]any{
+		keyBlock:  instruction.Block(),
+		keyLine:   uint64(instruction.Line()),
+		keyTokens: tokensList,
+	}
+
+	return output
+}
+
+func tokenToValue(token asts.Token) map[string]any {
+	elementsList := []any{}
+	for _, oneElement := range token.Elements().List() {
+		elementsList = append(elementsList, elementToValue(oneElement))
+	}
+
+	output := map[string]any{
+		keyName:     token.Name(),
+		keyElements: elementsList,
+	}
+
+	if token.HasUnique() {
+		unique := token.Unique()
+		mode := uniqueModeMustBe
+		if unique.MustNot() {
+			mode = uniqueModeMustNot
+		}
+
+		output[keyUnique] = map[string]any{
+			keyMode:    mode,
+			keyIndex:   uint64(unique.Index()),
+			keyElement: grammarElementToValue(unique.Element()),
+		}
+	}
+
+	return output
+}
+
+func grammarElementToValue(element elements.Element) map[string]any {
+	if element.IsRule() {
+		return map[string]any{
+			keyRule: element.Rule(),
+		}
+	}
+
+	if element.IsBlock() {
+		return map[string]any{
+			keyBlock: element.Block(),
+		}
+	}
+
+	if element.IsConstant() {
+		return map[string]any{
+			keyConstant: element.Constant(),
+		}
+	}
+
+	reference := element.Reference()
+	path := []any{}
+	for _, oneSegment := range reference.Path() {
+		path = append(path, oneSegment)
+	}
+
+	return map[string]any{
+		keyReference: map[string]any{
+			keyPath:       path,
+			keyName:       reference.Name(),
+			keyConstraint: reference.Constraint().String(),
+		},
+	}
+}
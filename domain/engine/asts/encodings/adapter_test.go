@@ -0,0 +1,249 @@
+package encodings
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+)
+
+func TestAdapter_Success(t *testing.T) {
+	ast, err := parse("let a = 0; let b = 1; use a;", false)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	adapters := map[string]Adapter{
+		"json": NewJSONAdapter(),
+		"cbor": NewCBORAdapter(),
+	}
+
+	for name, oneAdapter := range adapters {
+		retBytes, err := oneAdapter.ToBytes(ast)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retAST, err := oneAdapter.ToAST(retBytes)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retReencoded, err := oneAdapter.ToBytes(retAST)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if !bytes.Equal(retBytes, retReencoded) {
+			t.Errorf("the %s encoding was expected to be the same once decoded and encoded again", name)
+			return
+		}
+
+		if !retAST.HasVersion() || retAST.Version().String() != "1.0.0" {
+			t.Errorf("the %s encoding was expected to preserve the version", name)
+			return
+		}
+
+		root := retAST.Root()
+		if !root.HasSpan() || root.Span().Start() != 0 || root.Span().End() != 28 {
+			t.Errorf("the %s encoding was expected to preserve the spans", name)
+			return
+		}
+
+		retDeclaration, err := root.Search("declaration", 0)
+		if err != nil || retDeclaration == nil {
+			t.Errorf("the %s encoding was expected to preserve the tokens", name)
+			return
+		}
+
+		retSecondDeclaration, err := retDeclaration.Elements().Fetch(1)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retValue, err := retSecondDeclaration.Search("value", 0)
+		if err != nil || retValue == nil {
+			t.Errorf("the %s encoding was expected to preserve the tokens", name)
+			return
+		}
+
+		retElement, err := retValue.Elements().Fetch(0)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if !retElement.IsAST() || retElement.AST().Version().String() != "1.2.0" || retElement.Span().Start() != 19 {
+			t.Errorf("the %s encoding was expected to preserve the embedded AST", name)
+			return
+		}
+
+		retName, err := retSecondDeclaration.Search("name", 0)
+		if err != nil || retName == nil || !retName.HasUnique() || !retName.Unique().MustBe() {
+			t.Errorf("the %s encoding was expected to preserve the uniques", name)
+			return
+		}
+	}
+}
+
+func TestAdapter_withTrivia_Success(t *testing.T) {
+	input := " let a  =   0 ;  use  a; "
+	ast, err := parse(input, true)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	for _, oneAdapter := range []Adapter{NewJSONAdapter(), NewCBORAdapter()} {
+		retBytes, err := oneAdapter.ToBytes(ast)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retAST, err := oneAdapter.ToAST(retBytes)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		elements, err := asts.NewElementsBuilder().Create().WithList([]asts.Element{retAST.Root()}).Now()
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retInput, err := asts.NewElementsAdapter().ToBytes(elements)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if string(retInput) != input {
+			t.Errorf("the input was expected to be '%s', '%s' returned", input, retInput)
+			return
+		}
+	}
+}
+
+func TestAdapter_json_withDuplicateUnique_returnsError(t *testing.T) {
+	ast, err := parse("let a = 0; let b = 1;", false)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	adapter := NewJSONAdapter()
+	retBytes, err := adapter.ToBytes(ast)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// the name b is replaced by a, so the decoded tree no longer validates:
+	tampered := bytes.ReplaceAll(retBytes, []byte(`"Yg=="`), []byte(`"YQ=="`))
+	_, err = adapter.ToAST(tampered)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestAdapter_cbor_withTruncatedInput_returnsError(t *testing.T) {
+	ast, err := parse("let a = 0;", false)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	adapter := NewCBORAdapter()
+	retBytes, err := adapter.ToBytes(ast)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	for _, oneInput := range [][]byte{retBytes[:len(retBytes)-1], append(retBytes, 0)} {
+		_, err = adapter.ToAST(oneInput)
+		if err == nil {
+			t.Errorf("the error was expected to be valid, nil returned")
+			return
+		}
+	}
+}
+
+func parse(input string, isLossless bool) (asts.AST, error) {
+	grammarAdapter := grammars.NewAdapter()
+	valueGrammar, _, err := grammarAdapter.ToGrammar([]byte(`
+		v1.2;
+		> .value;
+		# .SPACE;
+
+		value: .N_ZERO
+			 | .N_ONE
+			 ;
+
+		N_ZERO: "0";
+		N_ONE: "1";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		return nil, err
+	}
+
+	repository := grammars.NewRepositoryMemory(map[string]grammars.Grammar{})
+	err = repository.Insert([]string{"lang", "value"}, valueGrammar)
+	if err != nil {
+		return nil, err
+	}
+
+	grammar, _, err := grammarAdapter.ToGrammar([]byte(`
+		v1;
+		> .program;
+		# .SPACE;
+
+		program: .declaration+ .usage*
+				;
+
+		declaration: .LET #.program .name .EQUAL .value[/lang/value, ^1] .SEMICOLON
+					;
+
+		usage: .USE $.program .name .SEMICOLON
+				;
+
+		name: .LL_A
+			| .LL_B
+			;
+
+		LET: "let";
+		USE: "use";
+		EQUAL: "=";
+		SEMICOLON: ";";
+		LL_A: "a";
+		LL_B: "b";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		return nil, err
+	}
+
+	adapter := asts.NewAdapter(repository)
+	if isLossless {
+		adapter = asts.NewLosslessAdapter(repository)
+	}
+
+	ast, _, err := adapter.ToAST(grammar, []byte(input))
+	if err != nil {
+		return nil, err
+	}
+
+	return ast, nil
+}
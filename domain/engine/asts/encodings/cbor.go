@@ -0,0 +1,250 @@
+package encodings
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// the subset of CBOR used by the adapter: unsigned integers, byte strings, text strings, arrays,
+// maps with text keys and booleans, always encoded with their definite and shortest length
+const cborMajorUint = 0
+const cborMajorBytes = 2
+const cborMajorText = 3
+const cborMajorArray = 4
+const cborMajorMap = 5
+const cborMajorSimple = 7
+const cborFalse = 20
+const cborTrue = 21
+const cborMaxDepth = 4096
+
+func encodeCBOR(value any) ([]byte, error) {
+	buffer := bytes.Buffer{}
+	err := writeCBOR(&buffer, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func writeCBOR(buffer *bytes.Buffer, value any) error {
+	switch casted := value.(type) {
+	case uint64:
+		writeCBORHead(buffer, cborMajorUint, casted)
+	case []byte:
+		writeCBORHead(buffer, cborMajorBytes, uint64(len(casted)))
+		buffer.Write(casted)
+	case string:
+		writeCBORHead(buffer, cborMajorText, uint64(len(casted)))
+		buffer.WriteString(casted)
+	case bool:
+		simple := uint64(cborFalse)
+		if casted {
+			simple = cborTrue
+		}
+
+		writeCBORHead(buffer, cborMajorSimple, simple)
+	case []any:
+		writeCBORHead(buffer, cborMajorArray, uint64(len(casted)))
+		for _, oneValue := range casted {
+			err := writeCBOR(buffer, oneValue)
+			if err != nil {
+				return err
+			}
+		}
+	case map[string]any:
+		// the keys are sorted by their encoded bytes, as required by the deterministic encoding:
+		keys := []string{}
+		for oneKey := range casted {
+			keys = append(keys, oneKey)
+		}
+
+		sort.Slice(keys, func(i, j int) bool {
+			if len(keys[i]) != len(keys[j]) {
+				return len(keys[i]) < len(keys[j])
+			}
+
+			return keys[i] < keys[j]
+		})
+
+		writeCBORHead(buffer, cborMajorMap, uint64(len(casted)))
+		for _, oneKey := range keys {
+			writeCBORHead(buffer, cborMajorText, uint64(len(oneKey)))
+			buffer.WriteString(oneKey)
+			err := writeCBOR(buffer, casted[oneKey])
+			if err != nil {
+				return err
+			}
+		}
+	default:
+		str := fmt.Sprintf("the value (type: %T) cannot be encoded in CBOR", value)
+		return errors.New(str)
+	}
+
+	return nil
+}
+
+func writeCBORHead(buffer *bytes.Buffer, major byte, argument uint64) {
+	prefix := major << 5
+	switch {
+	case argument < 24:
+		buffer.WriteByte(prefix | byte(argument))
+	case argument <= 0xff:
+		buffer.Write([]byte{prefix | 24, byte(argument)})
+	case argument <= 0xffff:
+		buffer.WriteByte(prefix | 25)
+		buffer.Write(binary.BigEndian.AppendUint16(nil, uint16(argument)))
+	case argument <= 0xffffffff:
+		buffer.WriteByte(prefix | 26)
+		buffer.Write(binary.BigEndian.AppendUint32(nil, uint32(argument)))
+	default:
+		buffer.WriteByte(prefix | 27)
+		buffer.Write(binary.BigEndian.AppendUint64(nil, argument))
+	}
+}
+
+func decodeCBOR(input []byte) (any, error) {
+	value, remaining, err := readCBOR(input, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(remaining) > 0 {
+		str := fmt.Sprintf("the CBOR input contains %d bytes after its value", len(remaining))
+		return nil, errors.New(str)
+	}
+
+	return value, nil
+}
+
+func readCBOR(input []byte, depth int) (any, []byte, error) {
+	if depth > cborMaxDepth {
+		str := fmt.Sprintf("the CBOR input exceeds the maximum depth (%d)", cborMaxDepth)
+		return nil, nil, errors.New(str)
+	}
+
+	major, argument, remaining, err := readCBORHead(input)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch major {
+	case cborMajorUint:
+		return argument, remaining, nil
+	case cborMajorBytes, cborMajorText:
+		if argument > uint64(len(remaining)) {
+			str := fmt.Sprintf("the CBOR string declares %d bytes but only %d remain", argument, len(remaining))
+			return nil, nil, errors.New(str)
+		}
+
+		content := remaining[:argument]
+		if major == cborMajorText {
+			return string(content), remaining[argument:], nil
+		}
+
+		return append([]byte{}, content...), remaining[argument:], nil
+	case cborMajorArray:
+		// every item contains at least 1 byte:
+		if argument > uint64(len(remaining)) {
+			str := fmt.Sprintf("the CBOR array declares %d items but only %d bytes remain", argument, len(remaining))
+			return nil, nil, errors.New(str)
+		}
+
+		output := []any{}
+		for i := uint64(0); i < argument; i++ {
+			value, retRemaining, err := readCBOR(remaining, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			output = append(output, value)
+			remaining = retRemaining
+		}
+
+		return output, remaining, nil
+	case cborMajorMap:
+		if argument > uint64(len(remaining)) {
+			str := fmt.Sprintf("the CBOR map declares %d entries but only %d bytes remain", argument, len(remaining))
+			return nil, nil, errors.New(str)
+		}
+
+		output := map[string]any{}
+		for i := uint64(0); i < argument; i++ {
+			key, retRemaining, err := readCBOR(remaining, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			keyname, ok := key.(string)
+			if !ok {
+				return nil, nil, errors.New("the CBOR map keys were expected to be text strings")
+			}
+
+			if _, ok := output[keyname]; ok {
+				str := fmt.Sprintf("the CBOR map contains a duplicate key (%s)", keyname)
+				return nil, nil, errors.New(str)
+			}
+
+			value, retRemaining, err := readCBOR(retRemaining, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			output[keyname] = value
+			remaining = retRemaining
+		}
+
+		return output, remaining, nil
+	case cborMajorSimple:
+		if argument == cborFalse {
+			return false, remaining, nil
+		}
+
+		if argument == cborTrue {
+			return true, remaining, nil
+		}
+	}
+
+	str := fmt.Sprintf("the CBOR item (major type: %d, argument: %d) is not supported", major, argument)
+	return nil, nil, errors.New(str)
+}
+
+func readCBORHead(input []byte) (byte, uint64, []byte, error) {
+	if len(input) <= 0 {
+		return 0, 0, nil, errors.New("the CBOR input ended before its value was complete")
+	}
+
+	major := input[0] >> 5
+	info := input[0] & 0x1f
+	remaining := input[1:]
+	if info < 24 {
+		return major, uint64(info), remaining, nil
+	}
+
+	sizes := map[byte]int{
+		24: 1,
+		25: 2,
+		26: 4,
+		27: 8,
+	}
+
+	size, ok := sizes[info]
+	if !ok {
+		str := fmt.Sprintf("the CBOR additional information (%d) is not supported, indefinite lengths are not allowed", info)
+		return 0, 0, nil, errors.New(str)
+	}
+
+	if len(remaining) < size {
+		return 0, 0, nil, errors.New("the CBOR input ended before its value was complete")
+	}
+
+	argument := uint64(0)
+	for _, oneByte := range remaining[:size] {
+		argument = argument<<8 | uint64(oneByte)
+	}
+
+	return major, argument, remaining[size:], nil
+}
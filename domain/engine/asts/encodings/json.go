@@ -0,0 +1,27 @@
+package encodings
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+func encodeJSON(value any) ([]byte, error) {
+	return json.Marshal(value)
+}
+
+func decodeJSON(input []byte) (any, error) {
+	var output any
+	decoder := json.NewDecoder(bytes.NewReader(input))
+	decoder.UseNumber()
+	err := decoder.Decode(&output)
+	if err != nil {
+		return nil, err
+	}
+
+	if decoder.More() {
+		return nil, errors.New("the JSON input contains data after its value")
+	}
+
+	return output, nil
+}
@@ -0,0 +1,100 @@
+package encodings
+
+import (
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements/references"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/uniques"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+)
+
+const keyVersion = "version"
+const keyRoot = "root"
+const keySpan = "span"
+const keyStart = "start"
+const keyEnd = "end"
+const keyTrivia = "trivia"
+const keyLeading = "leading"
+const keyTrailing = "trailing"
+const keySource = "source"
+const keyConstant = "constant"
+const keyInstruction = "instruction"
+const keyAST = "ast"
+const keyName = "name"
+const keyValue = "value"
+const keyBlock = "block"
+const keyLine = "line"
+const keyTokens = "tokens"
+const keyElements = "elements"
+const keyUnique = "unique"
+const keyMode = "mode"
+const keyIndex = "index"
+const keyElement = "element"
+const keyRule = "rule"
+const keyReference = "reference"
+const keyPath = "path"
+const keyConstraint = "constraint"
+
+const uniqueModeMustBe = "must_be"
+const uniqueModeMustNot = "must_not"
+
+// encodeFn encodes a tree of maps, lists, strings, bytes, unsigned integers and booleans
+type encodeFn func(value any) ([]byte, error)
+
+// decodeFn decodes the input to a tree of maps, lists, strings, bytes, numbers and booleans
+type decodeFn func(input []byte) (any, error)
+
+// NewJSONAdapter creates a new adapter that encodes ASTs in JSON, the bytes are encoded in base64
+func NewJSONAdapter() Adapter {
+	return newAdapter(encodeJSON, decodeJSON)
+}
+
+// NewCBORAdapter creates a new adapter that encodes ASTs in CBOR (RFC 8949), the map keys are sorted
+func NewCBORAdapter() Adapter {
+	return newAdapter(encodeCBOR, decodeCBOR)
+}
+
+func newAdapter(
+	encode encodeFn,
+	decode decodeFn,
+) Adapter {
+	builder := asts.NewBuilder()
+	instructionBuilder := asts.NewInstructionBuilder()
+	tokensBuilder := asts.NewTokensBuilder()
+	tokenBuilder := asts.NewTokenBuilder()
+	elementsBuilder := asts.NewElementsBuilder()
+	elementBuilder := asts.NewElementBuilder()
+	constantBuilder := asts.NewConstantBuilder()
+	spanBuilder := asts.NewSpanBuilder()
+	triviaBuilder := asts.NewTriviaBuilder()
+	uniqueBuilder := uniques.NewBuilder()
+	grammarElementBuilder := elements.NewElementBuilder()
+	referenceBuilder := references.NewBuilder()
+	versionAdapter := versions.NewAdapter()
+	return createAdapter(
+		encode,
+		decode,
+		builder,
+		instructionBuilder,
+		tokensBuilder,
+		tokenBuilder,
+		elementsBuilder,
+		elementBuilder,
+		constantBuilder,
+		spanBuilder,
+		triviaBuilder,
+		uniqueBuilder,
+		grammarElementBuilder,
+		referenceBuilder,
+		versionAdapter,
+	)
+}
+
+// Adapter converts ASTs to and from an encoding
+type Adapter interface {
+	// ToBytes encodes the AST
+	ToBytes(ast asts.AST) ([]byte, error)
+
+	// ToAST decodes the input to an AST, built and validated using the AST builders
+	ToAST(input []byte) (asts.AST, error)
+}
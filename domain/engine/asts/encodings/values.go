@@ -0,0 +1,106 @@
+package encodings
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+func fetch(mp map[string]any, key string) (any, error) {
+	if value, ok := mp[key]; ok {
+		return value, nil
+	}
+
+	str := fmt.Sprintf("the key (%s) is mandatory", key)
+	return nil, errors.New(str)
+}
+
+func fetchString(mp map[string]any, key string) (string, error) {
+	value, err := fetch(mp, key)
+	if err != nil {
+		return "", err
+	}
+
+	return toString(value, key)
+}
+
+func fetchUint(mp map[string]any, key string) (uint, error) {
+	value, err := fetch(mp, key)
+	if err != nil {
+		return 0, err
+	}
+
+	return toUint(value, key)
+}
+
+func fetchList(mp map[string]any, key string) ([]any, error) {
+	value, err := fetch(mp, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if casted, ok := value.([]any); ok {
+		return casted, nil
+	}
+
+	str := fmt.Sprintf("the value of the key (%s) was expected to be a list", key)
+	return nil, errors.New(str)
+}
+
+func toMap(value any, key string) (map[string]any, error) {
+	if casted, ok := value.(map[string]any); ok {
+		return casted, nil
+	}
+
+	str := fmt.Sprintf("the value of the key (%s) was expected to be a map", key)
+	return nil, errors.New(str)
+}
+
+func toString(value any, key string) (string, error) {
+	if casted, ok := value.(string); ok {
+		return casted, nil
+	}
+
+	str := fmt.Sprintf("the value of the key (%s) was expected to be a string", key)
+	return "", errors.New(str)
+}
+
+// toBytes accepts bytes, as decoded from CBOR, or a base64 string, as decoded from JSON
+func toBytes(value any, key string) ([]byte, error) {
+	switch casted := value.(type) {
+	case []byte:
+		return casted, nil
+	case string:
+		bytes, err := base64.StdEncoding.DecodeString(casted)
+		if err != nil {
+			str := fmt.Sprintf("the value of the key (%s) was expected to be encoded in base64: %s", key, err.Error())
+			return nil, errors.New(str)
+		}
+
+		return bytes, nil
+	}
+
+	str := fmt.Sprintf("the value of the key (%s) was expected to be bytes", key)
+	return nil, errors.New(str)
+}
+
+// toUint accepts an unsigned integer, as decoded from CBOR, or a number, as decoded from JSON
+func toUint(value any, key string) (uint, error) {
+	switch casted := value.(type) {
+	case uint64:
+		if casted <= math.MaxUint {
+			return uint(casted), nil
+		}
+	case json.Number:
+		number, err := strconv.ParseUint(casted.String(), 10, strconv.IntSize)
+		if err == nil {
+			return uint(number), nil
+		}
+	}
+
+	str := fmt.Sprintf("the value of the key (%s) was expected to be an unsigned integer", key)
+	return 0, errors.New(str)
+}
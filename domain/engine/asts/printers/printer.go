@@ -0,0 +1,147 @@
+package printers
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+)
+
+type printer struct {
+	isVerbose bool
+}
+
+func createPrinter(
+	isVerbose bool,
+) Printer {
+	out := printer{
+		isVerbose: isVerbose,
+	}
+
+	return &out
+}
+
+// Print renders the AST as an indented tree
+func (app *printer) Print(ast asts.AST) ([]byte, error) {
+	buffer := bytes.Buffer{}
+	err := app.element(&buffer, ast.Root(), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func (app *printer) element(buffer *bytes.Buffer, element asts.Element, depth int) error {
+	if element.IsConstant() {
+		constant := element.Constant()
+		app.line(buffer, depth, element, fmt.Sprintf("%s = %s", constant.Name(), value(constant.Value())))
+		return nil
+	}
+
+	if element.IsAST() {
+		ast := element.AST()
+		head := astKeyname
+		if ast.HasVersion() {
+			head = fmt.Sprintf("%s v%s", head, ast.Version().String())
+		}
+
+		app.line(buffer, depth, element, head)
+		return app.element(buffer, ast.Root(), depth+1)
+	}
+
+	if element.IsInstruction() {
+		instruction := element.Instruction()
+		app.line(buffer, depth, element, fmt.Sprintf("%s#%d", instruction.Block(), instruction.Line()))
+		for _, oneToken := range instruction.Tokens().List() {
+			err := app.token(buffer, oneToken, depth+1)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	str := fmt.Sprintf("the element (name: %s) is neither a constant, an instruction or an AST", element.Name())
+	return errors.New(str)
+}
+
+func (app *printer) token(buffer *bytes.Buffer, token asts.Token, depth int) error {
+	list := token.Elements().List()
+	if !app.isVerbose && len(list) == 1 && list[0].IsConstant() && list[0].Name() == token.Name() {
+		return app.element(buffer, list[0], depth)
+	}
+
+	head := fmt.Sprintf("%s (%d)", token.Name(), len(list))
+	if app.isVerbose && token.HasUnique() {
+		unique := token.Unique()
+		mode := uniqueModeMustBe
+		if unique.MustNot() {
+			mode = uniqueModeMustNot
+		}
+
+		head = fmt.Sprintf("%s unique (%s): %s[%d]", head, mode, unique.Element().Name(), unique.Index())
+	}
+
+	writeLine(buffer, depth, head)
+	for _, oneElement := range list {
+		err := app.element(buffer, oneElement, depth+1)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// line writes the line of an element, followed by its span and trivia in verbose mode
+func (app *printer) line(buffer *bytes.Buffer, depth int, element asts.Element, content string) {
+	if !app.isVerbose {
+		writeLine(buffer, depth, content)
+		return
+	}
+
+	sections := []string{content}
+	if element.HasSpan() {
+		span := element.Span()
+		sections = append(sections, fmt.Sprintf("@%d..%d", span.Start(), span.End()))
+	}
+
+	if element.HasTrivia() {
+		trivia := element.Trivia()
+		if trivia.HasLeading() {
+			sections = append(sections, fmt.Sprintf("leading: %s", value(trivia.Leading())))
+		}
+
+		if trivia.HasSource() {
+			sections = append(sections, fmt.Sprintf("source: %s", value(trivia.Source())))
+		}
+
+		if trivia.HasTrailing() {
+			sections = append(sections, fmt.Sprintf("trailing: %s", value(trivia.Trailing())))
+		}
+	}
+
+	writeLine(buffer, depth, strings.Join(sections, " "))
+}
+
+func writeLine(buffer *bytes.Buffer, depth int, content string) {
+	buffer.WriteString(strings.Repeat(indentation, depth))
+	buffer.WriteString(content)
+	buffer.WriteString("\n")
+}
+
+// value renders the bytes as an escaped string, or in hexadecimal when they are not valid UTF-8
+func value(input []byte) string {
+	if !utf8.Valid(input) {
+		return hexPrefix + hex.EncodeToString(input)
+	}
+
+	return strconv.Quote(string(input))
+}
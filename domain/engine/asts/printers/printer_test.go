@@ -0,0 +1,180 @@
+package printers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/snapshots"
+)
+
+func TestPrinter_compact_Success(t *testing.T) {
+	ast, err := parse("a -> a 0;", false)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retBytes, err := NewCompactPrinter().Print(ast)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := strings.Join([]string{
+		"links#0",
+		"  link (1)",
+		"    link#0",
+		"      name (1)",
+		"        name#0",
+		"          LL_A = \"a\"",
+		"      ARROW = \"->\"",
+		"      name (1)",
+		"        name#0",
+		"          LL_A = \"a\"",
+		"      bit (1)",
+		"        ast v1.2.0",
+		"          bit#0",
+		"            N_ZERO = \"0\"",
+		"      SEMICOLON = \";\"",
+		"",
+	}, "\n")
+
+	if string(retBytes) != expected {
+		t.Errorf("the printed tree was expected to be:\n%s\nreturned:\n%s", expected, retBytes)
+		return
+	}
+}
+
+func TestPrinter_verbose_Success(t *testing.T) {
+	ast, err := parse("a -> a 0;  b -> a 1;", true)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retBytes, err := NewVerbosePrinter().Print(ast)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	lines := []string{
+		"links#0 @0..20\n",
+		"      ARROW (1)\n        ARROW = \"->\" @2..4 trailing: \" \"\n",
+		"      name (1) unique (must_be): links[0]\n",
+		"        SEMICOLON = \";\" @8..9 trailing: \"  \"\n",
+		"        ast v1.2.0 @18..19\n",
+		"      name (1) unique (must_not): links[0]\n",
+	}
+
+	for _, oneLine := range lines {
+		if !strings.Contains(string(retBytes), oneLine) {
+			t.Errorf("the printed tree was expected to contain:\n%s\nreturned:\n%s", oneLine, retBytes)
+			return
+		}
+	}
+}
+
+func TestPrinter_sexpression_Success(t *testing.T) {
+	ast, err := parse("a -> a 0; b -> a 1;", false)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retBytes, err := NewSExpressionPrinter().Print(ast)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retDiff, err := snapshots.NewAdapter().Compare(retBytes, ast)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if retDiff != nil {
+		t.Errorf("the s-expression was expected to match the snapshot of the AST:\n%s", retDiff)
+		return
+	}
+}
+
+func TestValue_withBinary_Success(t *testing.T) {
+	if value([]byte("a\tb")) != `"a\tb"` {
+		t.Errorf("the text was expected to be escaped, %s returned", value([]byte("a\tb")))
+		return
+	}
+
+	if value([]byte{0xff, 0x00}) != "0xff00" {
+		t.Errorf("the binary value was expected to be rendered in hexadecimal, %s returned", value([]byte{0xff, 0x00}))
+		return
+	}
+}
+
+func parse(input string, isLossless bool) (asts.AST, error) {
+	grammarAdapter := grammars.NewAdapter()
+	bitGrammar, _, err := grammarAdapter.ToGrammar([]byte(`
+		v1.2;
+		> .bit;
+		# .SPACE;
+
+		bit: .N_ZERO
+		   | .N_ONE
+		   ;
+
+		N_ZERO: "0";
+		N_ONE: "1";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		return nil, err
+	}
+
+	repository := grammars.NewRepositoryMemory(map[string]grammars.Grammar{})
+	err = repository.Insert([]string{"lang", "bit"}, bitGrammar)
+	if err != nil {
+		return nil, err
+	}
+
+	grammar, _, err := grammarAdapter.ToGrammar([]byte(`
+		v1;
+		> .links;
+		# .SPACE;
+
+		links: .link+
+			 ;
+
+		link: #.links .name .ARROW $.links .name .bit[/lang/bit, ^1] .SEMICOLON
+			;
+
+		name: .LL_A
+			| .LL_B
+			;
+
+		ARROW: "->";
+		SEMICOLON: ";";
+		LL_A: "a";
+		LL_B: "b";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		return nil, err
+	}
+
+	adapter := asts.NewAdapter(repository)
+	if isLossless {
+		adapter = asts.NewLosslessAdapter(repository)
+	}
+
+	ast, _, err := adapter.ToAST(grammar, []byte(input))
+	if err != nil {
+		return nil, err
+	}
+
+	return ast, nil
+}
@@ -0,0 +1,37 @@
+package printers
+
+import (
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/snapshots"
+)
+
+const indentation = "  "
+const astKeyname = "ast"
+const hexPrefix = "0x"
+const uniqueModeMustBe = "must_be"
+const uniqueModeMustNot = "must_not"
+
+// NewCompactPrinter creates a printer that renders the tree of an AST, a token that contains a single constant of its own
+// name being rendered on one line
+func NewCompactPrinter() Printer {
+	return createPrinter(false)
+}
+
+// NewVerbosePrinter creates a printer that renders every token and element of the tree of an AST, along with the spans,
+// the trivia and the unique constraints
+func NewVerbosePrinter() Printer {
+	return createPrinter(true)
+}
+
+// NewSExpressionPrinter creates a printer that renders an AST as the s-expression used by the snapshots of the suites
+func NewSExpressionPrinter() Printer {
+	snapshotAdapter := snapshots.NewAdapter()
+	return createSExpressionPrinter(
+		snapshotAdapter,
+	)
+}
+
+// Printer renders an AST as human-readable text
+type Printer interface {
+	Print(ast asts.AST) ([]byte, error)
+}
@@ -0,0 +1,25 @@
+package printers
+
+import (
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/snapshots"
+)
+
+type sexpressionPrinter struct {
+	snapshotAdapter snapshots.Adapter
+}
+
+func createSExpressionPrinter(
+	snapshotAdapter snapshots.Adapter,
+) Printer {
+	out := sexpressionPrinter{
+		snapshotAdapter: snapshotAdapter,
+	}
+
+	return &out
+}
+
+// Print renders the AST as an s-expression
+func (app *sexpressionPrinter) Print(ast asts.AST) ([]byte, error) {
+	return app.snapshotAdapter.ToSnapshot(ast)
+}
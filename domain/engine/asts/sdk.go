@@ -1,16 +1,53 @@
 package asts
 
 import (
+	"github.com/steve-care-software/grammars/domain/engine/coverages"
 	"github.com/steve-care-software/grammars/domain/engine/grammars"
 	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/balances"
 	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/balances/selectors"
 	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/balances/selectors/chains"
 	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/uniques"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
 )
 
 // NewAdapter creates a new adapter
 func NewAdapter(
 	grammarRepository grammars.Repository,
+) Adapter {
+	return NewAdapterWithCoverage(
+		grammarRepository,
+		nil,
+	)
+}
+
+// NewAdapterWithCoverage creates a new adapter that records the grammar parts exercised by every parsed AST in the provided collector
+func NewAdapterWithCoverage(
+	grammarRepository grammars.Repository,
+	coverage coverages.Collector,
+) Adapter {
+	return newAdapter(
+		grammarRepository,
+		coverage,
+		false,
+	)
+}
+
+// NewLosslessAdapter creates a new adapter that keeps the omitted bytes as trivia of the elements,
+// so that the elements adapter converts the parsed ASTs back to their input byte for byte
+func NewLosslessAdapter(
+	grammarRepository grammars.Repository,
+) Adapter {
+	return newAdapter(
+		grammarRepository,
+		nil,
+		true,
+	)
+}
+
+func newAdapter(
+	grammarRepository grammars.Repository,
+	coverage coverages.Collector,
+	isLossless bool,
 ) Adapter {
 	grammarAdapter := grammars.NewAdapter()
 	builder := NewBuilder()
@@ -20,6 +57,8 @@ func NewAdapter(
 	elementsBuilder := NewElementsBuilder()
 	elementBuilder := NewElementBuilder()
 	constantBuilder := NewConstantBuilder()
+	spanBuilder := NewSpanBuilder()
+	triviaBuilder := NewTriviaBuilder()
 	return createAdapter(
 		grammarRepository,
 		grammarAdapter,
@@ -30,6 +69,10 @@ func NewAdapter(
 		elementsBuilder,
 		elementBuilder,
 		constantBuilder,
+		spanBuilder,
+		triviaBuilder,
+		coverage,
+		isLossless,
 	)
 }
 
@@ -68,12 +111,27 @@ func NewElementBuilder() ElementBuilder {
 	return createElementBuilder()
 }
 
+// NewSpanBuilder creates a new span builder
+func NewSpanBuilder() SpanBuilder {
+	return createSpanBuilder()
+}
+
+// NewTriviaBuilder creates a new trivia builder
+func NewTriviaBuilder() TriviaBuilder {
+	return createTriviaBuilder()
+}
+
 // NewConstantBuilder creates a new constant builder
 func NewConstantBuilder() ConstantBuilder {
 	return createConstantBuilder()
 }
 
-// Adapter represents the adapter
+// Validate validates the uniqueness constraints of the tokens contained in the element, as the AST builder does
+func Validate(element Element) error {
+	return createValidator().Validate(element)
+}
+
+// Adapter represents the adapter, it is safe for concurrent use: the state of a conversion is created on every call
 type Adapter interface {
 	// ToAST takes the grammar and input and converts them to a ast instance and the remaining data
 	ToAST(grammar grammars.Grammar, input []byte) (AST, []byte, error)
@@ -86,12 +144,15 @@ type Adapter interface {
 type Builder interface {
 	Create() Builder
 	WithRoot(root Element) Builder
+	WithVersion(version versions.Version) Builder
 	Now() (AST, error)
 }
 
 // AST represents a ast
 type AST interface {
 	Root() Element
+	HasVersion() bool
+	Version() versions.Version
 }
 
 // InstructionBuilder represents the instruction builder
@@ -105,7 +166,6 @@ type InstructionBuilder interface {
 
 // Instruction represents an instruction
 type Instruction interface {
-	Validate(elementNameIndex map[string]BlockCount) (map[string]BlockCount, error)
 	Block() string
 	Line() uint
 	Tokens() Tokens
@@ -120,7 +180,6 @@ type TokensBuilder interface {
 
 // Tokens represents tokens
 type Tokens interface {
-	Validate(elementNameIndex map[string]BlockCount) (map[string]BlockCount, error)
 	List() []Token
 	Value() []byte
 	FetchAll(name string) ([]Token, error)
@@ -142,7 +201,6 @@ type TokenBuilder interface {
 
 // Token represents a token
 type Token interface {
-	Validate(elementNameIndex map[string]BlockCount) (map[string]BlockCount, error)
 	Name() string
 	Elements() Elements
 	Value() []byte
@@ -152,7 +210,7 @@ type Token interface {
 
 // ElementsAdapter represents the elements adapter
 type ElementsAdapter interface {
-	// ToBytes takes an elements and returns its bytes
+	// ToBytes takes an elements and returns its bytes, including the trivia of its elements
 	ToBytes(elements Elements) ([]byte, error)
 }
 
@@ -165,7 +223,6 @@ type ElementsBuilder interface {
 
 // Elements represents elements
 type Elements interface {
-	Validate(elementNameIndex map[string]BlockCount) (map[string]BlockCount, error)
 	List() []Element
 	Fetch(idx uint) (Element, error)
 	Value() []byte
@@ -177,13 +234,14 @@ type ElementBuilder interface {
 	WithConstant(constant Constant) ElementBuilder
 	WithInstruction(instruction Instruction) ElementBuilder
 	WithAST(ast AST) ElementBuilder
+	WithSpan(span Span) ElementBuilder
+	WithTrivia(trivia Trivia) ElementBuilder
 	Now() (Element, error)
 }
 
 // Element represents an element
 type Element interface {
 	Search(name string, idx uint) (Token, error)
-	Validate(elementNameIndex map[string]BlockCount) (map[string]BlockCount, error)
 	Name() string
 	Value() []byte
 	IsChainValid(chain chains.Chain) bool
@@ -193,6 +251,45 @@ type Element interface {
 	Instruction() Instruction
 	IsAST() bool
 	AST() AST
+	HasSpan() bool
+	Span() Span
+	HasTrivia() bool
+	Trivia() Trivia
+}
+
+// SpanBuilder represents the span builder
+type SpanBuilder interface {
+	Create() SpanBuilder
+	WithStart(start uint) SpanBuilder
+	WithEnd(end uint) SpanBuilder
+	Now() (Span, error)
+}
+
+// Span represents the byte offsets of an element in the parsed input, omitted bytes excluded
+type Span interface {
+	Start() uint
+	End() uint
+}
+
+// TriviaBuilder represents the trivia builder
+type TriviaBuilder interface {
+	Create() TriviaBuilder
+	WithLeading(leading []byte) TriviaBuilder
+	WithTrailing(trailing []byte) TriviaBuilder
+	WithSource(source []byte) TriviaBuilder
+	Now() (Trivia, error)
+}
+
+// Trivia represents the input bytes of an element that are not part of its value, kept by a lossless adapter:
+//   - the leading and trailing bytes are the omitted bytes consumed before and after the element
+//   - the source contains the input bytes of a constant when they differ from its value, such as omitted bytes between its parts
+type Trivia interface {
+	HasLeading() bool
+	Leading() []byte
+	HasTrailing() bool
+	Trailing() []byte
+	HasSource() bool
+	Source() []byte
 }
 
 // ConstantBuilder represents the constant builder
@@ -209,9 +306,3 @@ type Constant interface {
 	Value() []byte
 	IsChainValid(chain chains.Chain) bool
 }
-
-// BlockCount represents a block count
-type BlockCount struct {
-	index  uint
-	tokens map[string][]byte
-}
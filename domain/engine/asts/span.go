@@ -0,0 +1,28 @@
+package asts
+
+type span struct {
+	start uint
+	end   uint
+}
+
+func createSpan(
+	start uint,
+	end uint,
+) Span {
+	out := span{
+		start: start,
+		end:   end,
+	}
+
+	return &out
+}
+
+// Start returns the offset of the first byte
+func (obj *span) Start() uint {
+	return obj.start
+}
+
+// End returns the offset following the last byte
+func (obj *span) End() uint {
+	return obj.end
+}
@@ -0,0 +1,55 @@
+package asts
+
+import (
+	"errors"
+	"fmt"
+)
+
+type spanBuilder struct {
+	pStart *uint
+	pEnd   *uint
+}
+
+func createSpanBuilder() SpanBuilder {
+	out := spanBuilder{
+		pStart: nil,
+		pEnd:   nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *spanBuilder) Create() SpanBuilder {
+	return createSpanBuilder()
+}
+
+// WithStart adds a start to the builder
+func (app *spanBuilder) WithStart(start uint) SpanBuilder {
+	app.pStart = &start
+	return app
+}
+
+// WithEnd adds an end to the builder
+func (app *spanBuilder) WithEnd(end uint) SpanBuilder {
+	app.pEnd = &end
+	return app
+}
+
+// Now builds a new Span instance
+func (app *spanBuilder) Now() (Span, error) {
+	if app.pStart == nil {
+		return nil, errors.New("the start is mandatory in order to build a Span instance")
+	}
+
+	if app.pEnd == nil {
+		return nil, errors.New("the end is mandatory in order to build a Span instance")
+	}
+
+	if *app.pEnd < *app.pStart {
+		str := fmt.Sprintf("the end (%d) of the Span cannot be smaller than its start (%d)", *app.pEnd, *app.pStart)
+		return nil, errors.New(str)
+	}
+
+	return createSpan(*app.pStart, *app.pEnd), nil
+}
@@ -26,21 +26,6 @@ func createTokens(
 	return &out
 }
 
-// Validate validates tokens
-func (obj *tokensStr) Validate(elementNameIndex map[string]BlockCount) (map[string]BlockCount, error) {
-	lastBlockNameIndex := map[string]BlockCount{}
-	for _, oneToken := range obj.list {
-		retBlockNameIndex, err := oneToken.Validate(lastBlockNameIndex)
-		if err != nil {
-			return nil, err
-		}
-
-		lastBlockNameIndex = retBlockNameIndex
-	}
-
-	return lastBlockNameIndex, nil
-}
-
 // List returns the list of token
 func (obj *tokensStr) List() []Token {
 	return obj.list
@@ -107,6 +92,16 @@ func (obj *tokensStr) Select(chain chains.Chain) ([]Token, Token, Element, error
 					return nil, nil, nil, errors.New("the element was expected to contain an Instruction")
 				}
 
+				if retElement.IsAST() {
+					root := retElement.AST().Root()
+					if !root.IsInstruction() {
+						str := fmt.Sprintf("the root (%s) of the embedded AST was expected to contain an Instruction", root.Name())
+						return nil, nil, nil, errors.New(str)
+					}
+
+					return root.Instruction().Tokens().Select(retChain)
+				}
+
 				return retElement.Instruction().Tokens().Select(retChain)
 			}
 
@@ -0,0 +1,51 @@
+package asts
+
+type trivia struct {
+	leading  []byte
+	trailing []byte
+	source   []byte
+}
+
+func createTrivia(
+	leading []byte,
+	trailing []byte,
+	source []byte,
+) Trivia {
+	out := trivia{
+		leading:  leading,
+		trailing: trailing,
+		source:   source,
+	}
+
+	return &out
+}
+
+// HasLeading returns true if there is leading bytes, false otherwise
+func (obj *trivia) HasLeading() bool {
+	return obj.leading != nil
+}
+
+// Leading returns the omitted bytes that precede the element, if any
+func (obj *trivia) Leading() []byte {
+	return obj.leading
+}
+
+// HasTrailing returns true if there is trailing bytes, false otherwise
+func (obj *trivia) HasTrailing() bool {
+	return obj.trailing != nil
+}
+
+// Trailing returns the omitted bytes that follow the element, if any
+func (obj *trivia) Trailing() []byte {
+	return obj.trailing
+}
+
+// HasSource returns true if there is a source, false otherwise
+func (obj *trivia) HasSource() bool {
+	return obj.source != nil
+}
+
+// Source returns the input bytes of a constant, when they differ from its value, if any
+func (obj *trivia) Source() []byte {
+	return obj.source
+}
@@ -0,0 +1,67 @@
+package asts
+
+import "errors"
+
+type triviaBuilder struct {
+	leading  []byte
+	trailing []byte
+	source   []byte
+}
+
+func createTriviaBuilder() TriviaBuilder {
+	out := triviaBuilder{
+		leading:  nil,
+		trailing: nil,
+		source:   nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *triviaBuilder) Create() TriviaBuilder {
+	return createTriviaBuilder()
+}
+
+// WithLeading adds leading bytes to the builder
+func (app *triviaBuilder) WithLeading(leading []byte) TriviaBuilder {
+	app.leading = leading
+	return app
+}
+
+// WithTrailing adds trailing bytes to the builder
+func (app *triviaBuilder) WithTrailing(trailing []byte) TriviaBuilder {
+	app.trailing = trailing
+	return app
+}
+
+// WithSource adds a source to the builder
+func (app *triviaBuilder) WithSource(source []byte) TriviaBuilder {
+	app.source = source
+	return app
+}
+
+// Now builds a new Trivia instance
+func (app *triviaBuilder) Now() (Trivia, error) {
+	if app.leading != nil && len(app.leading) <= 0 {
+		app.leading = nil
+	}
+
+	if app.trailing != nil && len(app.trailing) <= 0 {
+		app.trailing = nil
+	}
+
+	if app.source != nil && len(app.source) <= 0 {
+		app.source = nil
+	}
+
+	if app.leading == nil && app.trailing == nil && app.source == nil {
+		return nil, errors.New("the leading bytes, the trailing bytes or the source is mandatory in order to build a Trivia instance")
+	}
+
+	return createTrivia(
+		app.leading,
+		app.trailing,
+		app.source,
+	), nil
+}
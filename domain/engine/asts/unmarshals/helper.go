@@ -0,0 +1,48 @@
+package unmarshals
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// normalize appends the default index to the segments of the path that do not contain any index
+func normalize(tag string) (string, error) {
+	if tag == "" {
+		return "", errors.New("the tag was expected to contain a path")
+	}
+
+	segments := strings.Split(tag, pathSeparator)
+	for idx, oneSegment := range segments {
+		if !strings.Contains(oneSegment, indexPrefix) {
+			segments[idx] = oneSegment + defaultIndex
+		}
+	}
+
+	return strings.Join(segments, pathSeparator), nil
+}
+
+func isScalar(valueType reflect.Type) bool {
+	if valueType == bytesType {
+		return true
+	}
+
+	switch valueType.Kind() {
+	case reflect.String, reflect.Bool:
+		return true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	case reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
+
+func conversionError(input string, valueType reflect.Type, location string, err error) error {
+	str := fmt.Sprintf("%s: the value (%s) could not be converted to (%s): %s", location, input, valueType.String(), err.Error())
+	return errors.New(str)
+}
@@ -0,0 +1,38 @@
+package unmarshals
+
+import (
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/asts/cursors"
+)
+
+const tagName = "grammar"
+const pathSeparator = "/"
+const indexPrefix = "["
+const defaultIndex = "[0]"
+
+// Unmarshal fills the struct pointed to by v using the AST
+func Unmarshal(ast asts.AST, v any) error {
+	return NewUnmarshaller().Unmarshal(ast, v)
+}
+
+// NewUnmarshaller creates a new unmarshaller
+func NewUnmarshaller() Unmarshaller {
+	cursorAdapter := cursors.NewAdapter()
+	return createUnmarshaller(
+		cursorAdapter,
+	)
+}
+
+// Unmarshaller fills Go values using ASTs.
+//
+// The grammar tag of a struct field contains a path relative to the element the struct is filled from, using the format
+// of the cursors without the root name, such as name[0] or declaration[1][0]/value[0][0]. A segment without index
+// designates the first token of its name. A path that ends on a token fills the field using all of its elements, and a
+// path that ends on an element fills it using that element only. The untagged fields are ignored.
+//
+// The values are converted to strings, byte slices, booleans, integers and floats, the instructions fill nested structs
+// and the tokens fill slices. The asts.Element and asts.AST fields receive the element itself. A tag that cannot be
+// matched is an error, unless its field is a pointer or a slice, which is then left empty.
+type Unmarshaller interface {
+	Unmarshal(ast asts.AST, v any) error
+}
@@ -0,0 +1,249 @@
+package unmarshals
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/asts/cursors"
+)
+
+var elementType = reflect.TypeOf((*asts.Element)(nil)).Elem()
+var astType = reflect.TypeOf((*asts.AST)(nil)).Elem()
+var bytesType = reflect.TypeOf([]byte(nil))
+
+type unmarshaller struct {
+	cursorAdapter cursors.Adapter
+}
+
+func createUnmarshaller(
+	cursorAdapter cursors.Adapter,
+) Unmarshaller {
+	out := unmarshaller{
+		cursorAdapter: cursorAdapter,
+	}
+
+	return &out
+}
+
+// Unmarshal fills the value pointed to by v using the AST
+func (app *unmarshaller) Unmarshal(ast asts.AST, v any) error {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Pointer || value.IsNil() {
+		str := fmt.Sprintf("the value was expected to be a non-nil pointer, %T provided", v)
+		return errors.New(str)
+	}
+
+	target := value.Elem()
+	return app.element(ast.Root(), target, target.Type().String())
+}
+
+func (app *unmarshaller) element(element asts.Element, value reflect.Value, location string) error {
+	valueType := value.Type()
+	if valueType == elementType {
+		value.Set(reflect.ValueOf(element))
+		return nil
+	}
+
+	if valueType == astType {
+		if !element.IsAST() {
+			str := fmt.Sprintf("%s: the element (%s) was expected to contain an AST", location, element.Name())
+			return errors.New(str)
+		}
+
+		value.Set(reflect.ValueOf(element.AST()))
+		return nil
+	}
+
+	if isScalar(valueType) {
+		return app.scalar(element.Value(), value, location)
+	}
+
+	switch value.Kind() {
+	case reflect.Pointer:
+		created := reflect.New(valueType.Elem())
+		err := app.element(element, created.Elem(), location)
+		if err != nil {
+			return err
+		}
+
+		value.Set(created)
+		return nil
+	case reflect.Slice:
+		created := reflect.MakeSlice(valueType, 1, 1)
+		err := app.element(element, created.Index(0), fmt.Sprintf("%s[0]", location))
+		if err != nil {
+			return err
+		}
+
+		value.Set(created)
+		return nil
+	case reflect.Struct:
+		return app.structure(element, value, location)
+	}
+
+	str := fmt.Sprintf("%s: the type (%s) is not supported", location, valueType.String())
+	return errors.New(str)
+}
+
+func (app *unmarshaller) token(token asts.Token, value reflect.Value, location string) error {
+	valueType := value.Type()
+	if isScalar(valueType) {
+		return app.scalar(token.Value(), value, location)
+	}
+
+	elementsList := token.Elements().List()
+	if value.Kind() == reflect.Pointer {
+		if len(elementsList) <= 0 {
+			return nil
+		}
+
+		created := reflect.New(valueType.Elem())
+		err := app.token(token, created.Elem(), location)
+		if err != nil {
+			return err
+		}
+
+		value.Set(created)
+		return nil
+	}
+
+	if value.Kind() == reflect.Slice {
+		created := reflect.MakeSlice(valueType, len(elementsList), len(elementsList))
+		for idx, oneElement := range elementsList {
+			err := app.element(oneElement, created.Index(idx), fmt.Sprintf("%s[%d]", location, idx))
+			if err != nil {
+				return err
+			}
+		}
+
+		value.Set(created)
+		return nil
+	}
+
+	if len(elementsList) != 1 {
+		str := fmt.Sprintf("%s: the token (%s) was expected to contain 1 element, %d found", location, token.Name(), len(elementsList))
+		return errors.New(str)
+	}
+
+	return app.element(elementsList[0], value, location)
+}
+
+func (app *unmarshaller) structure(element asts.Element, value reflect.Value, location string) error {
+	instructionElement := element
+	if instructionElement.IsAST() {
+		instructionElement = instructionElement.AST().Root()
+	}
+
+	if !instructionElement.IsInstruction() {
+		str := fmt.Sprintf("%s: the element (%s) was expected to contain an instruction in order to fill a struct", location, element.Name())
+		return errors.New(str)
+	}
+
+	tokens := instructionElement.Instruction().Tokens()
+	valueType := value.Type()
+	for idx := 0; idx < valueType.NumField(); idx++ {
+		field := valueType.Field(idx)
+		tag, ok := field.Tag.Lookup(tagName)
+		if !ok {
+			continue
+		}
+
+		fieldLocation := fmt.Sprintf("%s.%s", location, field.Name)
+		if !field.IsExported() {
+			str := fmt.Sprintf("%s: the field contains a tag but is not exported", fieldLocation)
+			return errors.New(str)
+		}
+
+		err := app.field(tokens, instructionElement.Name(), tag, value.Field(idx), fieldLocation)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (app *unmarshaller) field(tokens asts.Tokens, name string, tag string, value reflect.Value, location string) error {
+	path, err := normalize(tag)
+	if err != nil {
+		str := fmt.Sprintf("%s: %s", location, err.Error())
+		return errors.New(str)
+	}
+
+	chain, err := app.cursorAdapter.ToChain(strings.Join([]string{name, path}, pathSeparator))
+	if err != nil {
+		str := fmt.Sprintf("%s: the tag (%s) is invalid: %s", location, tag, err.Error())
+		return errors.New(str)
+	}
+
+	_, retToken, retElement, err := tokens.Select(chain)
+	if err != nil {
+		kind := value.Kind()
+		if kind == reflect.Pointer || kind == reflect.Slice {
+			return nil
+		}
+
+		str := fmt.Sprintf("%s: the tag (%s) could not be matched in the element (%s): %s", location, tag, name, err.Error())
+		return errors.New(str)
+	}
+
+	if retElement != nil {
+		return app.element(retElement, value, location)
+	}
+
+	return app.token(retToken, value, location)
+}
+
+func (app *unmarshaller) scalar(data []byte, value reflect.Value, location string) error {
+	valueType := value.Type()
+	if valueType == bytesType {
+		value.SetBytes(data)
+		return nil
+	}
+
+	input := string(data)
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(input)
+		return nil
+	case reflect.Bool:
+		retBool, err := strconv.ParseBool(input)
+		if err != nil {
+			return conversionError(input, valueType, location, err)
+		}
+
+		value.SetBool(retBool)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		retInt, err := strconv.ParseInt(input, 10, valueType.Bits())
+		if err != nil {
+			return conversionError(input, valueType, location, err)
+		}
+
+		value.SetInt(retInt)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		retUint, err := strconv.ParseUint(input, 10, valueType.Bits())
+		if err != nil {
+			return conversionError(input, valueType, location, err)
+		}
+
+		value.SetUint(retUint)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		retFloat, err := strconv.ParseFloat(input, valueType.Bits())
+		if err != nil {
+			return conversionError(input, valueType, location, err)
+		}
+
+		value.SetFloat(retFloat)
+		return nil
+	}
+
+	str := fmt.Sprintf("%s: the type (%s) is not supported", location, valueType.String())
+	return errors.New(str)
+}
@@ -0,0 +1,215 @@
+package unmarshals
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+)
+
+type testProgram struct {
+	Assignments []testAssignment `grammar:"assignment"`
+	First       string           `grammar:"assignment[0][0]/name"`
+	Flag        *bool            `grammar:"flag/boolean"`
+	Ignored     string
+}
+
+type testAssignment struct {
+	Name    []byte       `grammar:"name"`
+	Number  float64      `grammar:"number"`
+	Integer uint         `grammar:"number/digit"`
+	Digits  []string     `grammar:"number/digit"`
+	Element asts.Element `grammar:"number[0][0]"`
+}
+
+type testUnmatched struct {
+	Third testAssignment `grammar:"assignment[0][2]"`
+}
+
+type testInvalidConversion struct {
+	Name int `grammar:"assignment/name"`
+}
+
+func TestUnmarshal_Success(t *testing.T) {
+	ast, err := parse("a = 12; b = 2.1; # true")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program := testProgram{}
+	err = Unmarshal(ast, &program)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(program.Assignments) != 2 {
+		t.Errorf("the program was expected to contain %d assignments, %d returned", 2, len(program.Assignments))
+		return
+	}
+
+	if program.First != "a" {
+		t.Errorf("the first name was expected to be (%s), (%s) returned", "a", program.First)
+		return
+	}
+
+	if program.Flag == nil || !*program.Flag {
+		t.Errorf("the flag was expected to be true")
+		return
+	}
+
+	first := program.Assignments[0]
+	if string(first.Name) != "a" || first.Number != 12 || first.Integer != 12 {
+		t.Errorf("the first assignment was expected to contain (a, 12, 12), (%s, %f, %d) returned", first.Name, first.Number, first.Integer)
+		return
+	}
+
+	if len(first.Digits) != 2 || first.Digits[0] != "1" || first.Digits[1] != "2" {
+		t.Errorf("the digits of the first assignment were expected to be [1 2], %v returned", first.Digits)
+		return
+	}
+
+	if first.Element == nil || first.Element.Name() != "number" {
+		t.Errorf("the element of the first assignment was expected to be the number instruction")
+		return
+	}
+
+	second := program.Assignments[1]
+	if string(second.Name) != "b" || second.Number != 2.1 || second.Integer != 2 {
+		t.Errorf("the second assignment was expected to contain (b, 2.1, 2), (%s, %f, %d) returned", second.Name, second.Number, second.Integer)
+		return
+	}
+}
+
+func TestUnmarshal_withoutOptional_Success(t *testing.T) {
+	ast, err := parse("a = 1;")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program := testProgram{}
+	err = Unmarshal(ast, &program)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if program.Flag != nil {
+		t.Errorf("the flag was expected to be nil")
+		return
+	}
+}
+
+func TestUnmarshal_withUnmatchedTag_returnsError(t *testing.T) {
+	ast, err := parse("a = 1; b = 2;")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = Unmarshal(ast, &testUnmatched{})
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	if !strings.Contains(err.Error(), "testUnmatched.Third") || !strings.Contains(err.Error(), "assignment[0][2]") {
+		t.Errorf("the error was expected to contain the field and the tag, (%s) returned", err.Error())
+		return
+	}
+}
+
+func TestUnmarshal_withInvalidConversion_returnsError(t *testing.T) {
+	ast, err := parse("a = 1;")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = Unmarshal(ast, &testInvalidConversion{})
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	if !strings.Contains(err.Error(), "testInvalidConversion.Name") {
+		t.Errorf("the error was expected to contain the field, (%s) returned", err.Error())
+		return
+	}
+}
+
+func TestUnmarshal_withoutPointer_returnsError(t *testing.T) {
+	ast, err := parse("a = 1;")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = Unmarshal(ast, testProgram{})
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func parse(input string) (asts.AST, error) {
+	grammar, _, err := grammars.NewAdapter().ToGrammar([]byte(`
+		v1;
+		> .program;
+		# .SPACE;
+
+		program: .assignment+ .flag?
+				;
+
+		assignment: .name .EQUAL .number .SEMICOLON
+					;
+
+		flag: .HASH .boolean
+			;
+
+		boolean: .TRUE
+				| .FALSE
+				;
+
+		name: .LL_A
+			| .LL_B
+			;
+
+		number: .digit+ .fraction?
+				;
+
+		fraction: .DOT .digit+
+				;
+
+		digit: .N_ONE
+			| .N_TWO
+			;
+
+		EQUAL: "=";
+		SEMICOLON: ";";
+		HASH: "#";
+		DOT: ".";
+		TRUE: "true";
+		FALSE: "false";
+		LL_A: "a";
+		LL_B: "b";
+		N_ONE: "1";
+		N_TWO: "2";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		return nil, err
+	}
+
+	repository := grammars.NewRepositoryMemory(map[string]grammars.Grammar{})
+	ast, _, err := asts.NewAdapter(repository).ToAST(grammar, []byte(input))
+	if err != nil {
+		return nil, err
+	}
+
+	return ast, nil
+}
@@ -0,0 +1,178 @@
+package asts
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/uniques"
+)
+
+const validatorPathSeparator = "/"
+
+type uniqueScope struct {
+	instruction Instruction
+	token       string
+}
+
+type uniqueOccurrence struct {
+	path   string
+	value  []byte
+	unique uniques.Unique
+}
+
+// validator validates the uniqueness constraints of an AST:
+//   - the scope of a constrained token is the index-th nearest enclosing instruction of the unique's element, the instruction of the token included
+//   - every element of a constrained token is an occurrence of its value inside that scope
+//   - a MustBe occurrence fails when another MustBe occurrence of the same token shares its value inside the same scope
+//   - a MustNot occurrence fails when no other occurrence of the same token shares its value inside the same scope
+//   - a constraint whose scope does not enclose the token, such as when an inner block is parsed on its own, is not evaluated
+//   - an AST element embedded from another grammar is validated when it is built, so its instructions are never part of the enclosing scopes
+type validator struct {
+	scopes      []uniqueScope
+	occurrences map[uniqueScope][]uniqueOccurrence
+}
+
+func createValidator() *validator {
+	out := validator{
+		scopes:      []uniqueScope{},
+		occurrences: map[uniqueScope][]uniqueOccurrence{},
+	}
+
+	return &out
+}
+
+// Validate validates the uniqueness constraints of the tokens contained in the root element
+func (app *validator) Validate(root Element) error {
+	err := app.element(root, []Instruction{}, "")
+	if err != nil {
+		return err
+	}
+
+	for _, oneScope := range app.scopes {
+		occurrences := app.occurrences[oneScope]
+		for idx, oneOccurrence := range occurrences {
+			err := app.occurrence(oneScope, oneOccurrence, idx, occurrences)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (app *validator) occurrence(
+	scope uniqueScope,
+	occurrence uniqueOccurrence,
+	index int,
+	occurrences []uniqueOccurrence,
+) error {
+	unique := occurrence.unique
+	for idx, oneOccurrence := range occurrences {
+		if idx == index || !bytes.Equal(occurrence.value, oneOccurrence.value) {
+			continue
+		}
+
+		if unique.MustNot() {
+			return nil
+		}
+
+		if oneOccurrence.unique.MustBe() {
+			str := fmt.Sprintf(
+				"the token (name: %s, value: %s) was expected to be unique in the element (name: %s, index: %d) but it is duplicated at %s and %s",
+				scope.token,
+				occurrence.value,
+				unique.Element().Name(),
+				unique.Index(),
+				occurrence.path,
+				oneOccurrence.path,
+			)
+
+			return errors.New(str)
+		}
+	}
+
+	if unique.MustNot() {
+		str := fmt.Sprintf(
+			"the token (name: %s, value: %s) was expected to NOT be unique in the element (name: %s, index: %d) but no other occurrence was found for the one at %s",
+			scope.token,
+			occurrence.value,
+			unique.Element().Name(),
+			unique.Index(),
+			occurrence.path,
+		)
+
+		return errors.New(str)
+	}
+
+	return nil
+}
+
+func (app *validator) element(
+	element Element,
+	parents []Instruction,
+	path string,
+) error {
+	if !element.IsInstruction() {
+		return nil
+	}
+
+	instruction := element.Instruction()
+	currentParents := append(append([]Instruction{}, parents...), instruction)
+	currentPath := fmt.Sprintf("%s%s#%d", path, instruction.Block(), instruction.Line())
+	for _, oneToken := range instruction.Tokens().List() {
+		for elementIdx, oneElement := range oneToken.Elements().List() {
+			elementPath := fmt.Sprintf("%s.%s[%d]", currentPath, oneToken.Name(), elementIdx)
+			if oneToken.HasUnique() {
+				app.register(oneToken, oneElement, currentParents, elementPath)
+			}
+
+			err := app.element(oneElement, currentParents, fmt.Sprintf("%s%s", elementPath, validatorPathSeparator))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (app *validator) register(
+	token Token,
+	element Element,
+	parents []Instruction,
+	path string,
+) {
+	unique := token.Unique()
+	scopeName := unique.Element().Name()
+	expectedIndex := unique.Index()
+	cpt := uint(0)
+	for idx := len(parents) - 1; idx >= 0; idx-- {
+		if parents[idx].Block() != scopeName {
+			continue
+		}
+
+		if cpt != expectedIndex {
+			cpt++
+			continue
+		}
+
+		scope := uniqueScope{
+			instruction: parents[idx],
+			token:       token.Name(),
+		}
+
+		if _, ok := app.occurrences[scope]; !ok {
+			app.scopes = append(app.scopes, scope)
+		}
+
+		app.occurrences[scope] = append(app.occurrences[scope], uniqueOccurrence{
+			path:   path,
+			value:  element.Value(),
+			unique: unique,
+		})
+
+		return
+	}
+}
@@ -0,0 +1,46 @@
+package visitors
+
+import "errors"
+
+type builder struct {
+	preOrder  VisitFn
+	postOrder VisitFn
+}
+
+func createBuilder() Builder {
+	out := builder{
+		preOrder:  nil,
+		postOrder: nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *builder) Create() Builder {
+	return createBuilder()
+}
+
+// WithPreOrder adds a function called before the children of a node are visited
+func (app *builder) WithPreOrder(preOrder VisitFn) Builder {
+	app.preOrder = preOrder
+	return app
+}
+
+// WithPostOrder adds a function called after the children of a node are visited
+func (app *builder) WithPostOrder(postOrder VisitFn) Builder {
+	app.postOrder = postOrder
+	return app
+}
+
+// Now builds a new Visitor instance
+func (app *builder) Now() (Visitor, error) {
+	if app.preOrder == nil && app.postOrder == nil {
+		return nil, errors.New("the preOrder or postOrder function is mandatory in order to build a Visitor instance")
+	}
+
+	return createVisitor(
+		app.preOrder,
+		app.postOrder,
+	), nil
+}
@@ -0,0 +1,77 @@
+package visitors
+
+import "github.com/steve-care-software/grammars/domain/engine/asts"
+
+type node struct {
+	depth   uint
+	element asts.Element
+	token   asts.Token
+	parent  Node
+}
+
+func createNodeWithElement(
+	depth uint,
+	element asts.Element,
+	parent Node,
+) Node {
+	return createNodeInternally(depth, element, nil, parent)
+}
+
+func createNodeWithToken(
+	depth uint,
+	token asts.Token,
+	parent Node,
+) Node {
+	return createNodeInternally(depth, nil, token, parent)
+}
+
+func createNodeInternally(
+	depth uint,
+	element asts.Element,
+	token asts.Token,
+	parent Node,
+) Node {
+	out := node{
+		depth:   depth,
+		element: element,
+		token:   token,
+		parent:  parent,
+	}
+
+	return &out
+}
+
+// Depth returns the depth, the root element being at depth 0
+func (obj *node) Depth() uint {
+	return obj.depth
+}
+
+// IsElement returns true if there is an element, false otherwise
+func (obj *node) IsElement() bool {
+	return obj.element != nil
+}
+
+// Element returns the element, if any
+func (obj *node) Element() asts.Element {
+	return obj.element
+}
+
+// IsToken returns true if there is a token, false otherwise
+func (obj *node) IsToken() bool {
+	return obj.token != nil
+}
+
+// Token returns the token, if any
+func (obj *node) Token() asts.Token {
+	return obj.token
+}
+
+// HasParent returns true if there is a parent, false otherwise
+func (obj *node) HasParent() bool {
+	return obj.parent != nil
+}
+
+// Parent returns the parent, if any
+func (obj *node) Parent() Node {
+	return obj.parent
+}
@@ -0,0 +1,229 @@
+package visitors
+
+import (
+	"errors"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+)
+
+type rewriter struct {
+	builder            asts.Builder
+	instructionBuilder asts.InstructionBuilder
+	tokensBuilder      asts.TokensBuilder
+	tokenBuilder       asts.TokenBuilder
+	elementsBuilder    asts.ElementsBuilder
+	elementBuilder     asts.ElementBuilder
+}
+
+func createRewriter(
+	builder asts.Builder,
+	instructionBuilder asts.InstructionBuilder,
+	tokensBuilder asts.TokensBuilder,
+	tokenBuilder asts.TokenBuilder,
+	elementsBuilder asts.ElementsBuilder,
+	elementBuilder asts.ElementBuilder,
+) Rewriter {
+	out := rewriter{
+		builder:            builder,
+		instructionBuilder: instructionBuilder,
+		tokensBuilder:      tokensBuilder,
+		tokenBuilder:       tokenBuilder,
+		elementsBuilder:    elementsBuilder,
+		elementBuilder:     elementBuilder,
+	}
+
+	return &out
+}
+
+// Rewrite returns a new AST whose elements are rewritten pre-order by the function
+func (app *rewriter) Rewrite(ast asts.AST, fn RewriteFn) (asts.AST, error) {
+	retAST, err := app.ast(ast, fn)
+	if err != nil {
+		return nil, err
+	}
+
+	if retAST == nil {
+		return nil, errors.New("the root element of the AST cannot be deleted")
+	}
+
+	return retAST, nil
+}
+
+// ast rewrites the AST, and returns nil if its root is deleted
+func (app *rewriter) ast(ast asts.AST, fn RewriteFn) (asts.AST, error) {
+	root := ast.Root()
+	retRoot, err := app.element(root, fn)
+	if err != nil {
+		return nil, err
+	}
+
+	if retRoot == nil {
+		return nil, nil
+	}
+
+	if retRoot == root {
+		return ast, nil
+	}
+
+	builder := app.builder.Create().WithRoot(retRoot)
+	if ast.HasVersion() {
+		builder.WithVersion(ast.Version())
+	}
+
+	return builder.Now()
+}
+
+// element rewrites the element, and returns nil if it is deleted
+func (app *rewriter) element(element asts.Element, fn RewriteFn) (asts.Element, error) {
+	replacement, err := fn(element)
+	if err != nil {
+		return nil, err
+	}
+
+	if replacement != element {
+		return replacement, nil
+	}
+
+	builder := app.elementBuilder.Create()
+	if element.IsAST() {
+		ast := element.AST()
+		retAST, err := app.ast(ast, fn)
+		if err != nil {
+			return nil, err
+		}
+
+		if retAST == nil {
+			return nil, nil
+		}
+
+		if retAST == ast {
+			return element, nil
+		}
+
+		builder.WithAST(retAST)
+	}
+
+	if element.IsInstruction() {
+		instruction := element.Instruction()
+		retInstruction, err := app.instruction(instruction, fn)
+		if err != nil {
+			return nil, err
+		}
+
+		if retInstruction == nil {
+			return nil, nil
+		}
+
+		if retInstruction == instruction {
+			return element, nil
+		}
+
+		builder.WithInstruction(retInstruction)
+	}
+
+	if element.IsConstant() {
+		return element, nil
+	}
+
+	if element.HasSpan() {
+		builder.WithSpan(element.Span())
+	}
+
+	if element.HasTrivia() {
+		builder.WithTrivia(element.Trivia())
+	}
+
+	return builder.Now()
+}
+
+// instruction rewrites the instruction, and returns nil if none of its tokens remain
+func (app *rewriter) instruction(instruction asts.Instruction, fn RewriteFn) (asts.Instruction, error) {
+	isChanged := false
+	list := []asts.Token{}
+	for _, oneToken := range instruction.Tokens().List() {
+		retToken, err := app.token(oneToken, fn)
+		if err != nil {
+			return nil, err
+		}
+
+		if retToken != oneToken {
+			isChanged = true
+		}
+
+		if retToken == nil {
+			continue
+		}
+
+		list = append(list, retToken)
+	}
+
+	if !isChanged {
+		return instruction, nil
+	}
+
+	if len(list) <= 0 {
+		return nil, nil
+	}
+
+	tokens, err := app.tokensBuilder.Create().
+		WithList(list).
+		Now()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return app.instructionBuilder.Create().
+		WithBlock(instruction.Block()).
+		WithLine(instruction.Line()).
+		WithTokens(tokens).
+		Now()
+}
+
+// token rewrites the token, and returns nil if none of its elements remain
+func (app *rewriter) token(token asts.Token, fn RewriteFn) (asts.Token, error) {
+	isChanged := false
+	list := []asts.Element{}
+	for _, oneElement := range token.Elements().List() {
+		retElement, err := app.element(oneElement, fn)
+		if err != nil {
+			return nil, err
+		}
+
+		if retElement != oneElement {
+			isChanged = true
+		}
+
+		if retElement == nil {
+			continue
+		}
+
+		list = append(list, retElement)
+	}
+
+	if !isChanged {
+		return token, nil
+	}
+
+	if len(list) <= 0 {
+		return nil, nil
+	}
+
+	elements, err := app.elementsBuilder.Create().
+		WithList(list).
+		Now()
+
+	if err != nil {
+		return nil, err
+	}
+
+	builder := app.tokenBuilder.Create().
+		WithName(token.Name()).
+		WithElements(elements)
+
+	if token.HasUnique() {
+		builder.WithUnique(token.Unique())
+	}
+
+	return builder.Now()
+}
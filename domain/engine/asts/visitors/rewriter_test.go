@@ -0,0 +1,190 @@
+package visitors
+
+import (
+	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+)
+
+func TestRewriter_withReplacement_Success(t *testing.T) {
+	ast, err := parse("import a 0; export a;")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retAST, err := NewRewriter().Rewrite(ast, rename("LL_A", "LL_B", "b"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !retAST.HasVersion() || retAST.Version().String() != ast.Version().String() {
+		t.Errorf("the rewritten AST was expected to keep its version")
+		return
+	}
+
+	root := ast.Root()
+	retRoot := retAST.Root()
+	if !root.HasSpan() || !retRoot.HasSpan() {
+		t.Errorf("the rewritten root was expected to keep its span")
+		return
+	}
+
+	if retRoot.Span().Start() != root.Span().Start() || retRoot.Span().End() != root.Span().End() {
+		t.Errorf("the rewritten root was expected to keep the span (%d, %d), (%d, %d) returned", root.Span().Start(), root.Span().End(), retRoot.Span().Start(), retRoot.Span().End())
+		return
+	}
+
+	retExport, err := retAST.Root().Search("export", 0)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retElement, err := retExport.Elements().Fetch(0)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !retElement.HasSpan() {
+		t.Errorf("the rebuilt export element was expected to keep its span")
+		return
+	}
+
+	retName, err := retElement.Search("name", 0)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if string(retName.Value()) != "b" {
+		t.Errorf("the name was expected to be rewritten to b, %s returned", retName.Value())
+		return
+	}
+
+	// the original AST is left untouched:
+	retOriginalExport, err := ast.Root().Search("export", 0)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if string(retOriginalExport.Value()) != "exporta;" {
+		t.Errorf("the original AST was not expected to be rewritten, %s returned", retOriginalExport.Value())
+		return
+	}
+}
+
+func TestRewriter_withUnchangedTree_returnsSameAST_Success(t *testing.T) {
+	ast, err := parse("import a 0; export a;")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retAST, err := NewRewriter().Rewrite(ast, func(element asts.Element) (asts.Element, error) {
+		return element, nil
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if retAST != ast {
+		t.Errorf("the AST was expected to be returned as is when nothing is rewritten")
+		return
+	}
+}
+
+func TestRewriter_withDeletion_Success(t *testing.T) {
+	ast, err := parse("import a 0; import b 1; export a;")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retAST, err := NewRewriter().Rewrite(ast, func(element asts.Element) (asts.Element, error) {
+		if element.Name() == "export" {
+			return nil, nil
+		}
+
+		return element, nil
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retTokens := retAST.Root().Instruction().Tokens().List()
+	if len(retTokens) != 1 || retTokens[0].Name() != "import" {
+		t.Errorf("the export token was expected to be deleted once it contains no element")
+		return
+	}
+
+	if retTokens[0] != ast.Root().Instruction().Tokens().List()[0] {
+		t.Errorf("the untouched token was expected to be reused")
+		return
+	}
+}
+
+func TestRewriter_withDeletedRoot_returnsError(t *testing.T) {
+	ast, err := parse("import a 0; export a;")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = NewRewriter().Rewrite(ast, func(element asts.Element) (asts.Element, error) {
+		// every descendant is deleted, which leaves the root without any token:
+		if element.Name() != "module" {
+			return nil, nil
+		}
+
+		return element, nil
+	})
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestRewriter_withInvalidUniques_returnsError(t *testing.T) {
+	ast, err := parse("import a 0; import b 1; export a;")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// both imports are named b once rewritten:
+	_, err = NewRewriter().Rewrite(ast, rename("LL_A", "LL_B", "b"))
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func rename(from string, to string, value string) RewriteFn {
+	return func(element asts.Element) (asts.Element, error) {
+		if !element.IsConstant() || element.Name() != from {
+			return element, nil
+		}
+
+		constant, err := asts.NewConstantBuilder().Create().
+			WithName(to).
+			WithValue([]byte(value)).
+			Now()
+
+		if err != nil {
+			return nil, err
+		}
+
+		return asts.NewElementBuilder().Create().
+			WithConstant(constant).
+			Now()
+	}
+}
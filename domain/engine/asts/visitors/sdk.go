@@ -0,0 +1,85 @@
+package visitors
+
+import "github.com/steve-care-software/grammars/domain/engine/asts"
+
+// Action represents what a visit function asks the traversal to do next
+type Action uint8
+
+const (
+	// ActionContinue continues the traversal
+	ActionContinue Action = iota
+
+	// ActionSkip skips the children of the visited node, it behaves like ActionContinue in post-order
+	ActionSkip
+
+	// ActionStop stops the traversal, without error
+	ActionStop
+)
+
+// VisitFn visits a node and returns the next action of the traversal
+type VisitFn func(node Node) (Action, error)
+
+// RewriteFn returns what replaces the provided element:
+//   - the element itself keeps it, and its children are rewritten
+//   - another element replaces its subtree, whose children are not rewritten
+//   - nil deletes it, and a token or an instruction left without elements is deleted as well
+type RewriteFn func(element asts.Element) (asts.Element, error)
+
+// NewBuilder creates a new visitor builder
+func NewBuilder() Builder {
+	return createBuilder()
+}
+
+// NewRewriter creates a new rewriter
+func NewRewriter() Rewriter {
+	builder := asts.NewBuilder()
+	instructionBuilder := asts.NewInstructionBuilder()
+	tokensBuilder := asts.NewTokensBuilder()
+	tokenBuilder := asts.NewTokenBuilder()
+	elementsBuilder := asts.NewElementsBuilder()
+	elementBuilder := asts.NewElementBuilder()
+	return createRewriter(
+		builder,
+		instructionBuilder,
+		tokensBuilder,
+		tokenBuilder,
+		elementsBuilder,
+		elementBuilder,
+	)
+}
+
+// Builder represents the visitor builder
+type Builder interface {
+	Create() Builder
+	WithPreOrder(preOrder VisitFn) Builder
+	WithPostOrder(postOrder VisitFn) Builder
+	Now() (Visitor, error)
+}
+
+// Visitor traverses the elements and tokens of an AST depth-first, the root of an embedded AST is a child of its element
+type Visitor interface {
+	// Visit visits the root of the AST and its descendants
+	Visit(ast asts.AST) error
+
+	// VisitElement visits the element and its descendants
+	VisitElement(element asts.Element) error
+}
+
+// Node represents a visited node, either an element or a token
+type Node interface {
+	Depth() uint
+	IsElement() bool
+	Element() asts.Element
+	IsToken() bool
+	Token() asts.Token
+	HasParent() bool
+	Parent() Node
+}
+
+// Rewriter rewrites ASTs
+type Rewriter interface {
+	// Rewrite returns a new AST whose elements are rewritten pre-order by the function, the rewritten ancestors are
+	// rebuilt with the span and trivia of their original element, so their span still locates them in the parsed input
+	// even if their content changed
+	Rewrite(ast asts.AST, fn RewriteFn) (asts.AST, error)
+}
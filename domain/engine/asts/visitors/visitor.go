@@ -0,0 +1,96 @@
+package visitors
+
+import "github.com/steve-care-software/grammars/domain/engine/asts"
+
+type visitor struct {
+	preOrder  VisitFn
+	postOrder VisitFn
+}
+
+func createVisitor(
+	preOrder VisitFn,
+	postOrder VisitFn,
+) Visitor {
+	out := visitor{
+		preOrder:  preOrder,
+		postOrder: postOrder,
+	}
+
+	return &out
+}
+
+// Visit visits the root of the AST and its descendants
+func (app *visitor) Visit(ast asts.AST) error {
+	return app.VisitElement(ast.Root())
+}
+
+// VisitElement visits the element and its descendants
+func (app *visitor) VisitElement(element asts.Element) error {
+	_, err := app.element(element, nil, 0)
+	return err
+}
+
+// element visits the element and returns true if the traversal is stopped
+func (app *visitor) element(element asts.Element, parent Node, depth uint) (bool, error) {
+	current := createNodeWithElement(depth, element, parent)
+	isStopped, isSkipped, err := app.call(app.preOrder, current)
+	if err != nil || isStopped {
+		return isStopped, err
+	}
+
+	if !isSkipped {
+		if element.IsAST() {
+			isStopped, err := app.element(element.AST().Root(), current, depth+1)
+			if err != nil || isStopped {
+				return isStopped, err
+			}
+		}
+
+		if element.IsInstruction() {
+			for _, oneToken := range element.Instruction().Tokens().List() {
+				isStopped, err := app.token(oneToken, current, depth+1)
+				if err != nil || isStopped {
+					return isStopped, err
+				}
+			}
+		}
+	}
+
+	isStopped, _, err = app.call(app.postOrder, current)
+	return isStopped, err
+}
+
+// token visits the token and returns true if the traversal is stopped
+func (app *visitor) token(token asts.Token, parent Node, depth uint) (bool, error) {
+	current := createNodeWithToken(depth, token, parent)
+	isStopped, isSkipped, err := app.call(app.preOrder, current)
+	if err != nil || isStopped {
+		return isStopped, err
+	}
+
+	if !isSkipped {
+		for _, oneElement := range token.Elements().List() {
+			isStopped, err := app.element(oneElement, current, depth+1)
+			if err != nil || isStopped {
+				return isStopped, err
+			}
+		}
+	}
+
+	isStopped, _, err = app.call(app.postOrder, current)
+	return isStopped, err
+}
+
+// call calls the function, if any, and returns true if the traversal is stopped, then true if the children are skipped
+func (app *visitor) call(fn VisitFn, current Node) (bool, bool, error) {
+	if fn == nil {
+		return false, false, nil
+	}
+
+	action, err := fn(current)
+	if err != nil {
+		return false, false, err
+	}
+
+	return action == ActionStop, action == ActionSkip, nil
+}
@@ -0,0 +1,242 @@
+package visitors
+
+import (
+	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+)
+
+func TestVisitor_Success(t *testing.T) {
+	ast, err := parse("import a 0; import b 1; export a;")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	preOrder := []string{}
+	postOrder := []string{}
+	visitor, err := NewBuilder().Create().
+		WithPreOrder(func(node Node) (Action, error) {
+			if node.IsElement() {
+				preOrder = append(preOrder, node.Element().Name())
+			}
+
+			return ActionContinue, nil
+		}).
+		WithPostOrder(func(node Node) (Action, error) {
+			if node.IsElement() {
+				postOrder = append(postOrder, node.Element().Name())
+			}
+
+			return ActionContinue, nil
+		}).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = visitor.Visit(ast)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(preOrder) != len(postOrder) || len(preOrder) <= 0 {
+		t.Errorf("the pre-order and post-order visits were expected to contain the same amount of elements")
+		return
+	}
+
+	if preOrder[0] != "module" || postOrder[len(postOrder)-1] != "module" {
+		t.Errorf("the root was expected to be visited first in pre-order and last in post-order")
+		return
+	}
+
+	if postOrder[0] != "IMPORT" {
+		t.Errorf("the first leaf was expected to be visited first in post-order, %s returned", postOrder[0])
+		return
+	}
+}
+
+func TestVisitor_withSkip_Success(t *testing.T) {
+	ast, err := parse("import a 0; import b 1; export a;")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	names := []string{}
+	visitor, err := NewBuilder().Create().
+		WithPreOrder(func(node Node) (Action, error) {
+			if node.IsToken() {
+				return ActionContinue, nil
+			}
+
+			names = append(names, node.Element().Name())
+			if node.Element().Name() == "import" {
+				return ActionSkip, nil
+			}
+
+			return ActionContinue, nil
+		}).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = visitor.Visit(ast)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	for _, oneName := range names {
+		if oneName == "bit" || oneName == "IMPORT" {
+			t.Errorf("the children of the skipped imports were not expected to be visited")
+			return
+		}
+	}
+
+	if names[len(names)-1] != "SEMICOLON" {
+		t.Errorf("the export was expected to be visited after the skipped imports")
+		return
+	}
+}
+
+func TestVisitor_withStop_Success(t *testing.T) {
+	ast, err := parse("import a 0; import b 1; export a;")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	var found asts.Element
+	depth := uint(0)
+	amount := 0
+	visitor, err := NewBuilder().Create().
+		WithPreOrder(func(node Node) (Action, error) {
+			amount++
+			if node.IsElement() && node.Element().Name() == "N_ONE" {
+				found = node.Element()
+				depth = node.Depth()
+				return ActionStop, nil
+			}
+
+			return ActionContinue, nil
+		}).
+		WithPostOrder(func(node Node) (Action, error) {
+			amount++
+			return ActionContinue, nil
+		}).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = visitor.Visit(ast)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if found == nil {
+		t.Errorf("the element was expected to be valid, nil returned")
+		return
+	}
+
+	// module > import (token) > import > bit (token) > bit (AST) > bit > N_ONE (token) > N_ONE
+	if depth != 7 {
+		t.Errorf("the depth was expected to be %d, %d returned", 7, depth)
+		return
+	}
+
+	retAmount := amount
+	err = visitor.Visit(ast)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if amount != retAmount*2 {
+		t.Errorf("the traversal was expected to stop at the same node")
+		return
+	}
+}
+
+func TestBuilder_withoutFunction_returnsError(t *testing.T) {
+	_, err := NewBuilder().Create().Now()
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func parse(input string) (asts.AST, error) {
+	grammarAdapter := grammars.NewAdapter()
+	bitGrammar, _, err := grammarAdapter.ToGrammar([]byte(`
+		v1;
+		> .bit;
+		# .SPACE;
+
+		bit: .N_ZERO
+		   | .N_ONE
+		   ;
+
+		N_ZERO: "0";
+		N_ONE: "1";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		return nil, err
+	}
+
+	repository := grammars.NewRepositoryMemory(map[string]grammars.Grammar{})
+	err = repository.Insert([]string{"lang", "bit"}, bitGrammar)
+	if err != nil {
+		return nil, err
+	}
+
+	grammar, _, err := grammarAdapter.ToGrammar([]byte(`
+		v1;
+		> .module;
+		# .SPACE;
+
+		module: .import+ .export?
+			  ;
+
+		import: .IMPORT #.module .name .bit[/lang/bit, ^1] .SEMICOLON
+			  ;
+
+		export: .EXPORT $.module .name .SEMICOLON
+			  ;
+
+		name: .LL_A
+			| .LL_B
+			;
+
+		IMPORT: "import";
+		EXPORT: "export";
+		SEMICOLON: ";";
+		LL_A: "a";
+		LL_B: "b";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		return nil, err
+	}
+
+	ast, _, err := asts.NewAdapter(repository).ToAST(grammar, []byte(input))
+	if err != nil {
+		return nil, err
+	}
+
+	return ast, nil
+}
@@ -0,0 +1,409 @@
+package bundles
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/resolvers"
+	"github.com/steve-care-software/grammars/domain/engine/signatures"
+)
+
+type jsonManifest struct {
+	Root       jsonGrammar   `json:"root"`
+	Lock       jsonFile      `json:"lock"`
+	Grammars   []jsonGrammar `json:"grammars"`
+	Corpora    []jsonFile    `json:"corpora,omitempty"`
+	Signatures []jsonFile    `json:"signatures,omitempty"`
+}
+
+type jsonGrammar struct {
+	Path    string `json:"path,omitempty"`
+	Version string `json:"version"`
+	File    string `json:"file"`
+	Hash    string `json:"hash"`
+}
+
+type jsonFile struct {
+	File string `json:"file"`
+	Hash string `json:"hash"`
+}
+
+type archiveFile struct {
+	name    string
+	content []byte
+}
+
+type adapter struct {
+	grammarAdapter   grammars.Adapter
+	lockAdapter      resolvers.Adapter
+	signatureAdapter signatures.Adapter
+	hasher           signatures.Hasher
+	builder          Builder
+	entryBuilder     EntryBuilder
+}
+
+func createAdapter(
+	grammarAdapter grammars.Adapter,
+	lockAdapter resolvers.Adapter,
+	signatureAdapter signatures.Adapter,
+	hasher signatures.Hasher,
+	builder Builder,
+	entryBuilder EntryBuilder,
+) Adapter {
+	out := adapter{
+		grammarAdapter:   grammarAdapter,
+		lockAdapter:      lockAdapter,
+		signatureAdapter: signatureAdapter,
+		hasher:           hasher,
+		builder:          builder,
+		entryBuilder:     entryBuilder,
+	}
+
+	return &out
+}
+
+// ToBytes converts a bundle to its archive
+func (app *adapter) ToBytes(bundle Bundle) ([]byte, error) {
+	files := []archiveFile{}
+	manifest := jsonManifest{
+		Grammars: []jsonGrammar{},
+	}
+
+	root, rootFileIns, err := app.grammarToFile(rootFile, "", bundle.Root())
+	if err != nil {
+		return nil, err
+	}
+
+	manifest.Root = root
+	files = append(files, rootFileIns)
+
+	lockContent, err := app.lockAdapter.ToBytes(bundle.Lock())
+	if err != nil {
+		return nil, err
+	}
+
+	manifest.Lock = fileToManifest(lockFile, lockContent)
+	files = append(files, archiveFile{
+		name:    lockFile,
+		content: lockContent,
+	})
+
+	for _, oneEntry := range bundle.Entries() {
+		grammar := oneEntry.Grammar()
+		segments := append([]string{grammarsDirectory}, oneEntry.Path()...)
+		name := path.Join(append(segments, grammars.FileName(grammar.Version()))...)
+		manifestGrammar, file, err := app.grammarToFile(name, fmt.Sprintf("/%s", strings.Join(oneEntry.Path(), "/")), grammar)
+		if err != nil {
+			return nil, err
+		}
+
+		manifest.Grammars = append(manifest.Grammars, manifestGrammar)
+		files = append(files, file)
+	}
+
+	if bundle.HasCorpora() {
+		corpora := bundle.Corpora()
+		names := []string{}
+		for name := range corpora {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+		for _, oneName := range names {
+			name := path.Join(corporaDirectory, oneName)
+			manifest.Corpora = append(manifest.Corpora, fileToManifest(name, corpora[oneName]))
+			files = append(files, archiveFile{
+				name:    name,
+				content: corpora[oneName],
+			})
+		}
+	}
+
+	if bundle.HasSignatures() {
+		for _, oneSignature := range bundle.Signatures() {
+			content, err := app.signatureAdapter.ToBytes(oneSignature)
+			if err != nil {
+				return nil, err
+			}
+
+			name := path.Join(signaturesDirectory, fmt.Sprintf("%s%s", hex.EncodeToString(oneSignature.Hash()), signatureFileExtension))
+			manifest.Signatures = append(manifest.Signatures, fileToManifest(name, content))
+			files = append(files, archiveFile{
+				name:    name,
+				content: content,
+			})
+		}
+	}
+
+	manifestContent, err := json.MarshalIndent(manifest, "", "\t")
+	if err != nil {
+		return nil, err
+	}
+
+	// the manifest is written first, so that it can be read before the files it lists:
+	files = append([]archiveFile{
+		{
+			name:    manifestFile,
+			content: manifestContent,
+		},
+	}, files...)
+
+	buffer := bytes.Buffer{}
+	writer := tar.NewWriter(&buffer)
+	for _, oneFile := range files {
+		err := writer.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     oneFile.name,
+			Mode:     0644,
+			Size:     int64(len(oneFile.content)),
+			ModTime:  time.Unix(0, 0),
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = writer.Write(oneFile.content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// ToBundle converts an archive to a bundle, after verifying the hashes of its manifest
+func (app *adapter) ToBundle(input []byte) (Bundle, error) {
+	files, err := readArchive(input)
+	if err != nil {
+		return nil, err
+	}
+
+	manifestContent, ok := files[manifestFile]
+	if !ok {
+		str := fmt.Sprintf("the bundle does not contain its manifest (%s)", manifestFile)
+		return nil, errors.New(str)
+	}
+
+	manifest := jsonManifest{}
+	err = json.Unmarshal(manifestContent, &manifest)
+	if err != nil {
+		str := fmt.Sprintf("the manifest of the bundle is invalid: %s", err.Error())
+		return nil, errors.New(str)
+	}
+
+	root, err := app.fileToGrammar(files, manifest.Root)
+	if err != nil {
+		return nil, err
+	}
+
+	lockContent, err := fetchFile(files, manifest.Lock)
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := app.lockAdapter.ToLock(lockContent)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []Entry{}
+	for _, oneGrammar := range manifest.Grammars {
+		grammar, err := app.fileToGrammar(files, oneGrammar)
+		if err != nil {
+			return nil, err
+		}
+
+		pathList := []string{}
+		for _, oneSegment := range strings.Split(oneGrammar.Path, "/") {
+			if oneSegment != "" {
+				pathList = append(pathList, oneSegment)
+			}
+		}
+
+		entry, err := app.entryBuilder.Create().
+			WithPath(pathList).
+			WithGrammar(grammar).
+			Now()
+
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	builder := app.builder.Create().
+		WithRoot(root).
+		WithLock(lock).
+		WithEntries(entries)
+
+	if len(manifest.Corpora) > 0 {
+		corpora := map[string][]byte{}
+		for _, oneCorpus := range manifest.Corpora {
+			content, err := fetchFile(files, oneCorpus)
+			if err != nil {
+				return nil, err
+			}
+
+			name := strings.TrimPrefix(oneCorpus.File, fmt.Sprintf("%s/", corporaDirectory))
+			corpora[name] = content
+		}
+
+		builder.WithCorpora(corpora)
+	}
+
+	if len(manifest.Signatures) > 0 {
+		signaturesList := []signatures.Signature{}
+		for _, oneSignature := range manifest.Signatures {
+			content, err := fetchFile(files, oneSignature)
+			if err != nil {
+				return nil, err
+			}
+
+			signature, err := app.signatureAdapter.ToSignature(content)
+			if err != nil {
+				return nil, err
+			}
+
+			signaturesList = append(signaturesList, signature)
+		}
+
+		builder.WithSignatures(signaturesList)
+	}
+
+	return builder.Now()
+}
+
+func (app *adapter) grammarToFile(name string, pathStr string, grammar grammars.Grammar) (jsonGrammar, archiveFile, error) {
+	content, err := app.grammarAdapter.ToBytes(grammar)
+	if err != nil {
+		return jsonGrammar{}, archiveFile{}, err
+	}
+
+	hash, err := app.hasher.Hash(grammar)
+	if err != nil {
+		return jsonGrammar{}, archiveFile{}, err
+	}
+
+	return jsonGrammar{
+		Path:    pathStr,
+		Version: grammar.Version().String(),
+		File:    name,
+		Hash:    hex.EncodeToString(hash),
+	}, archiveFile{
+		name:    name,
+		content: content,
+	}, nil
+}
+
+func (app *adapter) fileToGrammar(files map[string][]byte, manifestGrammar jsonGrammar) (grammars.Grammar, error) {
+	content, ok := files[manifestGrammar.File]
+	if !ok {
+		str := fmt.Sprintf("the bundle does not contain the file (%s) listed in its manifest", manifestGrammar.File)
+		return nil, errors.New(str)
+	}
+
+	grammar, remaining, err := app.grammarAdapter.ToGrammar(content)
+	if err != nil {
+		str := fmt.Sprintf("the grammar file (%s) could not be parsed: %s", manifestGrammar.File, err.Error())
+		return nil, errors.New(str)
+	}
+
+	if len(bytes.TrimSpace(remaining)) > 0 {
+		str := fmt.Sprintf("the grammar file (%s) was expected to contain no remaining data after its definition", manifestGrammar.File)
+		return nil, errors.New(str)
+	}
+
+	if grammar.Version().String() != manifestGrammar.Version {
+		str := fmt.Sprintf("the grammar file (%s) was expected to declare the version (%s) of the manifest, %s declared", manifestGrammar.File, manifestGrammar.Version, grammar.Version().String())
+		return nil, errors.New(str)
+	}
+
+	hash, err := app.hasher.Hash(grammar)
+	if err != nil {
+		return nil, err
+	}
+
+	if hex.EncodeToString(hash) != manifestGrammar.Hash {
+		str := fmt.Sprintf("the grammar file (%s) does not match the hash of the manifest", manifestGrammar.File)
+		return nil, errors.New(str)
+	}
+
+	return grammar, nil
+}
+
+func fileToManifest(name string, content []byte) jsonFile {
+	hash := sha256.Sum256(content)
+	return jsonFile{
+		File: name,
+		Hash: hex.EncodeToString(hash[:]),
+	}
+}
+
+func fetchFile(files map[string][]byte, manifestFile jsonFile) ([]byte, error) {
+	content, ok := files[manifestFile.File]
+	if !ok {
+		str := fmt.Sprintf("the bundle does not contain the file (%s) listed in its manifest", manifestFile.File)
+		return nil, errors.New(str)
+	}
+
+	hash := sha256.Sum256(content)
+	if hex.EncodeToString(hash[:]) != manifestFile.Hash {
+		str := fmt.Sprintf("the file (%s) does not match the hash of the manifest", manifestFile.File)
+		return nil, errors.New(str)
+	}
+
+	return content, nil
+}
+
+func readArchive(input []byte) (map[string][]byte, error) {
+	output := map[string][]byte{}
+	reader := tar.NewReader(bytes.NewReader(input))
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			str := fmt.Sprintf("the bundle is not a valid tar archive: %s", err.Error())
+			return nil, errors.New(str)
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			str := fmt.Sprintf("the bundle file (%s) was expected to be a regular file", header.Name)
+			return nil, errors.New(str)
+		}
+
+		if _, ok := output[header.Name]; ok {
+			str := fmt.Sprintf("the bundle contains the file (%s) more than once", header.Name)
+			return nil, errors.New(str)
+		}
+
+		content, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, err
+		}
+
+		output[header.Name] = content
+	}
+
+	return output, nil
+}
@@ -0,0 +1,330 @@
+package bundles
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/resolvers"
+	"github.com/steve-care-software/grammars/domain/engine/signatures"
+)
+
+func TestExporter_Success(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	root, archive, err := export(privateKey)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	adapter := NewAdapter()
+	bundle, err := adapter.ToBundle(archive)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retArchive, err := adapter.ToBytes(bundle)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !bytes.Equal(archive, retArchive) {
+		t.Errorf("the archive was expected to be the same once converted back and forth")
+		return
+	}
+
+	// the bundle is imported in an empty repository, that only accepts signed grammars:
+	memory := grammars.NewRepositoryMemory(map[string]grammars.Grammar{})
+	repository := signatures.NewRepository(
+		signatures.NewVerifier([]ed25519.PublicKey{publicKey}),
+		memory,
+	)
+
+	retBundle, err := NewImporter().Import(archive, repository)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	list, err := memory.List()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(list) != 2 {
+		t.Errorf("the repository was expected to contain %d grammar paths, %d returned", 2, len(list))
+		return
+	}
+
+	corpus, ok := retBundle.Corpora()["assignments/valid.txt"]
+	if !retBundle.HasCorpora() || !ok {
+		t.Errorf("the bundle was expected to contain its corpus")
+		return
+	}
+
+	parserAdapter := asts.NewAdapter(resolvers.NewRepository(retBundle.Lock(), repository))
+	_, _, err = parserAdapter.ToAST(root, corpus)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+}
+
+func TestImporter_withFailedInsertion_rollsBack(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, archive, err := export(privateKey)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// the second insertion fails, so the first one must be rolled back:
+	memory := grammars.NewRepositoryMemory(map[string]grammars.Grammar{})
+	repository := signatures.NewRepository(
+		signatures.NewVerifier([]ed25519.PublicKey{publicKey}),
+		&failingRepository{
+			Repository: memory,
+			remaining:  1,
+		},
+	)
+
+	_, err = NewImporter().Import(archive, repository)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	list, err := memory.List()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(list) != 0 {
+		t.Errorf("the inserted grammars were expected to be rolled back, %d paths remaining", len(list))
+		return
+	}
+
+	bundle, err := NewAdapter().ToBundle(archive)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	for _, oneSignature := range bundle.Signatures() {
+		if repository.IsRegistered(oneSignature) {
+			t.Errorf("the registered signatures were expected to be rolled back")
+			return
+		}
+	}
+}
+
+func TestAdapter_withTamperedGrammar_returnsError(t *testing.T) {
+	_, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, archive, err := export(privateKey)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	buffer := bytes.Buffer{}
+	reader := tar.NewReader(bytes.NewReader(archive))
+	writer := tar.NewWriter(&buffer)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		content, err := io.ReadAll(reader)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if strings.HasPrefix(header.Name, "grammars/lang/digit/") {
+			content = bytes.Replace(content, []byte(`"0"`), []byte(`"1"`), 1)
+			header.Size = int64(len(content))
+		}
+
+		err = writer.WriteHeader(header)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		_, err = writer.Write(content)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+	}
+
+	err = writer.Close()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = NewAdapter().ToBundle(buffer.Bytes())
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestBuilder_withCorpusOutsideDirectory_returnsError(t *testing.T) {
+	root, _, err := grammars.NewAdapter().ToGrammar([]byte(`
+		v1;
+		> .value;
+		# .SPACE;
+
+		value: .N_ZERO;
+
+		N_ZERO: "0";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	lock, err := resolvers.NewResolver(grammars.NewRepositoryMemory(map[string]grammars.Grammar{})).Resolve(root)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = NewBuilder().Create().
+		WithRoot(root).
+		WithLock(lock).
+		WithCorpora(map[string][]byte{
+			"../outside.txt": []byte("0"),
+		}).
+		Now()
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func export(privateKey ed25519.PrivateKey) (grammars.Grammar, []byte, error) {
+	grammarAdapter := grammars.NewAdapter()
+	repository := grammars.NewRepositoryMemory(map[string]grammars.Grammar{})
+	inputs := map[string]string{
+		"lang/digit": `
+			v1;
+			> .digit;
+			# .SPACE;
+
+			digit: .N_ZERO;
+
+			N_ZERO: "0";
+			SPACE: " ";
+		`,
+		"lang/value": `
+			v1.2;
+			> .value;
+			# .SPACE;
+
+			value: .digit[/lang/digit, ^1];
+
+			SPACE: " ";
+		`,
+	}
+
+	signaturesList := []signatures.Signature{}
+	signer := signatures.NewSigner(privateKey)
+	for path, oneInput := range inputs {
+		grammar, _, err := grammarAdapter.ToGrammar([]byte(oneInput))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		err = repository.Insert(strings.Split(path, "/"), grammar)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		signature, err := signer.Sign(grammar)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		signaturesList = append(signaturesList, signature)
+	}
+
+	root, _, err := grammarAdapter.ToGrammar([]byte(`
+		v1;
+		> .assignment;
+		# .SPACE;
+
+		assignment: .VARIABLE .EQUAL .value[/lang/value, ^1]
+					---
+					valid: "myVariable = 0";
+					;
+
+		VARIABLE: "myVariable";
+		EQUAL: "=";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	archive, err := NewExporter(repository).Export(root, map[string][]byte{
+		"assignments/valid.txt": []byte("myVariable = 0"),
+	}, signaturesList)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return root, archive, nil
+}
+
+type failingRepository struct {
+	grammars.Repository
+	remaining int
+}
+
+func (app *failingRepository) Insert(path []string, grammar grammars.Grammar) error {
+	if app.remaining <= 0 {
+		return errors.New("the insertion failed")
+	}
+
+	app.remaining--
+	return app.Repository.Insert(path, grammar)
+}
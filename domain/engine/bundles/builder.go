@@ -0,0 +1,147 @@
+package bundles
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/resolvers"
+	"github.com/steve-care-software/grammars/domain/engine/signatures"
+)
+
+type builder struct {
+	hasher     signatures.Hasher
+	root       grammars.Grammar
+	lock       resolvers.Lock
+	entries    []Entry
+	corpora    map[string][]byte
+	signatures []signatures.Signature
+}
+
+func createBuilder(
+	hasher signatures.Hasher,
+) Builder {
+	out := builder{
+		hasher:     hasher,
+		root:       nil,
+		lock:       nil,
+		entries:    nil,
+		corpora:    nil,
+		signatures: nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *builder) Create() Builder {
+	return createBuilder(
+		app.hasher,
+	)
+}
+
+// WithRoot adds a root grammar to the builder
+func (app *builder) WithRoot(root grammars.Grammar) Builder {
+	app.root = root
+	return app
+}
+
+// WithLock adds a lock to the builder
+func (app *builder) WithLock(lock resolvers.Lock) Builder {
+	app.lock = lock
+	return app
+}
+
+// WithEntries adds entries to the builder
+func (app *builder) WithEntries(entries []Entry) Builder {
+	app.entries = entries
+	return app
+}
+
+// WithCorpora adds corpora to the builder
+func (app *builder) WithCorpora(corpora map[string][]byte) Builder {
+	app.corpora = corpora
+	return app
+}
+
+// WithSignatures adds signatures to the builder
+func (app *builder) WithSignatures(signatures []signatures.Signature) Builder {
+	app.signatures = signatures
+	return app
+}
+
+// Now builds a new Bundle instance
+func (app *builder) Now() (Bundle, error) {
+	if app.root == nil {
+		return nil, errors.New("the root grammar is mandatory in order to build a Bundle instance")
+	}
+
+	if app.lock == nil {
+		return nil, errors.New("the lock is mandatory in order to build a Bundle instance")
+	}
+
+	if app.entries == nil {
+		app.entries = []Entry{}
+	}
+
+	// every dependency of the lock must be bundled, with the content it was locked with:
+	dependencies := app.lock.Dependencies()
+	if len(dependencies) != len(app.entries) {
+		str := fmt.Sprintf("the lock contains %d dependencies but %d entries were provided", len(dependencies), len(app.entries))
+		return nil, errors.New(str)
+	}
+
+	for idx, oneDependency := range dependencies {
+		entry := app.entries[idx]
+		pathStr := strings.Join(oneDependency.Path(), "/")
+		if strings.Join(entry.Path(), "/") != pathStr || entry.Grammar().Version().Compare(oneDependency.Version()) != 0 {
+			str := fmt.Sprintf("the entry (index: %d) was expected to contain the grammar (path: %s, version: %s) of the lock", idx, pathStr, oneDependency.Version().String())
+			return nil, errors.New(str)
+		}
+
+		hash, err := app.hasher.Hash(entry.Grammar())
+		if err != nil {
+			return nil, err
+		}
+
+		if !bytes.Equal(hash, oneDependency.Hash()) {
+			str := fmt.Sprintf("the entry (index: %d, path: %s) does not match the hash of the lock", idx, pathStr)
+			return nil, errors.New(str)
+		}
+	}
+
+	if app.corpora != nil && len(app.corpora) <= 0 {
+		app.corpora = nil
+	}
+
+	for name := range app.corpora {
+		if !isValidName(name) {
+			str := fmt.Sprintf("the corpus name (%s) must be a relative path that does not leave the corpora directory", name)
+			return nil, errors.New(str)
+		}
+	}
+
+	if app.signatures != nil && len(app.signatures) <= 0 {
+		app.signatures = nil
+	}
+
+	return createBundle(
+		app.root,
+		app.lock,
+		app.entries,
+		app.corpora,
+		app.signatures,
+	), nil
+}
+
+func isValidName(name string) bool {
+	if name == "" || strings.Contains(name, `\`) || path.IsAbs(name) {
+		return false
+	}
+
+	cleaned := path.Clean(name)
+	return cleaned == name && cleaned != ".." && !strings.HasPrefix(cleaned, "../")
+}
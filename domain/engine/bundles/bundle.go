@@ -0,0 +1,68 @@
+package bundles
+
+import (
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/resolvers"
+	"github.com/steve-care-software/grammars/domain/engine/signatures"
+)
+
+type bundle struct {
+	root       grammars.Grammar
+	lock       resolvers.Lock
+	entries    []Entry
+	corpora    map[string][]byte
+	signatures []signatures.Signature
+}
+
+func createBundle(
+	root grammars.Grammar,
+	lock resolvers.Lock,
+	entries []Entry,
+	corpora map[string][]byte,
+	signatures []signatures.Signature,
+) Bundle {
+	out := bundle{
+		root:       root,
+		lock:       lock,
+		entries:    entries,
+		corpora:    corpora,
+		signatures: signatures,
+	}
+
+	return &out
+}
+
+// Root returns the root grammar
+func (obj *bundle) Root() grammars.Grammar {
+	return obj.root
+}
+
+// Lock returns the lock of the root grammar
+func (obj *bundle) Lock() resolvers.Lock {
+	return obj.lock
+}
+
+// Entries returns the referenced grammars, in the order of the lock's dependencies
+func (obj *bundle) Entries() []Entry {
+	return obj.entries
+}
+
+// HasCorpora returns true if there is corpora, false otherwise
+func (obj *bundle) HasCorpora() bool {
+	return obj.corpora != nil
+}
+
+// Corpora returns the corpora, if any
+func (obj *bundle) Corpora() map[string][]byte {
+	return obj.corpora
+}
+
+// HasSignatures returns true if there is signatures, false otherwise
+func (obj *bundle) HasSignatures() bool {
+	return obj.signatures != nil
+}
+
+// Signatures returns the signatures, if any
+func (obj *bundle) Signatures() []signatures.Signature {
+	return obj.signatures
+}
@@ -0,0 +1,30 @@
+package bundles
+
+import "github.com/steve-care-software/grammars/domain/engine/grammars"
+
+type entry struct {
+	path    []string
+	grammar grammars.Grammar
+}
+
+func createEntry(
+	path []string,
+	grammar grammars.Grammar,
+) Entry {
+	out := entry{
+		path:    path,
+		grammar: grammar,
+	}
+
+	return &out
+}
+
+// Path returns the path
+func (obj *entry) Path() []string {
+	return obj.path
+}
+
+// Grammar returns the grammar
+func (obj *entry) Grammar() grammars.Grammar {
+	return obj.grammar
+}
@@ -0,0 +1,55 @@
+package bundles
+
+import (
+	"errors"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+)
+
+type entryBuilder struct {
+	path    []string
+	grammar grammars.Grammar
+}
+
+func createEntryBuilder() EntryBuilder {
+	out := entryBuilder{
+		path:    nil,
+		grammar: nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *entryBuilder) Create() EntryBuilder {
+	return createEntryBuilder()
+}
+
+// WithPath adds a path to the builder
+func (app *entryBuilder) WithPath(path []string) EntryBuilder {
+	app.path = path
+	return app
+}
+
+// WithGrammar adds a grammar to the builder
+func (app *entryBuilder) WithGrammar(grammar grammars.Grammar) EntryBuilder {
+	app.grammar = grammar
+	return app
+}
+
+// Now builds a new Entry instance
+func (app *entryBuilder) Now() (Entry, error) {
+	if app.path != nil && len(app.path) <= 0 {
+		app.path = nil
+	}
+
+	if app.path == nil {
+		return nil, errors.New("the path is mandatory in order to build an Entry instance")
+	}
+
+	if app.grammar == nil {
+		return nil, errors.New("the grammar is mandatory in order to build an Entry instance")
+	}
+
+	return createEntry(app.path, app.grammar), nil
+}
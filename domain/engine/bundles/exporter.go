@@ -0,0 +1,102 @@
+package bundles
+
+import (
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements/references"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+	"github.com/steve-care-software/grammars/domain/engine/resolvers"
+	"github.com/steve-care-software/grammars/domain/engine/signatures"
+)
+
+type exporter struct {
+	resolver          resolvers.Resolver
+	adapter           Adapter
+	builder           Builder
+	entryBuilder      EntryBuilder
+	referenceBuilder  references.Builder
+	constraintBuilder versions.ConstraintBuilder
+	repository        grammars.Repository
+}
+
+func createExporter(
+	resolver resolvers.Resolver,
+	adapter Adapter,
+	builder Builder,
+	entryBuilder EntryBuilder,
+	referenceBuilder references.Builder,
+	constraintBuilder versions.ConstraintBuilder,
+	repository grammars.Repository,
+) Exporter {
+	out := exporter{
+		resolver:          resolver,
+		adapter:           adapter,
+		builder:           builder,
+		entryBuilder:      entryBuilder,
+		referenceBuilder:  referenceBuilder,
+		constraintBuilder: constraintBuilder,
+		repository:        repository,
+	}
+
+	return &out
+}
+
+// Export resolves the references of the root grammar and returns the archive of its bundle
+func (app *exporter) Export(root grammars.Grammar, corpora map[string][]byte, signaturesList []signatures.Signature) ([]byte, error) {
+	lock, err := app.resolver.Resolve(root)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []Entry{}
+	for _, oneDependency := range lock.Dependencies() {
+		path := oneDependency.Path()
+		constraint, err := app.constraintBuilder.Create().
+			WithVersion(oneDependency.Version()).
+			Now()
+
+		if err != nil {
+			return nil, err
+		}
+
+		reference, err := app.referenceBuilder.Create().
+			WithPath(path).
+			WithName(path[len(path)-1]).
+			WithConstraint(constraint).
+			Now()
+
+		if err != nil {
+			return nil, err
+		}
+
+		grammar, err := app.repository.Retrieve(reference)
+		if err != nil {
+			return nil, err
+		}
+
+		entry, err := app.entryBuilder.Create().
+			WithPath(path).
+			WithGrammar(grammar).
+			Now()
+
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	// the builder verifies that the retrieved grammars still match the hashes of the lock:
+	bundle, err := app.builder.Create().
+		WithRoot(root).
+		WithLock(lock).
+		WithEntries(entries).
+		WithCorpora(corpora).
+		WithSignatures(signaturesList).
+		Now()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return app.adapter.ToBytes(bundle)
+}
@@ -0,0 +1,141 @@
+package bundles
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements/references"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+	"github.com/steve-care-software/grammars/domain/engine/signatures"
+)
+
+type insertion struct {
+	path      []string
+	reference references.Reference
+	previous  grammars.Grammar
+}
+
+type importer struct {
+	adapter           Adapter
+	referenceBuilder  references.Builder
+	constraintBuilder versions.ConstraintBuilder
+}
+
+func createImporter(
+	adapter Adapter,
+	referenceBuilder references.Builder,
+	constraintBuilder versions.ConstraintBuilder,
+) Importer {
+	out := importer{
+		adapter:           adapter,
+		referenceBuilder:  referenceBuilder,
+		constraintBuilder: constraintBuilder,
+	}
+
+	return &out
+}
+
+// Import converts the archive to a bundle and inserts its grammars in the repository, or rolls it back on error
+func (app *importer) Import(input []byte, repository grammars.Repository) (Bundle, error) {
+	bundle, err := app.adapter.ToBundle(input)
+	if err != nil {
+		return nil, err
+	}
+
+	registered := []signatures.Signature{}
+	signedRepository, isSigned := repository.(signatures.Repository)
+	if isSigned && bundle.HasSignatures() {
+		for _, oneSignature := range bundle.Signatures() {
+			isNew := !signedRepository.IsRegistered(oneSignature)
+			err := signedRepository.Register(oneSignature)
+			if err != nil {
+				return nil, app.rollback(err, repository, []insertion{}, registered)
+			}
+
+			if isNew {
+				registered = append(registered, oneSignature)
+			}
+		}
+	}
+
+	insertions := []insertion{}
+	for _, oneEntry := range bundle.Entries() {
+		path := oneEntry.Path()
+		grammar := oneEntry.Grammar()
+		reference, err := app.reference(path, grammar.Version())
+		if err != nil {
+			return nil, app.rollback(err, repository, insertions, registered)
+		}
+
+		// the grammar replaced by the insertion, if any, is restored by the rollback:
+		previous, err := repository.Retrieve(reference)
+		if err != nil {
+			previous = nil
+		}
+
+		err = repository.Insert(path, grammar)
+		if err != nil {
+			return nil, app.rollback(err, repository, insertions, registered)
+		}
+
+		insertions = append(insertions, insertion{
+			path:      path,
+			reference: reference,
+			previous:  previous,
+		})
+	}
+
+	return bundle, nil
+}
+
+func (app *importer) reference(path []string, version versions.Version) (references.Reference, error) {
+	constraint, err := app.constraintBuilder.Create().
+		WithVersion(version).
+		Now()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return app.referenceBuilder.Create().
+		WithPath(path).
+		WithName(path[len(path)-1]).
+		WithConstraint(constraint).
+		Now()
+}
+
+func (app *importer) rollback(cause error, repository grammars.Repository, insertions []insertion, registered []signatures.Signature) error {
+	failures := []string{}
+	for idx := len(insertions) - 1; idx >= 0; idx-- {
+		oneInsertion := insertions[idx]
+		if oneInsertion.previous != nil {
+			err := repository.Insert(oneInsertion.path, oneInsertion.previous)
+			if err != nil {
+				failures = append(failures, err.Error())
+			}
+
+			continue
+		}
+
+		err := repository.Delete(oneInsertion.reference)
+		if err != nil {
+			failures = append(failures, err.Error())
+		}
+	}
+
+	if signedRepository, ok := repository.(signatures.Repository); ok {
+		for _, oneSignature := range registered {
+			signedRepository.Unregister(oneSignature)
+		}
+	}
+
+	if len(failures) > 0 {
+		str := fmt.Sprintf("the bundle could not be imported: %s, and the repository could not be rolled back: %s", cause.Error(), strings.Join(failures, ", "))
+		return errors.New(str)
+	}
+
+	str := fmt.Sprintf("the bundle could not be imported, the repository was rolled back: %s", cause.Error())
+	return errors.New(str)
+}
@@ -0,0 +1,140 @@
+package bundles
+
+import (
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements/references"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+	"github.com/steve-care-software/grammars/domain/engine/resolvers"
+	"github.com/steve-care-software/grammars/domain/engine/signatures"
+)
+
+const manifestFile = "manifest.json"
+const rootFile = "root.grammar"
+const lockFile = "lock.json"
+const grammarsDirectory = "grammars"
+const corporaDirectory = "corpora"
+const signaturesDirectory = "signatures"
+const signatureFileExtension = ".json"
+
+// NewBuilder creates a new bundle builder
+func NewBuilder() Builder {
+	hasher := signatures.NewHasher()
+	return createBuilder(
+		hasher,
+	)
+}
+
+// NewEntryBuilder creates a new entry builder
+func NewEntryBuilder() EntryBuilder {
+	return createEntryBuilder()
+}
+
+// NewAdapter creates a new bundle adapter
+func NewAdapter() Adapter {
+	grammarAdapter := grammars.NewAdapter()
+	lockAdapter := resolvers.NewAdapter()
+	signatureAdapter := signatures.NewAdapter()
+	hasher := signatures.NewHasher()
+	builder := NewBuilder()
+	entryBuilder := NewEntryBuilder()
+	return createAdapter(
+		grammarAdapter,
+		lockAdapter,
+		signatureAdapter,
+		hasher,
+		builder,
+		entryBuilder,
+	)
+}
+
+// NewExporter creates a new exporter that bundles the grammars referenced from the repository
+func NewExporter(
+	repository grammars.Repository,
+) Exporter {
+	resolver := resolvers.NewResolver(repository)
+	adapter := NewAdapter()
+	builder := NewBuilder()
+	entryBuilder := NewEntryBuilder()
+	referenceBuilder := references.NewBuilder()
+	constraintBuilder := versions.NewConstraintBuilder()
+	return createExporter(
+		resolver,
+		adapter,
+		builder,
+		entryBuilder,
+		referenceBuilder,
+		constraintBuilder,
+		repository,
+	)
+}
+
+// NewImporter creates a new importer
+func NewImporter() Importer {
+	adapter := NewAdapter()
+	referenceBuilder := references.NewBuilder()
+	constraintBuilder := versions.NewConstraintBuilder()
+	return createImporter(
+		adapter,
+		referenceBuilder,
+		constraintBuilder,
+	)
+}
+
+// Adapter represents the bundle adapter, a bundle is a tar archive whose manifest lists the paths, versions and hashes of its files
+type Adapter interface {
+	// ToBytes converts a bundle to its archive
+	ToBytes(bundle Bundle) ([]byte, error)
+
+	// ToBundle converts an archive to a bundle, after verifying the hashes of its manifest
+	ToBundle(input []byte) (Bundle, error)
+}
+
+// Exporter exports a grammar along with the grammars it references
+type Exporter interface {
+	// Export resolves the references of the root grammar and returns the archive of its bundle
+	Export(root grammars.Grammar, corpora map[string][]byte, signaturesList []signatures.Signature) ([]byte, error)
+}
+
+// Importer imports bundles
+type Importer interface {
+	// Import converts the archive to a bundle and inserts its grammars in the repository, the signatures
+	// of the bundle are registered first when the repository is a signatures.Repository. When an insertion
+	// fails, the grammars and signatures are rolled back so the repository is left as it was
+	Import(input []byte, repository grammars.Repository) (Bundle, error)
+}
+
+// Builder represents the bundle builder
+type Builder interface {
+	Create() Builder
+	WithRoot(root grammars.Grammar) Builder
+	WithLock(lock resolvers.Lock) Builder
+	WithEntries(entries []Entry) Builder
+	WithCorpora(corpora map[string][]byte) Builder
+	WithSignatures(signatures []signatures.Signature) Builder
+	Now() (Bundle, error)
+}
+
+// Bundle represents a grammar, its referenced grammars and its lock
+type Bundle interface {
+	Root() grammars.Grammar
+	Lock() resolvers.Lock
+	Entries() []Entry
+	HasCorpora() bool
+	Corpora() map[string][]byte
+	HasSignatures() bool
+	Signatures() []signatures.Signature
+}
+
+// EntryBuilder represents the entry builder
+type EntryBuilder interface {
+	Create() EntryBuilder
+	WithPath(path []string) EntryBuilder
+	WithGrammar(grammar grammars.Grammar) EntryBuilder
+	Now() (Entry, error)
+}
+
+// Entry represents a referenced grammar and its path
+type Entry interface {
+	Path() []string
+	Grammar() grammars.Grammar
+}
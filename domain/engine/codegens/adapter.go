@@ -0,0 +1,254 @@
+package codegens
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"go/token"
+	"strings"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens"
+)
+
+type adapter struct {
+}
+
+func createAdapter() Adapter {
+	out := adapter{}
+	return &out
+}
+
+// ToGo generates the Go source code of the grammar
+func (app *adapter) ToGo(grammar grammars.Grammar, packageName string) ([]byte, error) {
+	if !token.IsIdentifier(packageName) {
+		str := fmt.Sprintf("the package name (%s) is not a valid Go identifier", packageName)
+		return nil, errors.New(str)
+	}
+
+	root := grammar.Root()
+	if !root.IsBlock() {
+		str := fmt.Sprintf("the root element (%s) of the grammar was expected to be a block", root.Name())
+		return nil, errors.New(str)
+	}
+
+	// the blocks builder reverses its list, so we restore the declaration order:
+	list := grammar.Blocks().List()
+	declared := []blocks.Block{}
+	for i := len(list) - 1; i >= 0; i-- {
+		declared = append(declared, list[i])
+	}
+
+	typeNames, err := app.typeNames(declared)
+	if err != nil {
+		return nil, err
+	}
+
+	interfaces := map[string]bool{}
+	for _, oneBlock := range declared {
+		interfaces[oneBlock.Name()] = len(oneBlock.Lines().List()) > 1
+	}
+
+	rootTypeName, ok := typeNames[root.Block()]
+	if !ok {
+		str := fmt.Sprintf("the root block (%s) is not declared in the grammar", root.Block())
+		return nil, errors.New(str)
+	}
+
+	buffer := bytes.Buffer{}
+	buffer.WriteString(fmt.Sprintf(
+		headerTemplate,
+		packageName,
+		rootConverterName,
+		rootConverterName,
+		rootTypeName,
+		converterPrefix+rootTypeName,
+	))
+
+	for _, oneBlock := range declared {
+		code, err := app.block(oneBlock, typeNames, interfaces)
+		if err != nil {
+			return nil, err
+		}
+
+		buffer.WriteString(code)
+	}
+
+	buffer.WriteString(helpersTemplate)
+	return format.Source(buffer.Bytes())
+}
+
+func (app *adapter) typeNames(declared []blocks.Block) (map[string]string, error) {
+	reserved := map[string]string{
+		rootConverterName: rootConverterName,
+	}
+
+	reserve := func(typeName string, blockName string) error {
+		if previous, ok := reserved[typeName]; ok {
+			str := fmt.Sprintf("the block (%s) generates the type name (%s), which is already used by (%s)", blockName, typeName, previous)
+			return errors.New(str)
+		}
+
+		reserved[typeName] = blockName
+		return nil
+	}
+
+	out := map[string]string{}
+	for _, oneBlock := range declared {
+		name := oneBlock.Name()
+		typeName := toIdentifier(name)
+		err := reserve(typeName, name)
+		if err != nil {
+			return nil, err
+		}
+
+		linesList := oneBlock.Lines().List()
+		if len(linesList) > 1 {
+			for idx := range linesList {
+				err := reserve(lineTypeName(typeName, uint(idx)), name)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		out[name] = typeName
+	}
+
+	return out, nil
+}
+
+func (app *adapter) block(block blocks.Block, typeNames map[string]string, interfaces map[string]bool) (string, error) {
+	name := block.Name()
+	typeName := typeNames[name]
+	linesList := block.Lines().List()
+	if len(linesList) == 1 {
+		fields, err := app.fields(linesList[0], typeNames, interfaces)
+		if err != nil {
+			return "", err
+		}
+
+		builder := strings.Builder{}
+		builder.WriteString(fmt.Sprintf("\n// %s represents the %s block\n", typeName, name))
+		builder.WriteString(structDeclaration(typeName, fields))
+		builder.WriteString(fmt.Sprintf("\nfunc %s%s(element asts.Element) (%s, error) {\n", converterPrefix, typeName, typeName))
+		builder.WriteString(fmt.Sprintf("out := %s{}\n", typeName))
+		builder.WriteString(fmt.Sprintf("instruction, err := toInstruction(element, %q)\n", name))
+		builder.WriteString("if err != nil {\nreturn out, err\n}\n\n")
+		builder.WriteString(fieldAssignments(fields, "out"))
+		builder.WriteString("return out, nil\n}\n")
+		return builder.String(), nil
+	}
+
+	method := interfaceMethodPrefix + typeName
+	builder := strings.Builder{}
+	builder.WriteString(fmt.Sprintf("\n// %s represents the %s block, implemented by one struct per line\n", typeName, name))
+	builder.WriteString(fmt.Sprintf("type %s interface {\n%s()\n}\n", typeName, method))
+
+	converters := strings.Builder{}
+	converters.WriteString(fmt.Sprintf("\nfunc %s%s(element asts.Element) (%s, error) {\n", converterPrefix, typeName, typeName))
+	converters.WriteString(fmt.Sprintf("instruction, err := toInstruction(element, %q)\n", name))
+	converters.WriteString("if err != nil {\nreturn nil, err\n}\n\n")
+	converters.WriteString("switch instruction.Line() {\n")
+	for idx, oneLine := range linesList {
+		fields, err := app.fields(oneLine, typeNames, interfaces)
+		if err != nil {
+			return "", err
+		}
+
+		lineType := lineTypeName(typeName, uint(idx))
+		builder.WriteString(fmt.Sprintf("\n// %s represents the line %d of the %s block\n", lineType, idx, name))
+		builder.WriteString(structDeclaration(lineType, fields))
+		builder.WriteString(fmt.Sprintf("\nfunc (%s) %s() {}\n", lineType, method))
+		builder.WriteString(fmt.Sprintf("\nfunc %s%s(instruction asts.Instruction) (%s, error) {\n", converterPrefix, lineType, typeName))
+		builder.WriteString(fmt.Sprintf("out := %s{}\n", lineType))
+		if len(fields) > 0 {
+			builder.WriteString("var err error\n")
+			builder.WriteString(fieldAssignments(fields, "nil"))
+		}
+
+		builder.WriteString("return out, nil\n}\n")
+		converters.WriteString(fmt.Sprintf("case %d:\nreturn %s%s(instruction)\n", idx, converterPrefix, lineType))
+	}
+
+	converters.WriteString("}\n\n")
+	converters.WriteString("str := fmt.Sprintf(\"the line (%d) of the block (%s) does not exists\", instruction.Line(), instruction.Block())\n")
+	converters.WriteString("return nil, errors.New(str)\n}\n")
+	builder.WriteString(converters.String())
+	return builder.String(), nil
+}
+
+func (app *adapter) fields(line lines.Line, typeNames map[string]string, interfaces map[string]bool) ([]*field, error) {
+	occurrences := map[string]uint{}
+	names := map[string]string{}
+	out := []*field{}
+	for idx, oneToken := range line.Tokens().List() {
+		tokenName := oneToken.Name()
+		occurrence := occurrences[tokenName]
+		occurrences[tokenName]++
+
+		name := toIdentifier(tokenName)
+		if occurrence > 0 {
+			name = fmt.Sprintf("%s%d", name, occurrence+1)
+		}
+
+		if previous, ok := names[name]; ok {
+			str := fmt.Sprintf("the token (%s) generates the field name (%s), which is already used by the token (%s)", tokenName, name, previous)
+			return nil, errors.New(str)
+		}
+
+		names[name] = tokenName
+		retField, err := app.field(oneToken, name, uint(idx), typeNames, interfaces)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, retField)
+	}
+
+	return out, nil
+}
+
+func (app *adapter) field(grammarToken tokens.Token, name string, position uint, typeNames map[string]string, interfaces map[string]bool) (*field, error) {
+	element := grammarToken.Element()
+	typeName := "[]byte"
+	converter := "toBytes"
+	isStruct := false
+	if !grammarToken.HasReverse() {
+		if element.IsBlock() {
+			blockName := element.Block()
+			retTypeName, ok := typeNames[blockName]
+			if !ok {
+				str := fmt.Sprintf("the token (%s) refers to the block (%s), which is not declared in the grammar", grammarToken.Name(), blockName)
+				return nil, errors.New(str)
+			}
+
+			typeName = retTypeName
+			converter = converterPrefix + retTypeName
+			isStruct = !interfaces[blockName]
+		}
+
+		if element.IsReference() {
+			typeName = "asts.AST"
+			converter = "toAST"
+		}
+	}
+
+	cardinality := grammarToken.Cardinality()
+	if cardinality.HasMax() && *cardinality.Max() == 1 {
+		if cardinality.Min() >= 1 {
+			return createField(name, grammarToken.Name(), position, typeName, "fetchOne", converter), nil
+		}
+
+		if isStruct {
+			return createField(name, grammarToken.Name(), position, "*"+typeName, "fetchOptional", converter), nil
+		}
+
+		return createField(name, grammarToken.Name(), position, typeName, "fetchOptionalValue", converter), nil
+	}
+
+	return createField(name, grammarToken.Name(), position, "[]"+typeName, "fetchMany", converter), nil
+}
@@ -0,0 +1,98 @@
+package codegens
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/codegens/examples/programs"
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+)
+
+func TestAdapter_Success(t *testing.T) {
+	grammar, _, err := grammars.NewAdapter().ToGrammar([]byte(programs.Grammar))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retCode, err := NewAdapter().ToGo(grammar, "programs")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected, err := os.ReadFile(filepath.Join("examples", "programs", "programs.go"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !bytes.Equal(expected, retCode) {
+		t.Errorf("the generated code does not match the example package, regenerate it:\n%s", retCode)
+		return
+	}
+}
+
+func TestAdapter_withInvalidPackageName_returnsError(t *testing.T) {
+	grammar, _, err := grammars.NewAdapter().ToGrammar([]byte(programs.Grammar))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = NewAdapter().ToGo(grammar, "my-programs")
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestAdapter_withCollidingTypeNames_returnsError(t *testing.T) {
+	grammar, _, err := grammars.NewAdapter().ToGrammar([]byte(`
+		v1;
+		> .name;
+		# .SPACE;
+
+		name: .LL_A
+			| .nameLine0
+			;
+
+		nameLine0: .LL_B
+				;
+
+		LL_A: "a";
+		LL_B: "b";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = NewAdapter().ToGo(grammar, "names")
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestToIdentifier_Success(t *testing.T) {
+	cases := map[string]string{
+		"LL_A":         "LlA",
+		"SEMICOLON":    "Semicolon",
+		"_letters":     "Letters",
+		"variableName": "VariableName",
+		"_1":           "X1",
+	}
+
+	for input, expected := range cases {
+		retIdentifier := toIdentifier(input)
+		if retIdentifier != expected {
+			t.Errorf("the identifier of (%s) was expected to be (%s), (%s) returned", input, expected, retIdentifier)
+			return
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package programs
+
+// ValueGrammar represents the grammar referenced by the example grammar
+const ValueGrammar = `
+	v1.2;
+	> .value;
+	# .SPACE;
+
+	value: .N_ZERO
+		 | .N_ONE
+		 ;
+
+	N_ZERO: "0";
+	N_ONE: "1";
+	SPACE: " ";
+`
+
+// Grammar represents the example grammar the code of this package is generated from
+const Grammar = `
+	v1;
+	> .program;
+	# .SPACE;
+
+	program: .declaration+ .usage* .comment?
+			;
+
+	declaration: .LET .name .EQUAL .value[/lang/value, ^1] .SEMICOLON
+				;
+
+	usage: .USE .name? .COMMA .name .SEMICOLON
+			;
+
+	comment: .HASH .name
+			;
+
+	name: .LL_A
+		| .LL_B
+		;
+
+	LET: "let";
+	USE: "use";
+	EQUAL: "=";
+	COMMA: ",";
+	HASH: "#";
+	SEMICOLON: ";";
+	LL_A: "a";
+	LL_B: "b";
+	SPACE: " ";
+`
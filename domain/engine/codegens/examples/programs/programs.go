@@ -0,0 +1,336 @@
+// Code generated by the grammars code generator. DO NOT EDIT.
+
+package programs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+)
+
+// Convert converts an AST of the grammar to its root block
+func Convert(ast asts.AST) (Program, error) {
+	return convertProgram(ast.Root())
+}
+
+// Program represents the program block
+type Program struct {
+	Declaration []Declaration
+	Usage       []Usage
+	Comment     *Comment
+}
+
+func convertProgram(element asts.Element) (Program, error) {
+	out := Program{}
+	instruction, err := toInstruction(element, "program")
+	if err != nil {
+		return out, err
+	}
+
+	tokens := toTokens(instruction, []string{"declaration", "usage", "comment"})
+	out.Declaration, err = fetchMany(instruction, tokens, "declaration", 0, convertDeclaration)
+	if err != nil {
+		return out, err
+	}
+
+	out.Usage, err = fetchMany(instruction, tokens, "usage", 1, convertUsage)
+	if err != nil {
+		return out, err
+	}
+
+	out.Comment, err = fetchOptional(instruction, tokens, "comment", 2, convertComment)
+	if err != nil {
+		return out, err
+	}
+
+	return out, nil
+}
+
+// Declaration represents the declaration block
+type Declaration struct {
+	Let       []byte
+	Name      Name
+	Equal     []byte
+	Value     asts.AST
+	Semicolon []byte
+}
+
+func convertDeclaration(element asts.Element) (Declaration, error) {
+	out := Declaration{}
+	instruction, err := toInstruction(element, "declaration")
+	if err != nil {
+		return out, err
+	}
+
+	tokens := toTokens(instruction, []string{"LET", "name", "EQUAL", "value", "SEMICOLON"})
+	out.Let, err = fetchOne(instruction, tokens, "LET", 0, toBytes)
+	if err != nil {
+		return out, err
+	}
+
+	out.Name, err = fetchOne(instruction, tokens, "name", 1, convertName)
+	if err != nil {
+		return out, err
+	}
+
+	out.Equal, err = fetchOne(instruction, tokens, "EQUAL", 2, toBytes)
+	if err != nil {
+		return out, err
+	}
+
+	out.Value, err = fetchOne(instruction, tokens, "value", 3, toAST)
+	if err != nil {
+		return out, err
+	}
+
+	out.Semicolon, err = fetchOne(instruction, tokens, "SEMICOLON", 4, toBytes)
+	if err != nil {
+		return out, err
+	}
+
+	return out, nil
+}
+
+// Usage represents the usage block
+type Usage struct {
+	Use       []byte
+	Name      Name
+	Comma     []byte
+	Name2     Name
+	Semicolon []byte
+}
+
+func convertUsage(element asts.Element) (Usage, error) {
+	out := Usage{}
+	instruction, err := toInstruction(element, "usage")
+	if err != nil {
+		return out, err
+	}
+
+	tokens := toTokens(instruction, []string{"USE", "name", "COMMA", "name", "SEMICOLON"})
+	out.Use, err = fetchOne(instruction, tokens, "USE", 0, toBytes)
+	if err != nil {
+		return out, err
+	}
+
+	out.Name, err = fetchOptionalValue(instruction, tokens, "name", 1, convertName)
+	if err != nil {
+		return out, err
+	}
+
+	out.Comma, err = fetchOne(instruction, tokens, "COMMA", 2, toBytes)
+	if err != nil {
+		return out, err
+	}
+
+	out.Name2, err = fetchOne(instruction, tokens, "name", 3, convertName)
+	if err != nil {
+		return out, err
+	}
+
+	out.Semicolon, err = fetchOne(instruction, tokens, "SEMICOLON", 4, toBytes)
+	if err != nil {
+		return out, err
+	}
+
+	return out, nil
+}
+
+// Comment represents the comment block
+type Comment struct {
+	Hash []byte
+	Name Name
+}
+
+func convertComment(element asts.Element) (Comment, error) {
+	out := Comment{}
+	instruction, err := toInstruction(element, "comment")
+	if err != nil {
+		return out, err
+	}
+
+	tokens := toTokens(instruction, []string{"HASH", "name"})
+	out.Hash, err = fetchOne(instruction, tokens, "HASH", 0, toBytes)
+	if err != nil {
+		return out, err
+	}
+
+	out.Name, err = fetchOne(instruction, tokens, "name", 1, convertName)
+	if err != nil {
+		return out, err
+	}
+
+	return out, nil
+}
+
+// Name represents the name block, implemented by one struct per line
+type Name interface {
+	isName()
+}
+
+// NameLine0 represents the line 0 of the name block
+type NameLine0 struct {
+	LlA []byte
+}
+
+func (NameLine0) isName() {}
+
+func convertNameLine0(instruction asts.Instruction) (Name, error) {
+	out := NameLine0{}
+	var err error
+	tokens := toTokens(instruction, []string{"LL_A"})
+	out.LlA, err = fetchOne(instruction, tokens, "LL_A", 0, toBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// NameLine1 represents the line 1 of the name block
+type NameLine1 struct {
+	LlB []byte
+}
+
+func (NameLine1) isName() {}
+
+func convertNameLine1(instruction asts.Instruction) (Name, error) {
+	out := NameLine1{}
+	var err error
+	tokens := toTokens(instruction, []string{"LL_B"})
+	out.LlB, err = fetchOne(instruction, tokens, "LL_B", 0, toBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func convertName(element asts.Element) (Name, error) {
+	instruction, err := toInstruction(element, "name")
+	if err != nil {
+		return nil, err
+	}
+
+	switch instruction.Line() {
+	case 0:
+		return convertNameLine0(instruction)
+	case 1:
+		return convertNameLine1(instruction)
+	}
+
+	str := fmt.Sprintf("the line (%d) of the block (%s) does not exists", instruction.Line(), instruction.Block())
+	return nil, errors.New(str)
+}
+
+func toInstruction(element asts.Element, block string) (asts.Instruction, error) {
+	if element.IsAST() {
+		element = element.AST().Root()
+	}
+
+	if !element.IsInstruction() {
+		str := fmt.Sprintf("the element (%s) was expected to contain an instruction of the block (%s)", element.Name(), block)
+		return nil, errors.New(str)
+	}
+
+	instruction := element.Instruction()
+	if instruction.Block() != block {
+		str := fmt.Sprintf("the instruction was expected to be of the block (%s), (%s) provided", block, instruction.Block())
+		return nil, errors.New(str)
+	}
+
+	return instruction, nil
+}
+
+func toBytes(element asts.Element) ([]byte, error) {
+	if element.IsAST() {
+		return element.AST().Root().Value(), nil
+	}
+
+	return element.Value(), nil
+}
+
+func toAST(element asts.Element) (asts.AST, error) {
+	if !element.IsAST() {
+		str := fmt.Sprintf("the element (%s) was expected to contain an AST", element.Name())
+		return nil, errors.New(str)
+	}
+
+	return element.AST(), nil
+}
+
+func toTokens(instruction asts.Instruction, names []string) []asts.Token {
+	// tokens without elements are not part of the instruction, so match them in order by name:
+	out := make([]asts.Token, len(names))
+	position := 0
+	for _, oneToken := range instruction.Tokens().List() {
+		for position < len(names) && names[position] != oneToken.Name() {
+			position++
+		}
+
+		if position >= len(names) {
+			break
+		}
+
+		out[position] = oneToken
+		position++
+	}
+
+	return out
+}
+
+func fetchElements(tokens []asts.Token, position uint) []asts.Element {
+	if position >= uint(len(tokens)) || tokens[position] == nil {
+		return nil
+	}
+
+	return tokens[position].Elements().List()
+}
+
+func fetchOne[T any](instruction asts.Instruction, tokens []asts.Token, name string, position uint, convert func(element asts.Element) (T, error)) (T, error) {
+	elements := fetchElements(tokens, position)
+	if len(elements) != 1 {
+		var zero T
+		str := fmt.Sprintf("the token (%s) at position (%d) of the line (%d) of the block (%s) was expected to contain 1 element, %d found", name, position, instruction.Line(), instruction.Block(), len(elements))
+		return zero, errors.New(str)
+	}
+
+	return convert(elements[0])
+}
+
+func fetchOptional[T any](instruction asts.Instruction, tokens []asts.Token, name string, position uint, convert func(element asts.Element) (T, error)) (*T, error) {
+	if len(fetchElements(tokens, position)) <= 0 {
+		return nil, nil
+	}
+
+	value, err := fetchOne(instruction, tokens, name, position, convert)
+	if err != nil {
+		return nil, err
+	}
+
+	return &value, nil
+}
+
+func fetchOptionalValue[T any](instruction asts.Instruction, tokens []asts.Token, name string, position uint, convert func(element asts.Element) (T, error)) (T, error) {
+	if len(fetchElements(tokens, position)) <= 0 {
+		var zero T
+		return zero, nil
+	}
+
+	return fetchOne(instruction, tokens, name, position, convert)
+}
+
+func fetchMany[T any](instruction asts.Instruction, tokens []asts.Token, name string, position uint, convert func(element asts.Element) (T, error)) ([]T, error) {
+	out := []T{}
+	for _, oneElement := range fetchElements(tokens, position) {
+		value, err := convert(oneElement)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, value)
+	}
+
+	return out, nil
+}
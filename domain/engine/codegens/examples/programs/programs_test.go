@@ -0,0 +1,175 @@
+package programs
+
+import (
+	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+)
+
+func TestConvert_Success(t *testing.T) {
+	grammarAdapter := grammars.NewAdapter()
+	valueGrammar, _, err := grammarAdapter.ToGrammar([]byte(ValueGrammar))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	repository := grammars.NewRepositoryMemory(map[string]grammars.Grammar{})
+	err = repository.Insert([]string{"lang", "value"}, valueGrammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	grammar, _, err := grammarAdapter.ToGrammar([]byte(Grammar))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	ast, _, err := asts.NewAdapter(repository).ToAST(grammar, []byte("let a = 1; let b = 0; use a, b; # b"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, err := Convert(ast)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(program.Declaration) != 2 {
+		t.Errorf("the program was expected to contain %d declarations, %d returned", 2, len(program.Declaration))
+		return
+	}
+
+	if _, ok := program.Declaration[0].Name.(NameLine0); !ok {
+		t.Errorf("the name of the first declaration was expected to be the line 0 of the name block")
+		return
+	}
+
+	if _, ok := program.Declaration[1].Name.(NameLine1); !ok {
+		t.Errorf("the name of the second declaration was expected to be the line 1 of the name block")
+		return
+	}
+
+	if string(program.Declaration[0].Value.Root().Value()) != "1" {
+		t.Errorf("the value of the first declaration was expected to be (%s), (%s) returned", "1", program.Declaration[0].Value.Root().Value())
+		return
+	}
+
+	if len(program.Usage) != 1 {
+		t.Errorf("the program was expected to contain %d usage, %d returned", 1, len(program.Usage))
+		return
+	}
+
+	if string(program.Usage[0].Name2.(NameLine1).LlB) != "b" {
+		t.Errorf("the second name of the usage was expected to be (%s), (%s) returned", "b", program.Usage[0].Name2.(NameLine1).LlB)
+		return
+	}
+
+	if program.Comment == nil {
+		t.Errorf("the comment was expected to be valid, nil returned")
+		return
+	}
+
+	if string(program.Comment.Hash) != "#" {
+		t.Errorf("the hash of the comment was expected to be (%s), (%s) returned", "#", program.Comment.Hash)
+		return
+	}
+}
+
+func TestConvert_withoutOptional_Success(t *testing.T) {
+	grammarAdapter := grammars.NewAdapter()
+	valueGrammar, _, err := grammarAdapter.ToGrammar([]byte(ValueGrammar))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	repository := grammars.NewRepositoryMemory(map[string]grammars.Grammar{})
+	err = repository.Insert([]string{"lang", "value"}, valueGrammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	grammar, _, err := grammarAdapter.ToGrammar([]byte(Grammar))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	ast, _, err := asts.NewAdapter(repository).ToAST(grammar, []byte("let a = 0;"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, err := Convert(ast)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(program.Usage) != 0 {
+		t.Errorf("the program was expected to contain %d usage, %d returned", 0, len(program.Usage))
+		return
+	}
+
+	if program.Comment != nil {
+		t.Errorf("the comment was expected to be nil")
+		return
+	}
+}
+
+func TestConvert_withoutRepeatedOptional_Success(t *testing.T) {
+	grammarAdapter := grammars.NewAdapter()
+	valueGrammar, _, err := grammarAdapter.ToGrammar([]byte(ValueGrammar))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	repository := grammars.NewRepositoryMemory(map[string]grammars.Grammar{})
+	err = repository.Insert([]string{"lang", "value"}, valueGrammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	grammar, _, err := grammarAdapter.ToGrammar([]byte(Grammar))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	ast, _, err := asts.NewAdapter(repository).ToAST(grammar, []byte("let a = 0; use , b;"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	program, err := Convert(ast)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(program.Usage) != 1 {
+		t.Errorf("the program was expected to contain %d usage, %d returned", 1, len(program.Usage))
+		return
+	}
+
+	if program.Usage[0].Name != nil {
+		t.Errorf("the first name of the usage was expected to be nil")
+		return
+	}
+
+	if string(program.Usage[0].Name2.(NameLine1).LlB) != "b" {
+		t.Errorf("the second name of the usage was expected to be (%s), (%s) returned", "b", program.Usage[0].Name2.(NameLine1).LlB)
+		return
+	}
+}
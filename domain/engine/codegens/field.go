@@ -0,0 +1,30 @@
+package codegens
+
+type field struct {
+	name      string
+	token     string
+	position  uint
+	typeName  string
+	fetcher   string
+	converter string
+}
+
+func createField(
+	name string,
+	token string,
+	position uint,
+	typeName string,
+	fetcher string,
+	converter string,
+) *field {
+	out := field{
+		name:      name,
+		token:     token,
+		position:  position,
+		typeName:  typeName,
+		fetcher:   fetcher,
+		converter: converter,
+	}
+
+	return &out
+}
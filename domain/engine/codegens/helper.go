@@ -0,0 +1,74 @@
+package codegens
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+func toIdentifier(name string) string {
+	builder := strings.Builder{}
+	for _, onePart := range strings.Split(name, identifierSeparator) {
+		if onePart == "" {
+			continue
+		}
+
+		runes := []rune(onePart)
+		if strings.ToUpper(onePart) == onePart {
+			runes = []rune(strings.ToLower(onePart))
+		}
+
+		runes[0] = unicode.ToUpper(runes[0])
+		builder.WriteString(string(runes))
+	}
+
+	out := builder.String()
+	if out == "" || !unicode.IsLetter([]rune(out)[0]) {
+		return identifierPrefix + out
+	}
+
+	return out
+}
+
+func lineTypeName(typeName string, index uint) string {
+	return fmt.Sprintf("%s%s%d", typeName, lineTypeSuffix, index)
+}
+
+func structDeclaration(typeName string, fields []*field) string {
+	builder := strings.Builder{}
+	builder.WriteString(fmt.Sprintf("type %s struct {\n", typeName))
+	for _, oneField := range fields {
+		builder.WriteString(fmt.Sprintf("%s %s\n", oneField.name, oneField.typeName))
+	}
+
+	builder.WriteString("}\n")
+	return builder.String()
+}
+
+func fieldAssignments(fields []*field, zero string) string {
+	if len(fields) <= 0 {
+		return ""
+	}
+
+	names := []string{}
+	for _, oneField := range fields {
+		names = append(names, fmt.Sprintf("%q", oneField.token))
+	}
+
+	builder := strings.Builder{}
+	builder.WriteString(fmt.Sprintf("tokens := toTokens(instruction, []string{%s})\n", strings.Join(names, ", ")))
+	for _, oneField := range fields {
+		builder.WriteString(fmt.Sprintf(
+			"out.%s, err = %s(instruction, tokens, %q, %d, %s)\n",
+			oneField.name,
+			oneField.fetcher,
+			oneField.token,
+			oneField.position,
+			oneField.converter,
+		))
+
+		builder.WriteString(fmt.Sprintf("if err != nil {\nreturn %s, err\n}\n\n", zero))
+	}
+
+	return builder.String()
+}
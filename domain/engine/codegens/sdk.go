@@ -0,0 +1,23 @@
+package codegens
+
+import "github.com/steve-care-software/grammars/domain/engine/grammars"
+
+const converterPrefix = "convert"
+const lineTypeSuffix = "Line"
+const interfaceMethodPrefix = "is"
+const rootConverterName = "Convert"
+const identifierSeparator = "_"
+const identifierPrefix = "X"
+
+// NewAdapter creates a new adapter
+func NewAdapter() Adapter {
+	return createAdapter()
+}
+
+// Adapter generates Go code from grammars
+type Adapter interface {
+	// ToGo generates the source code of a Go package that contains one struct per block with one field per token, an
+	// interface implemented by one struct per line for the blocks that contain many lines, and a Convert function that
+	// converts the ASTs of the grammar to the type of its root block
+	ToGo(grammar grammars.Grammar, packageName string) ([]byte, error)
+}
@@ -0,0 +1,131 @@
+package codegens
+
+const headerTemplate = `// Code generated by the grammars code generator. DO NOT EDIT.
+
+package %s
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+)
+
+// %s converts an AST of the grammar to its root block
+func %s(ast asts.AST) (%s, error) {
+	return %s(ast.Root())
+}
+`
+
+const helpersTemplate = `
+func toInstruction(element asts.Element, block string) (asts.Instruction, error) {
+	if element.IsAST() {
+		element = element.AST().Root()
+	}
+
+	if !element.IsInstruction() {
+		str := fmt.Sprintf("the element (%s) was expected to contain an instruction of the block (%s)", element.Name(), block)
+		return nil, errors.New(str)
+	}
+
+	instruction := element.Instruction()
+	if instruction.Block() != block {
+		str := fmt.Sprintf("the instruction was expected to be of the block (%s), (%s) provided", block, instruction.Block())
+		return nil, errors.New(str)
+	}
+
+	return instruction, nil
+}
+
+func toBytes(element asts.Element) ([]byte, error) {
+	if element.IsAST() {
+		return element.AST().Root().Value(), nil
+	}
+
+	return element.Value(), nil
+}
+
+func toAST(element asts.Element) (asts.AST, error) {
+	if !element.IsAST() {
+		str := fmt.Sprintf("the element (%s) was expected to contain an AST", element.Name())
+		return nil, errors.New(str)
+	}
+
+	return element.AST(), nil
+}
+
+func toTokens(instruction asts.Instruction, names []string) []asts.Token {
+	// tokens without elements are not part of the instruction, so match them in order by name:
+	out := make([]asts.Token, len(names))
+	position := 0
+	for _, oneToken := range instruction.Tokens().List() {
+		for position < len(names) && names[position] != oneToken.Name() {
+			position++
+		}
+
+		if position >= len(names) {
+			break
+		}
+
+		out[position] = oneToken
+		position++
+	}
+
+	return out
+}
+
+func fetchElements(tokens []asts.Token, position uint) []asts.Element {
+	if position >= uint(len(tokens)) || tokens[position] == nil {
+		return nil
+	}
+
+	return tokens[position].Elements().List()
+}
+
+func fetchOne[T any](instruction asts.Instruction, tokens []asts.Token, name string, position uint, convert func(element asts.Element) (T, error)) (T, error) {
+	elements := fetchElements(tokens, position)
+	if len(elements) != 1 {
+		var zero T
+		str := fmt.Sprintf("the token (%s) at position (%d) of the line (%d) of the block (%s) was expected to contain 1 element, %d found", name, position, instruction.Line(), instruction.Block(), len(elements))
+		return zero, errors.New(str)
+	}
+
+	return convert(elements[0])
+}
+
+func fetchOptional[T any](instruction asts.Instruction, tokens []asts.Token, name string, position uint, convert func(element asts.Element) (T, error)) (*T, error) {
+	if len(fetchElements(tokens, position)) <= 0 {
+		return nil, nil
+	}
+
+	value, err := fetchOne(instruction, tokens, name, position, convert)
+	if err != nil {
+		return nil, err
+	}
+
+	return &value, nil
+}
+
+func fetchOptionalValue[T any](instruction asts.Instruction, tokens []asts.Token, name string, position uint, convert func(element asts.Element) (T, error)) (T, error) {
+	if len(fetchElements(tokens, position)) <= 0 {
+		var zero T
+		return zero, nil
+	}
+
+	return fetchOne(instruction, tokens, name, position, convert)
+}
+
+func fetchMany[T any](instruction asts.Instruction, tokens []asts.Token, name string, position uint, convert func(element asts.Element) (T, error)) ([]T, error) {
+	out := []T{}
+	for _, oneElement := range fetchElements(tokens, position) {
+		value, err := convert(oneElement)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, value)
+	}
+
+	return out, nil
+}
+`
@@ -0,0 +1,137 @@
+package coverages
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+type jsonReport struct {
+	Covered     uint        `json:"covered"`
+	Total       uint        `json:"total"`
+	Ratio       float64     `json:"ratio"`
+	Blocks      []jsonBlock `json:"blocks"`
+	Unexercised []string    `json:"unexercised"`
+}
+
+type jsonBlock struct {
+	Name  string     `json:"name"`
+	Hits  uint       `json:"hits"`
+	Lines []jsonLine `json:"lines"`
+}
+
+type jsonLine struct {
+	Index    uint          `json:"index"`
+	Hits     uint          `json:"hits"`
+	Tokens   []jsonToken   `json:"tokens"`
+	Balances []jsonBalance `json:"balances,omitempty"`
+}
+
+type jsonToken struct {
+	Index uint   `json:"index"`
+	Name  string `json:"name"`
+	Hits  uint   `json:"hits"`
+}
+
+type jsonBalance struct {
+	Index uint `json:"index"`
+	Hits  uint `json:"hits"`
+}
+
+type adapter struct {
+}
+
+func createAdapter() Adapter {
+	out := adapter{}
+	return &out
+}
+
+// ToText renders the report as human-readable text
+func (app *adapter) ToText(report Report) ([]byte, error) {
+	buffer := bytes.Buffer{}
+	buffer.WriteString(fmt.Sprintf("coverage: %d/%d (%.2f%%)\n", report.Covered(), report.Total(), report.Ratio()*100))
+	for _, oneBlock := range report.Blocks() {
+		buffer.WriteString(fmt.Sprintf("block %s: %d hits\n", oneBlock.Name(), oneBlock.Hits()))
+		for _, oneLine := range oneBlock.Lines() {
+			buffer.WriteString(fmt.Sprintf("\tline[%d]: %d hits\n", oneLine.Index(), oneLine.Hits()))
+			for _, oneToken := range oneLine.Tokens() {
+				buffer.WriteString(fmt.Sprintf("\t\ttoken[%d] (%s): %d hits\n", oneToken.Index(), oneToken.Name(), oneToken.Hits()))
+			}
+
+			if !oneLine.HasBalances() {
+				continue
+			}
+
+			for _, oneBalance := range oneLine.Balances() {
+				buffer.WriteString(fmt.Sprintf("\t\tbalance[%d]: %d hits\n", oneBalance.Index(), oneBalance.Hits()))
+			}
+		}
+	}
+
+	unexercised := report.Unexercised()
+	if len(unexercised) <= 0 {
+		return buffer.Bytes(), nil
+	}
+
+	buffer.WriteString(fmt.Sprintf("unexercised (%d):\n", len(unexercised)))
+	for _, onePath := range unexercised {
+		buffer.WriteString(fmt.Sprintf("\t%s\n", onePath))
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// ToJSON renders the report as JSON
+func (app *adapter) ToJSON(report Report) ([]byte, error) {
+	blocks := []jsonBlock{}
+	for _, oneBlock := range report.Blocks() {
+		lines := []jsonLine{}
+		for _, oneLine := range oneBlock.Lines() {
+			tokens := []jsonToken{}
+			for _, oneToken := range oneLine.Tokens() {
+				tokens = append(tokens, jsonToken{
+					Index: oneToken.Index(),
+					Name:  oneToken.Name(),
+					Hits:  oneToken.Hits(),
+				})
+			}
+
+			var balances []jsonBalance
+			if oneLine.HasBalances() {
+				balances = []jsonBalance{}
+				for _, oneBalance := range oneLine.Balances() {
+					balances = append(balances, jsonBalance{
+						Index: oneBalance.Index(),
+						Hits:  oneBalance.Hits(),
+					})
+				}
+			}
+
+			lines = append(lines, jsonLine{
+				Index:    oneLine.Index(),
+				Hits:     oneLine.Hits(),
+				Tokens:   tokens,
+				Balances: balances,
+			})
+		}
+
+		blocks = append(blocks, jsonBlock{
+			Name:  oneBlock.Name(),
+			Hits:  oneBlock.Hits(),
+			Lines: lines,
+		})
+	}
+
+	unexercised := report.Unexercised()
+	if unexercised == nil {
+		unexercised = []string{}
+	}
+
+	return json.MarshalIndent(jsonReport{
+		Covered:     report.Covered(),
+		Total:       report.Total(),
+		Ratio:       report.Ratio(),
+		Blocks:      blocks,
+		Unexercised: unexercised,
+	}, "", "\t")
+}
@@ -0,0 +1,72 @@
+package coverages
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestAdapter_Success(t *testing.T) {
+	grammar, err := grammar()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	collector := NewCollector()
+	collector.HitBlock(grammar, "program")
+	collector.HitLine(grammar, "program", 0)
+	collector.HitToken(grammar, "program", 0, 0)
+
+	retReport, err := collector.Report(grammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	adapter := NewAdapter()
+	retText, err := adapter.ToText(retReport)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	text := string(retText)
+	expectedLines := []string{
+		"coverage: 3/9 (33.33%)",
+		"block program: 1 hits",
+		"\t\ttoken[0] (name): 1 hits",
+		"unexercised (6):",
+		"\tprogram.line[0].token[1](SEMICOLON)",
+	}
+
+	for _, oneLine := range expectedLines {
+		if !strings.Contains(text, oneLine+"\n") {
+			t.Errorf("the text was expected to contain the line (%s), returned:\n%s", oneLine, text)
+			return
+		}
+	}
+
+	retJSON, err := adapter.ToJSON(retReport)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	decoded := jsonReport{}
+	err = json.Unmarshal(retJSON, &decoded)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if decoded.Covered != 3 || decoded.Total != 9 || len(decoded.Unexercised) != 6 {
+		t.Errorf("the JSON report was expected to contain (3, 9, 6), (%d, %d, %d) returned", decoded.Covered, decoded.Total, len(decoded.Unexercised))
+		return
+	}
+
+	if len(decoded.Blocks) != 2 || len(decoded.Blocks[0].Lines) <= 0 {
+		t.Errorf("the JSON report was expected to contain the blocks and their lines, returned: %s", retJSON)
+		return
+	}
+}
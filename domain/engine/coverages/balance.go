@@ -0,0 +1,28 @@
+package coverages
+
+type balance struct {
+	index uint
+	hits  uint
+}
+
+func createBalance(
+	index uint,
+	hits uint,
+) Balance {
+	out := balance{
+		index: index,
+		hits:  hits,
+	}
+
+	return &out
+}
+
+// Index returns the index
+func (obj *balance) Index() uint {
+	return obj.index
+}
+
+// Hits returns the hits
+func (obj *balance) Hits() uint {
+	return obj.hits
+}
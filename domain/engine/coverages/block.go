@@ -0,0 +1,36 @@
+package coverages
+
+type block struct {
+	name  string
+	hits  uint
+	lines []Line
+}
+
+func createBlock(
+	name string,
+	hits uint,
+	lines []Line,
+) Block {
+	out := block{
+		name:  name,
+		hits:  hits,
+		lines: lines,
+	}
+
+	return &out
+}
+
+// Name returns the name
+func (obj *block) Name() string {
+	return obj.name
+}
+
+// Hits returns the hits
+func (obj *block) Hits() uint {
+	return obj.hits
+}
+
+// Lines returns the lines
+func (obj *block) Lines() []Line {
+	return obj.lines
+}
@@ -0,0 +1,162 @@
+package coverages
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+)
+
+type hits struct {
+	blocks   map[string]uint
+	lines    map[string]uint
+	tokens   map[string]uint
+	balances map[string]uint
+}
+
+type collector struct {
+	mutex    sync.RWMutex
+	grammars map[grammars.Grammar]*hits
+}
+
+func createCollector() Collector {
+	out := collector{
+		grammars: map[grammars.Grammar]*hits{},
+	}
+
+	return &out
+}
+
+// HitBlock records a hit on a block
+func (app *collector) HitBlock(grammar grammars.Grammar, block string) {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	app.fetch(grammar).blocks[block]++
+}
+
+// HitLine records a hit on a block line
+func (app *collector) HitLine(grammar grammars.Grammar, block string, line uint) {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	app.fetch(grammar).lines[lineKeyname(block, line)]++
+}
+
+// HitToken records a hit on a line token
+func (app *collector) HitToken(grammar grammars.Grammar, block string, line uint, token uint) {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	app.fetch(grammar).tokens[tokenKeyname(block, line, token)]++
+}
+
+// HitBalance records a hit on a balance line
+func (app *collector) HitBalance(grammar grammars.Grammar, block string, line uint, balanceLine uint) {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	app.fetch(grammar).balances[balanceKeyname(block, line, balanceLine)]++
+}
+
+// Report creates the coverage report of the provided grammar
+func (app *collector) Report(grammar grammars.Grammar) (Report, error) {
+	app.mutex.RLock()
+	defer app.mutex.RUnlock()
+
+	// the report is read-only, a grammar that was never seen has no hits:
+	grammarHits, ok := app.grammars[grammar]
+	if !ok {
+		grammarHits = createHits()
+	}
+
+	covered := uint(0)
+	total := uint(0)
+	unexercised := []string{}
+	count := func(amount uint, path string) {
+		total++
+		if amount > 0 {
+			covered++
+			return
+		}
+
+		unexercised = append(unexercised, path)
+	}
+
+	blocksList := []Block{}
+	for _, oneBlock := range grammar.Blocks().List() {
+		blockName := oneBlock.Name()
+		blockHits := grammarHits.blocks[blockName]
+		count(blockHits, blockName)
+
+		linesList := []Line{}
+		for lineIdx, oneLine := range oneBlock.Lines().List() {
+			line := uint(lineIdx)
+			linePath := fmt.Sprintf("%s.line[%d]", blockName, line)
+			lineHits := grammarHits.lines[lineKeyname(blockName, line)]
+			count(lineHits, linePath)
+
+			tokensList := []Token{}
+			for tokenIdx, oneToken := range oneLine.Tokens().List() {
+				token := uint(tokenIdx)
+				tokenName := oneToken.Name()
+				tokenHits := grammarHits.tokens[tokenKeyname(blockName, line, token)]
+				count(tokenHits, fmt.Sprintf("%s.token[%d](%s)", linePath, token, tokenName))
+				tokensList = append(tokensList, createToken(token, tokenName, tokenHits))
+			}
+
+			if !oneLine.HasBalance() {
+				linesList = append(linesList, createLine(line, lineHits, tokensList))
+				continue
+			}
+
+			balancesList := []Balance{}
+			for balanceIdx := range oneLine.Balance().Lines() {
+				balanceLine := uint(balanceIdx)
+				balanceHits := grammarHits.balances[balanceKeyname(blockName, line, balanceLine)]
+				count(balanceHits, fmt.Sprintf("%s.balance[%d]", linePath, balanceLine))
+				balancesList = append(balancesList, createBalance(balanceLine, balanceHits))
+			}
+
+			linesList = append(linesList, createLineWithBalances(line, lineHits, tokensList, balancesList))
+		}
+
+		blocksList = append(blocksList, createBlock(blockName, blockHits, linesList))
+	}
+
+	return createReport(blocksList, covered, total, unexercised), nil
+}
+
+// Reset removes every hit recorded by the collector
+func (app *collector) Reset() {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	app.grammars = map[grammars.Grammar]*hits{}
+}
+
+func (app *collector) fetch(grammar grammars.Grammar) *hits {
+	if ins, ok := app.grammars[grammar]; ok {
+		return ins
+	}
+
+	ins := createHits()
+	app.grammars[grammar] = ins
+	return ins
+}
+
+func createHits() *hits {
+	return &hits{
+		blocks:   map[string]uint{},
+		lines:    map[string]uint{},
+		tokens:   map[string]uint{},
+		balances: map[string]uint{},
+	}
+}
+
+func lineKeyname(block string, line uint) string {
+	return fmt.Sprintf("%s|%d", block, line)
+}
+
+func tokenKeyname(block string, line uint, token uint) string {
+	return fmt.Sprintf("%s|%d|%d", block, line, token)
+}
+
+func balanceKeyname(block string, line uint, balanceLine uint) string {
+	return fmt.Sprintf("%s|%d|%d", block, line, balanceLine)
+}
@@ -0,0 +1,134 @@
+package coverages
+
+import (
+	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+)
+
+func TestCollector_Success(t *testing.T) {
+	grammar, err := grammar()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	collector := NewCollector()
+	collector.HitBlock(grammar, "name")
+	collector.HitBlock(grammar, "name")
+	collector.HitLine(grammar, "name", 0)
+	collector.HitToken(grammar, "name", 0, 0)
+
+	retReport, err := collector.Report(grammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// blocks: 2, lines: 3, tokens: 4
+	if retReport.Total() != 9 {
+		t.Errorf("the total was expected to be %d, %d returned", 9, retReport.Total())
+		return
+	}
+
+	if retReport.Covered() != 3 {
+		t.Errorf("the covered amount was expected to be %d, %d returned", 3, retReport.Covered())
+		return
+	}
+
+	for _, oneBlock := range retReport.Blocks() {
+		if oneBlock.Name() != "name" {
+			continue
+		}
+
+		if oneBlock.Hits() != 2 {
+			t.Errorf("the block (name) was expected to contain %d hits, %d returned", 2, oneBlock.Hits())
+			return
+		}
+	}
+
+	expected := map[string]bool{
+		"program":                             true,
+		"program.line[0]":                     true,
+		"program.line[0].token[0](name)":      true,
+		"program.line[0].token[1](SEMICOLON)": true,
+		"name.line[1]":                        true,
+		"name.line[1].token[0](LL_B)":         true,
+	}
+
+	unexercised := retReport.Unexercised()
+	if len(unexercised) != len(expected) {
+		t.Errorf("the report was expected to contain %d unexercised paths, %d returned: %v", len(expected), len(unexercised), unexercised)
+		return
+	}
+
+	for _, onePath := range unexercised {
+		if !expected[onePath] {
+			t.Errorf("the path (%s) was not expected to be unexercised", onePath)
+			return
+		}
+	}
+
+	collector.Reset()
+	retReport, err = collector.Report(grammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if retReport.Covered() != 0 {
+		t.Errorf("the covered amount was expected to be %d after a reset, %d returned", 0, retReport.Covered())
+		return
+	}
+}
+
+func TestCollector_report_isReadOnly_Success(t *testing.T) {
+	grammar, err := grammar()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	collector := createCollector().(*collector)
+	retReport, err := collector.Report(grammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if retReport.Covered() != 0 {
+		t.Errorf("the covered amount was expected to be %d, %d returned", 0, retReport.Covered())
+		return
+	}
+
+	if len(collector.grammars) != 0 {
+		t.Errorf("the report was not expected to record the grammar in the collector")
+		return
+	}
+}
+
+func grammar() (grammars.Grammar, error) {
+	grammar, _, err := grammars.NewAdapter().ToGrammar([]byte(`
+		v1;
+		> .program;
+		# .SPACE;
+
+		program: .name .SEMICOLON
+				;
+
+		name: .LL_A
+			| .LL_B
+			;
+
+		SEMICOLON: ";";
+		LL_A: "a";
+		LL_B: "b";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return grammar, nil
+}
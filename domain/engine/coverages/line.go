@@ -0,0 +1,66 @@
+package coverages
+
+type line struct {
+	index    uint
+	hits     uint
+	tokens   []Token
+	balances []Balance
+}
+
+func createLine(
+	index uint,
+	hits uint,
+	tokens []Token,
+) Line {
+	return createLineInternally(index, hits, tokens, nil)
+}
+
+func createLineWithBalances(
+	index uint,
+	hits uint,
+	tokens []Token,
+	balances []Balance,
+) Line {
+	return createLineInternally(index, hits, tokens, balances)
+}
+
+func createLineInternally(
+	index uint,
+	hits uint,
+	tokens []Token,
+	balances []Balance,
+) Line {
+	out := line{
+		index:    index,
+		hits:     hits,
+		tokens:   tokens,
+		balances: balances,
+	}
+
+	return &out
+}
+
+// Index returns the index
+func (obj *line) Index() uint {
+	return obj.index
+}
+
+// Hits returns the hits
+func (obj *line) Hits() uint {
+	return obj.hits
+}
+
+// Tokens returns the tokens
+func (obj *line) Tokens() []Token {
+	return obj.tokens
+}
+
+// HasBalances returns true if there is balances, false otherwise
+func (obj *line) HasBalances() bool {
+	return obj.balances != nil
+}
+
+// Balances returns the balances, if any
+func (obj *line) Balances() []Balance {
+	return obj.balances
+}
@@ -0,0 +1,53 @@
+package coverages
+
+type report struct {
+	blocks      []Block
+	covered     uint
+	total       uint
+	unexercised []string
+}
+
+func createReport(
+	blocks []Block,
+	covered uint,
+	total uint,
+	unexercised []string,
+) Report {
+	out := report{
+		blocks:      blocks,
+		covered:     covered,
+		total:       total,
+		unexercised: unexercised,
+	}
+
+	return &out
+}
+
+// Blocks returns the blocks
+func (obj *report) Blocks() []Block {
+	return obj.blocks
+}
+
+// Covered returns the amount of grammar parts exercised at least once
+func (obj *report) Covered() uint {
+	return obj.covered
+}
+
+// Total returns the amount of grammar parts
+func (obj *report) Total() uint {
+	return obj.total
+}
+
+// Ratio returns the ratio of exercised grammar parts, between 0 and 1
+func (obj *report) Ratio() float64 {
+	if obj.total <= 0 {
+		return 0
+	}
+
+	return float64(obj.covered) / float64(obj.total)
+}
+
+// Unexercised returns the paths of the grammar parts that were never exercised
+func (obj *report) Unexercised() []string {
+	return obj.unexercised
+}
@@ -0,0 +1,72 @@
+package coverages
+
+import (
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+)
+
+// NewCollector creates a new collector
+func NewCollector() Collector {
+	return createCollector()
+}
+
+// NewAdapter creates a new adapter
+func NewAdapter() Adapter {
+	return createAdapter()
+}
+
+// Adapter represents the coverage report adapter
+type Adapter interface {
+	// ToText renders the report as human-readable text
+	ToText(report Report) ([]byte, error)
+
+	// ToJSON renders the report as JSON
+	ToJSON(report Report) ([]byte, error)
+}
+
+// Collector collects the grammar parts exercised while parsing
+type Collector interface {
+	HitBlock(grammar grammars.Grammar, block string)
+	HitLine(grammar grammars.Grammar, block string, line uint)
+	HitToken(grammar grammars.Grammar, block string, line uint, token uint)
+	HitBalance(grammar grammars.Grammar, block string, line uint, balanceLine uint)
+	Report(grammar grammars.Grammar) (Report, error)
+	Reset()
+}
+
+// Report represents a coverage report
+type Report interface {
+	Blocks() []Block
+	Covered() uint
+	Total() uint
+	Ratio() float64
+	Unexercised() []string
+}
+
+// Block represents the coverage of a block
+type Block interface {
+	Name() string
+	Hits() uint
+	Lines() []Line
+}
+
+// Line represents the coverage of a block line
+type Line interface {
+	Index() uint
+	Hits() uint
+	Tokens() []Token
+	HasBalances() bool
+	Balances() []Balance
+}
+
+// Token represents the coverage of a line token
+type Token interface {
+	Index() uint
+	Name() string
+	Hits() uint
+}
+
+// Balance represents the coverage of a balance line
+type Balance interface {
+	Index() uint
+	Hits() uint
+}
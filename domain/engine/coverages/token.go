@@ -0,0 +1,36 @@
+package coverages
+
+type token struct {
+	index uint
+	name  string
+	hits  uint
+}
+
+func createToken(
+	index uint,
+	name string,
+	hits uint,
+) Token {
+	out := token{
+		index: index,
+		name:  name,
+		hits:  hits,
+	}
+
+	return &out
+}
+
+// Index returns the index
+func (obj *token) Index() uint {
+	return obj.index
+}
+
+// Name returns the name
+func (obj *token) Name() string {
+	return obj.name
+}
+
+// Hits returns the hits
+func (obj *token) Hits() uint {
+	return obj.hits
+}
@@ -0,0 +1,106 @@
+package harnesses
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/queries"
+)
+
+// FuzzGrammarAdapter fuzzes the grammar adapter, starting from the provided seeds:
+//   - parsing an input never panics
+//   - the remaining data of a parsed grammar is a suffix of the input
+//   - a parsed grammar can be converted back to bytes and parsed again
+func FuzzGrammarAdapter(f *testing.F, seeds ...[]byte) {
+	adapter := grammars.NewAdapter()
+	for _, oneSeed := range seeds {
+		f.Add(oneSeed)
+	}
+
+	f.Fuzz(func(t *testing.T, input []byte) {
+		grammar, remaining, err := adapter.ToGrammar(input)
+		if err != nil {
+			return
+		}
+
+		verifyRemaining(t, input, remaining)
+		output, err := adapter.ToBytes(grammar)
+		if err != nil {
+			t.Errorf("the grammar parsed from the input (%q) could not be converted back to bytes: %s", input, err.Error())
+			return
+		}
+
+		_, _, err = adapter.ToGrammar(output)
+		if err != nil {
+			t.Errorf("the grammar parsed from the input (%q) could not be parsed again from its bytes (%q): %s", input, output, err.Error())
+			return
+		}
+	})
+}
+
+// FuzzQueryAdapter fuzzes the query adapter, starting from the provided seeds:
+//   - parsing an input never panics
+//   - the remaining data of a parsed query is a suffix of the input
+func FuzzQueryAdapter(f *testing.F, seeds ...[]byte) {
+	adapter, err := queries.NewAdapterFactory(
+		grammars.NewRepositoryMemory(map[string]grammars.Grammar{}),
+	).Create()
+
+	if err != nil {
+		f.Fatalf("the query adapter could not be created: %s", err.Error())
+		return
+	}
+
+	for _, oneSeed := range seeds {
+		f.Add(oneSeed)
+	}
+
+	f.Fuzz(func(t *testing.T, input []byte) {
+		_, remaining, err := adapter.ToQuery(input)
+		if err != nil {
+			return
+		}
+
+		verifyRemaining(t, input, remaining)
+	})
+}
+
+// FuzzASTAdapter fuzzes the AST adapter against the grammar, starting from the inputs of its suites and the provided seeds:
+//   - parsing an input never panics
+//   - the remaining data of a parsed AST is a suffix of the input
+func FuzzASTAdapter(f *testing.F, grammarRepository grammars.Repository, grammar grammars.Grammar, seeds ...[]byte) {
+	adapter := asts.NewAdapter(
+		grammarRepository,
+	)
+
+	for _, oneBlock := range grammar.Blocks().List() {
+		if !oneBlock.HasSuites() {
+			continue
+		}
+
+		for _, oneSuite := range oneBlock.Suites().List() {
+			f.Add(oneSuite.Input())
+		}
+	}
+
+	for _, oneSeed := range seeds {
+		f.Add(oneSeed)
+	}
+
+	f.Fuzz(func(t *testing.T, input []byte) {
+		_, remaining, err := adapter.ToAST(grammar, input)
+		if err != nil {
+			return
+		}
+
+		verifyRemaining(t, input, remaining)
+	})
+}
+
+func verifyRemaining(t *testing.T, input []byte, remaining []byte) {
+	if !bytes.HasSuffix(input, remaining) {
+		t.Errorf("the remaining (%q) was expected to be a suffix of the input (%q)", remaining, input)
+	}
+}
@@ -0,0 +1,59 @@
+package harnesses
+
+import (
+	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+)
+
+var grammarSeed = []byte(`
+	v1;
+	> .assignment;
+	# .SPACE;
+
+	assignment: .name .EQUAL .value .SEMICOLON
+				---
+				valid: "a=1;";
+				invalid: !"a=;";
+				;
+
+	name: .LL_A
+		| .LL_B
+		;
+
+	value: .N_ONE[1,3]
+		;
+
+	N_ONE: "1";
+	LL_A: "a";
+	LL_B: "b";
+	EQUAL: "=";
+	SEMICOLON: ";";
+	SPACE: " ";
+`)
+
+func FuzzGrammars(f *testing.F) {
+	FuzzGrammarAdapter(f, grammarSeed, []byte("v1;"))
+}
+
+func FuzzQueries(f *testing.F) {
+	FuzzQueryAdapter(f, []byte(`
+		v1;
+		name: mySelector;
+		myChain[0][0]->RULE;`))
+}
+
+func FuzzASTs(f *testing.F) {
+	grammar, _, err := grammars.NewAdapter().ToGrammar(grammarSeed)
+	if err != nil {
+		f.Fatalf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	FuzzASTAdapter(
+		f,
+		grammars.NewRepositoryMemory(map[string]grammars.Grammar{}),
+		grammar,
+		[]byte("b = 111;"),
+	)
+}
@@ -0,0 +1,82 @@
+package fuzzers
+
+import (
+	"errors"
+	"fmt"
+)
+
+type minimizer struct {
+}
+
+func createMinimizer() Minimizer {
+	out := minimizer{}
+	return &out
+}
+
+// Minimize shrinks the input to the smallest input that still reproduces the failure
+func (app *minimizer) Minimize(input []byte, fails FailFn) ([]byte, error) {
+	if fails == nil {
+		return nil, errors.New("the fail func is mandatory in order to minimize an input")
+	}
+
+	if !fails(input) {
+		str := fmt.Sprintf("the input (%s) was expected to reproduce the failure in order to be minimized", input)
+		return nil, errors.New(str)
+	}
+
+	if fails([]byte{}) {
+		return []byte{}, nil
+	}
+
+	current := append([]byte{}, input...)
+	granularity := 2
+	for len(current) >= 2 {
+		reduced, isReduced := app.reduce(current, granularity, fails)
+		if isReduced {
+			current = reduced
+			granularity = max(granularity-1, 2)
+			continue
+		}
+
+		if granularity >= len(current) {
+			break
+		}
+
+		granularity = min(granularity*2, len(current))
+	}
+
+	return current, nil
+}
+
+func (app *minimizer) reduce(input []byte, granularity int, fails FailFn) ([]byte, bool) {
+	chunks := app.chunks(input, granularity)
+	for _, oneChunk := range chunks {
+		subset := append([]byte{}, input[oneChunk[0]:oneChunk[1]]...)
+		if fails(subset) {
+			return subset, true
+		}
+	}
+
+	for _, oneChunk := range chunks {
+		complement := append(append([]byte{}, input[:oneChunk[0]]...), input[oneChunk[1]:]...)
+		if fails(complement) {
+			return complement, true
+		}
+	}
+
+	return nil, false
+}
+
+func (app *minimizer) chunks(input []byte, granularity int) [][2]int {
+	length := len(input)
+	size := (length + granularity - 1) / granularity
+	output := [][2]int{}
+	for begin := 0; begin < length; begin += size {
+		output = append(output, [2]int{
+			begin,
+			min(begin+size, length),
+		})
+	}
+
+	return output
+}
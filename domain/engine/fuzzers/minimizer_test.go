@@ -0,0 +1,35 @@
+package fuzzers
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestMinimizer_Success(t *testing.T) {
+	input := []byte("this is an input with an x and a y inside")
+	retMinimized, err := NewMinimizer().Minimize(input, func(input []byte) bool {
+		return bytes.Contains(input, []byte("x")) && bytes.Contains(input, []byte("y"))
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := []byte("xy")
+	if !bytes.Equal(expected, retMinimized) {
+		t.Errorf("the minimized input was expected to be '%s', '%s' returned", expected, retMinimized)
+		return
+	}
+}
+
+func TestMinimizer_inputDoesNotFail_returnsError(t *testing.T) {
+	_, err := NewMinimizer().Minimize([]byte("this is an input"), func(input []byte) bool {
+		return false
+	})
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
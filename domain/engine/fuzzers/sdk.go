@@ -0,0 +1,43 @@
+package fuzzers
+
+import (
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/suites"
+)
+
+// NewMinimizer creates a new minimizer
+func NewMinimizer() Minimizer {
+	return createMinimizer()
+}
+
+// NewSuiteWriter creates a new suite writer
+func NewSuiteWriter() SuiteWriter {
+	grammarAdapter := grammars.NewAdapter()
+	grammarBuilder := grammars.NewBuilder()
+	blocksBuilder := blocks.NewBuilder()
+	blockBuilder := blocks.NewBlockBuilder()
+	suitesBuilder := suites.NewBuilder()
+	return createSuiteWriter(
+		grammarAdapter,
+		grammarBuilder,
+		blocksBuilder,
+		blockBuilder,
+		suitesBuilder,
+	)
+}
+
+// FailFn returns true when the input reproduces the failure
+type FailFn func(input []byte) bool
+
+// Minimizer represents a delta-debugging minimizer
+type Minimizer interface {
+	// Minimize shrinks the input to the smallest input that still reproduces the failure
+	Minimize(input []byte, fails FailFn) ([]byte, error)
+}
+
+// SuiteWriter writes inputs back to grammars as suites
+type SuiteWriter interface {
+	// Write adds the suite to the block of the grammar and returns the text representation of the updated grammar
+	Write(grammar grammars.Grammar, blockName string, suite suites.Suite) ([]byte, error)
+}
@@ -0,0 +1,121 @@
+package fuzzers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/suites"
+)
+
+type suiteWriter struct {
+	grammarAdapter grammars.Adapter
+	grammarBuilder grammars.Builder
+	blocksBuilder  blocks.Builder
+	blockBuilder   blocks.BlockBuilder
+	suitesBuilder  suites.Builder
+}
+
+func createSuiteWriter(
+	grammarAdapter grammars.Adapter,
+	grammarBuilder grammars.Builder,
+	blocksBuilder blocks.Builder,
+	blockBuilder blocks.BlockBuilder,
+	suitesBuilder suites.Builder,
+) SuiteWriter {
+	out := suiteWriter{
+		grammarAdapter: grammarAdapter,
+		grammarBuilder: grammarBuilder,
+		blocksBuilder:  blocksBuilder,
+		blockBuilder:   blockBuilder,
+		suitesBuilder:  suitesBuilder,
+	}
+
+	return &out
+}
+
+// Write adds the suite to the block of the grammar and returns the text representation of the updated grammar
+func (app *suiteWriter) Write(grammar grammars.Grammar, blockName string, suite suites.Suite) ([]byte, error) {
+	isFound := false
+	list := grammar.Blocks().List()
+	updated := []blocks.Block{}
+
+	// the blocks builder reverses its list, so the blocks are provided in reverse order to keep their order:
+	for idx := len(list) - 1; idx >= 0; idx-- {
+		block := list[idx]
+		if block.Name() == blockName {
+			retBlock, err := app.block(block, suite)
+			if err != nil {
+				return nil, err
+			}
+
+			block = retBlock
+			isFound = true
+		}
+
+		updated = append(updated, block)
+	}
+
+	if !isFound {
+		str := fmt.Sprintf("the block (name: %s) does not exists in the grammar", blockName)
+		return nil, errors.New(str)
+	}
+
+	blocksIns, err := app.blocksBuilder.Create().
+		WithList(updated).
+		Now()
+
+	if err != nil {
+		return nil, err
+	}
+
+	builder := app.grammarBuilder.Create().
+		WithVersion(grammar.Version()).
+		WithRoot(grammar.Root()).
+		WithRules(grammar.Rules()).
+		WithBlocks(blocksIns)
+
+	if grammar.HasOmissions() {
+		builder.WithOmissions(grammar.Omissions())
+	}
+
+	if grammar.HasConstants() {
+		builder.WithConstants(grammar.Constants())
+	}
+
+	updatedGrammar, err := builder.Now()
+	if err != nil {
+		return nil, err
+	}
+
+	return app.grammarAdapter.ToBytes(updatedGrammar)
+}
+
+func (app *suiteWriter) block(block blocks.Block, suite suites.Suite) (blocks.Block, error) {
+	list := []suites.Suite{}
+	if block.HasSuites() {
+		for _, oneSuite := range block.Suites().List() {
+			if oneSuite.Name() == suite.Name() {
+				str := fmt.Sprintf("the suite (name: %s) already exists in the block (name: %s)", suite.Name(), block.Name())
+				return nil, errors.New(str)
+			}
+
+			list = append(list, oneSuite)
+		}
+	}
+
+	suitesIns, err := app.suitesBuilder.Create().
+		WithList(append(list, suite)).
+		Now()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return app.blockBuilder.Create().
+		WithName(block.Name()).
+		WithLines(block.Lines()).
+		WithSuites(suitesIns).
+		Now()
+}
@@ -0,0 +1,160 @@
+package fuzzers
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/suites"
+)
+
+var grammarSeed = []byte(`
+	v1;
+	> .assignment;
+	# .SPACE;
+
+	assignment: .name .EQUAL .value .SEMICOLON
+				---
+				valid: "a=1;";
+				invalid: !"a=;";
+				;
+
+	name: .LL_A
+		| .LL_B
+		;
+
+	value: .N_ONE[1,3]
+		;
+
+	N_ONE: "1";
+	LL_A: "a";
+	LL_B: "b";
+	EQUAL: "=";
+	SEMICOLON: ";";
+	SPACE: " ";
+`)
+
+func TestSuiteWriter_withMinimizedInput_Success(t *testing.T) {
+	grammarAdapter := grammars.NewAdapter()
+	grammar, _, err := grammarAdapter.ToGrammar(grammarSeed)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	astAdapter := asts.NewAdapter(
+		grammars.NewRepositoryMemory(map[string]grammars.Grammar{}),
+	)
+
+	minimized, err := NewMinimizer().Minimize([]byte("a = 1111;"), func(input []byte) bool {
+		_, _, err := astAdapter.ToAST(grammar, input)
+		return err != nil && bytes.Count(input, []byte("1")) > 3
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	suite, err := suites.NewSuiteBuilder().Create().
+		WithName("minimized").
+		WithInput(minimized).
+		IsFail().
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	output, err := NewSuiteWriter().Write(grammar, "assignment", suite)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retGrammar, _, err := grammarAdapter.ToGrammar(output)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	list := retGrammar.Blocks().List()
+	if len(list) != 3 {
+		t.Errorf("the grammar was expected to contain %d blocks, %d returned", 3, len(list))
+		return
+	}
+
+	assignment := list[len(list)-1]
+	if assignment.Name() != "assignment" {
+		t.Errorf("the first block was expected to be 'assignment', '%s' returned", assignment.Name())
+		return
+	}
+
+	suitesList := assignment.Suites().List()
+	if len(suitesList) != 3 {
+		t.Errorf("the block was expected to contain %d suites, %d returned", 3, len(suitesList))
+		return
+	}
+
+	expected := []byte("1111")
+	if !bytes.Equal(expected, minimized) {
+		t.Errorf("the minimized input was expected to be '%s', '%s' returned", expected, minimized)
+		return
+	}
+
+	last := suitesList[2]
+	if last.Name() != "minimized" || !last.IsFail() || !bytes.Equal(last.Input(), minimized) {
+		t.Errorf("the last suite was expected to be the minimized failing input (%s)", minimized)
+		return
+	}
+}
+
+func TestSuiteWriter_withDuplicatedName_returnsError(t *testing.T) {
+	grammar, _, err := grammars.NewAdapter().ToGrammar(grammarSeed)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	suite, err := suites.NewSuiteBuilder().Create().
+		WithName("valid").
+		WithInput([]byte("b=1;")).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = NewSuiteWriter().Write(grammar, "assignment", suite)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestSuiteWriter_withUnknownBlock_returnsError(t *testing.T) {
+	grammar, _, err := grammars.NewAdapter().ToGrammar(grammarSeed)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	suite, err := suites.NewSuiteBuilder().Create().
+		WithName("other").
+		WithInput([]byte("b=1;")).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = NewSuiteWriter().Write(grammar, "unknown", suite)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
@@ -0,0 +1,94 @@
+package generators
+
+import (
+	"errors"
+	"math/rand"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+)
+
+type builder struct {
+	astAdapter        asts.Adapter
+	grammarRepository grammars.Repository
+	pSeed             *int64
+	maxDepth          uint
+	maxSize           uint
+	attempts          uint
+}
+
+func createBuilder(
+	astAdapter asts.Adapter,
+	grammarRepository grammars.Repository,
+) Builder {
+	out := builder{
+		astAdapter:        astAdapter,
+		grammarRepository: grammarRepository,
+		pSeed:             nil,
+		maxDepth:          defaultMaxDepth,
+		maxSize:           defaultMaxSize,
+		attempts:          defaultAttempts,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *builder) Create() Builder {
+	return createBuilder(
+		app.astAdapter,
+		app.grammarRepository,
+	)
+}
+
+// WithSeed adds a seed to the builder
+func (app *builder) WithSeed(seed int64) Builder {
+	app.pSeed = &seed
+	return app
+}
+
+// WithMaxDepth adds a maximum block depth to the builder
+func (app *builder) WithMaxDepth(maxDepth uint) Builder {
+	app.maxDepth = maxDepth
+	return app
+}
+
+// WithMaxSize adds a maximum input size, in bytes, to the builder
+func (app *builder) WithMaxSize(maxSize uint) Builder {
+	app.maxSize = maxSize
+	return app
+}
+
+// WithAttempts adds the amount of attempts made before giving up to the builder
+func (app *builder) WithAttempts(attempts uint) Builder {
+	app.attempts = attempts
+	return app
+}
+
+// Now builds a new Generator instance
+func (app *builder) Now() (Generator, error) {
+	if app.pSeed == nil {
+		return nil, errors.New("the seed is mandatory in order to build a Generator instance")
+	}
+
+	if app.maxDepth <= 0 {
+		return nil, errors.New("the maxDepth must be greater than zero in order to build a Generator instance")
+	}
+
+	if app.maxSize <= 0 {
+		return nil, errors.New("the maxSize must be greater than zero in order to build a Generator instance")
+	}
+
+	if app.attempts <= 0 {
+		return nil, errors.New("the attempts must be greater than zero in order to build a Generator instance")
+	}
+
+	return createGenerator(
+		app.astAdapter,
+		app.grammarRepository,
+		rand.New(rand.NewSource(*app.pSeed)),
+		app.maxDepth,
+		app.maxSize,
+		app.attempts,
+	), nil
+}
@@ -0,0 +1,194 @@
+package generators
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+)
+
+type generator struct {
+	astAdapter        asts.Adapter
+	grammarRepository grammars.Repository
+	random            *rand.Rand
+	maxDepth          uint
+	maxSize           uint
+	attempts          uint
+}
+
+func createGenerator(
+	astAdapter asts.Adapter,
+	grammarRepository grammars.Repository,
+	random *rand.Rand,
+	maxDepth uint,
+	maxSize uint,
+	attempts uint,
+) Generator {
+	out := generator{
+		astAdapter:        astAdapter,
+		grammarRepository: grammarRepository,
+		random:            random,
+		maxDepth:          maxDepth,
+		maxSize:           maxSize,
+		attempts:          attempts,
+	}
+
+	return &out
+}
+
+// Generate generates a random input accepted by the grammar
+func (app *generator) Generate(grammar grammars.Grammar) ([]byte, error) {
+	root := grammar.Root()
+	return app.generate(grammar, "", func(session *session) ([]byte, error) {
+		return session.generateElement(root, 0)
+	})
+}
+
+// GenerateWithRoot generates a random input accepted by the provided root block of the grammar
+func (app *generator) GenerateWithRoot(grammar grammars.Grammar, rootBlockName string) ([]byte, error) {
+	_, err := grammar.Blocks().Fetch(rootBlockName)
+	if err != nil {
+		return nil, err
+	}
+
+	return app.generate(grammar, rootBlockName, func(session *session) ([]byte, error) {
+		return session.generateBlock(rootBlockName, 0)
+	})
+}
+
+// Mutate creates near-miss mutations of the input that the grammar rejects
+func (app *generator) Mutate(grammar grammars.Grammar, input []byte, amount uint) ([][]byte, error) {
+	return app.mutate(grammar, "", input, amount)
+}
+
+// MutateWithRoot creates near-miss mutations of the input that the provided root block of the grammar rejects
+func (app *generator) MutateWithRoot(grammar grammars.Grammar, rootBlockName string, input []byte, amount uint) ([][]byte, error) {
+	return app.mutate(grammar, rootBlockName, input, amount)
+}
+
+func (app *generator) generate(
+	grammar grammars.Grammar,
+	rootBlockName string,
+	fn func(session *session) ([]byte, error),
+) ([]byte, error) {
+	retSession, err := createSession(app.grammarRepository, app.random, grammar, app.maxDepth, app.maxSize)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint(0); i < app.attempts; i++ {
+		output, err := fn(retSession.reset())
+		if err != nil {
+			return nil, err
+		}
+
+		// balances and uniqueness constraints are respected by rejecting the inputs that the grammar does not accept:
+		if uint(len(output)) > app.maxSize || !app.accepts(grammar, rootBlockName, output) {
+			continue
+		}
+
+		return output, nil
+	}
+
+	str := fmt.Sprintf("no input accepted by the grammar could be generated after %d attempts", app.attempts)
+	return nil, errors.New(str)
+}
+
+func (app *generator) mutate(grammar grammars.Grammar, rootBlockName string, input []byte, amount uint) ([][]byte, error) {
+	alphabet := mutationAlphabet(grammar)
+	output := [][]byte{}
+	for i := uint(0); i < amount*app.attempts && uint(len(output)) < amount; i++ {
+		mutation := app.mutation(input, alphabet)
+		if bytes.Equal(mutation, input) || contains(output, mutation) {
+			continue
+		}
+
+		if app.accepts(grammar, rootBlockName, mutation) {
+			continue
+		}
+
+		output = append(output, mutation)
+	}
+
+	if len(output) <= 0 && amount > 0 {
+		str := fmt.Sprintf("no mutation rejected by the grammar could be created from the input (%s)", input)
+		return nil, errors.New(str)
+	}
+
+	return output, nil
+}
+
+func (app *generator) mutation(input []byte, alphabet []byte) []byte {
+	output := append([]byte{}, input...)
+	length := len(output)
+	randomByte := alphabet[app.random.Intn(len(alphabet))]
+	if length <= 0 {
+		return []byte{randomByte}
+	}
+
+	idx := app.random.Intn(length)
+	switch app.random.Intn(5) {
+	case 0:
+		// delete a byte:
+		return append(output[:idx], output[idx+1:]...)
+	case 1:
+		// insert a byte:
+		return append(output[:idx], append([]byte{randomByte}, output[idx:]...)...)
+	case 2:
+		// replace a byte:
+		output[idx] = randomByte
+		return output
+	case 3:
+		// swap two adjacent bytes:
+		if idx+1 < length {
+			output[idx], output[idx+1] = output[idx+1], output[idx]
+		}
+
+		return output
+	default:
+		// truncate:
+		return output[:idx]
+	}
+}
+
+func (app *generator) accepts(grammar grammars.Grammar, rootBlockName string, input []byte) bool {
+	var retRemaining []byte
+	var err error
+	if rootBlockName != "" {
+		_, retRemaining, err = app.astAdapter.ToASTWithRoot(grammar, rootBlockName, input)
+	} else {
+		_, retRemaining, err = app.astAdapter.ToAST(grammar, input)
+	}
+
+	return err == nil && len(retRemaining) <= 0
+}
+
+func mutationAlphabet(grammar grammars.Grammar) []byte {
+	output := []byte{}
+	for _, oneRule := range grammar.Rules().List() {
+		for _, oneByte := range oneRule.Bytes() {
+			if bytes.IndexByte(output, oneByte) < 0 {
+				output = append(output, oneByte)
+			}
+		}
+	}
+
+	if len(output) <= 0 {
+		return []byte(reverseAlphabet)
+	}
+
+	return output
+}
+
+func contains(list [][]byte, value []byte) bool {
+	for _, oneValue := range list {
+		if bytes.Equal(oneValue, value) {
+			return true
+		}
+	}
+
+	return false
+}
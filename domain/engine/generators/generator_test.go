@@ -0,0 +1,144 @@
+package generators
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+)
+
+func TestGenerator_Success(t *testing.T) {
+	grammarInput := []byte(`
+		v1;
+		> .program;
+		# .SPACE;
+
+		program: .assignment+
+				;
+
+		assignment: .name .EQUAL .value .SEMICOLON
+					;
+
+		name: .LL_A
+			| .LL_B
+			;
+
+		value: .number
+			| .OPEN_PARENTHESIS .value .PLUS .value .CLOSE_PARENTHESIS
+			;
+
+		number: ._digits
+			| .N_ONE[1,3]
+			;
+
+		_digits: .N_ONE .N_TWO[2];
+
+		N_ONE: "1";
+		N_TWO: "2";
+		LL_A: "a";
+		LL_B: "b";
+		EQUAL: "=";
+		PLUS: "+";
+		SEMICOLON: ";";
+		OPEN_PARENTHESIS: "(";
+		CLOSE_PARENTHESIS: ")";
+		SPACE: " ";
+	`)
+
+	retGrammar, _, err := grammars.NewAdapter().ToGrammar(grammarInput)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	repository := grammars.NewRepositoryMemory(map[string]grammars.Grammar{})
+	builder := NewBuilder(repository)
+	generator, err := builder.Create().WithSeed(42).WithMaxDepth(6).WithMaxSize(128).Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	astAdapter := asts.NewAdapter(repository)
+	inputs := [][]byte{}
+	for i := 0; i < 20; i++ {
+		retInput, err := generator.Generate(retGrammar)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if len(retInput) > 128 {
+			t.Errorf("the generated input was expected to contain at most %d bytes, %d returned", 128, len(retInput))
+			return
+		}
+
+		_, retRemaining, err := astAdapter.ToAST(retGrammar, retInput)
+		if err != nil {
+			t.Errorf("the generated input (%s) was expected to be accepted, error returned: %s", retInput, err.Error())
+			return
+		}
+
+		if len(retRemaining) > 0 {
+			t.Errorf("the generated input (%s) was expected to be fully consumed, '%s' remaining", retInput, retRemaining)
+			return
+		}
+
+		retMutations, err := generator.Mutate(retGrammar, retInput, 3)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		for _, oneMutation := range retMutations {
+			_, retRemaining, err := astAdapter.ToAST(retGrammar, oneMutation)
+			if err == nil && len(retRemaining) <= 0 {
+				t.Errorf("the mutation (%s) of the input (%s) was expected to be rejected", oneMutation, retInput)
+				return
+			}
+		}
+
+		inputs = append(inputs, retInput)
+	}
+
+	sameSeed, err := builder.Create().WithSeed(42).WithMaxDepth(6).WithMaxSize(128).Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retInput, err := sameSeed.Generate(retGrammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !bytes.Equal(retInput, inputs[0]) {
+		t.Errorf("the same seed was expected to generate the same input (%s), %s returned", inputs[0], retInput)
+		return
+	}
+
+	retValue, err := generator.GenerateWithRoot(retGrammar, "value")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, retRemaining, err := astAdapter.ToASTWithRoot(retGrammar, "value", retValue)
+	if err != nil || len(retRemaining) > 0 {
+		t.Errorf("the generated value (%s) was expected to be accepted by the value block", retValue)
+		return
+	}
+}
+
+func TestGenerator_withoutSeed_returnsError(t *testing.T) {
+	_, err := NewBuilder(
+		grammars.NewRepositoryMemory(map[string]grammars.Grammar{}),
+	).Create().Now()
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
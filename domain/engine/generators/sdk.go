@@ -0,0 +1,53 @@
+package generators
+
+import (
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+)
+
+const defaultMaxDepth = 16
+const defaultMaxSize = 1024
+const defaultAttempts = 100
+const extraRepetitions = 3
+const omissionOdds = 4
+const reverseAlphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+const reverseMaxLength = 4
+
+// NewBuilder creates a new generator builder
+func NewBuilder(
+	grammarRepository grammars.Repository,
+) Builder {
+	astAdapter := asts.NewAdapter(
+		grammarRepository,
+	)
+
+	return createBuilder(
+		astAdapter,
+		grammarRepository,
+	)
+}
+
+// Builder represents the generator builder
+type Builder interface {
+	Create() Builder
+	WithSeed(seed int64) Builder
+	WithMaxDepth(maxDepth uint) Builder
+	WithMaxSize(maxSize uint) Builder
+	WithAttempts(attempts uint) Builder
+	Now() (Generator, error)
+}
+
+// Generator generates random inputs from a grammar, it is not safe for concurrent use
+type Generator interface {
+	// Generate generates a random input accepted by the grammar
+	Generate(grammar grammars.Grammar) ([]byte, error)
+
+	// GenerateWithRoot generates a random input accepted by the provided root block of the grammar
+	GenerateWithRoot(grammar grammars.Grammar, rootBlockName string) ([]byte, error)
+
+	// Mutate creates near-miss mutations of the input that the grammar rejects
+	Mutate(grammar grammars.Grammar, input []byte, amount uint) ([][]byte, error)
+
+	// MutateWithRoot creates near-miss mutations of the input that the provided root block of the grammar rejects
+	MutateWithRoot(grammar grammars.Grammar, rootBlockName string, input []byte, amount uint) ([][]byte, error)
+}
@@ -0,0 +1,292 @@
+package generators
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/cardinalities"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements"
+)
+
+const infiniteDepth = ^uint(0)
+
+type session struct {
+	grammarRepository grammars.Repository
+	random            *rand.Rand
+	grammar           grammars.Grammar
+	depths            map[string]uint
+	maxDepth          uint
+	maxSize           uint
+	output            []byte
+}
+
+func createSession(
+	grammarRepository grammars.Repository,
+	random *rand.Rand,
+	grammar grammars.Grammar,
+	maxDepth uint,
+	maxSize uint,
+) (*session, error) {
+	depths := blockDepths(grammar)
+	for name, depth := range depths {
+		if depth == infiniteDepth {
+			str := fmt.Sprintf("the block (name: %s) can never terminate, since every one of its lines requires itself", name)
+			return nil, errors.New(str)
+		}
+	}
+
+	out := session{
+		grammarRepository: grammarRepository,
+		random:            random,
+		grammar:           grammar,
+		depths:            depths,
+		maxDepth:          maxDepth,
+		maxSize:           maxSize,
+		output:            []byte{},
+	}
+
+	return &out, nil
+}
+
+func (obj *session) reset() *session {
+	obj.output = []byte{}
+	return obj
+}
+
+func (obj *session) generateElement(element elements.Element, depth uint) ([]byte, error) {
+	err := obj.element(element, depth)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.output, nil
+}
+
+func (obj *session) generateBlock(name string, depth uint) ([]byte, error) {
+	err := obj.block(name, depth)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.output, nil
+}
+
+func (obj *session) element(element elements.Element, depth uint) error {
+	if element.IsRule() {
+		rule, err := obj.grammar.Rules().Fetch(element.Rule())
+		if err != nil {
+			return err
+		}
+
+		obj.output = append(obj.output, rule.Bytes()...)
+		return nil
+	}
+
+	if element.IsConstant() {
+		return obj.constant(element.Constant())
+	}
+
+	if element.IsBlock() {
+		return obj.block(element.Block(), depth)
+	}
+
+	if element.IsReference() {
+		retGrammar, err := obj.grammarRepository.Retrieve(element.Reference())
+		if err != nil {
+			return err
+		}
+
+		child, err := createSession(obj.grammarRepository, obj.random, retGrammar, obj.maxDepth, obj.maxSize)
+		if err != nil {
+			return err
+		}
+
+		child.output = obj.output
+		err = child.element(retGrammar.Root(), depth)
+		if err != nil {
+			return err
+		}
+
+		obj.output = child.output
+		return nil
+	}
+
+	str := fmt.Sprintf("the element (name: %s) is neither a rule, a constant, a block or a reference", element.Name())
+	return errors.New(str)
+}
+
+func (obj *session) constant(name string) error {
+	if !obj.grammar.HasConstants() {
+		str := fmt.Sprintf("the constant (name: %s) is referenced but the grammar does not contain constants", name)
+		return errors.New(str)
+	}
+
+	constant, err := obj.grammar.Constants().Fetch(name)
+	if err != nil {
+		return err
+	}
+
+	for _, oneToken := range constant.Tokens().List() {
+		element := oneToken.Element()
+		for i := uint(0); i < oneToken.Amount(); i++ {
+			if element.IsConstant() {
+				err := obj.constant(element.Constant())
+				if err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			rule, err := obj.grammar.Rules().Fetch(element.Rule())
+			if err != nil {
+				return err
+			}
+
+			obj.output = append(obj.output, rule.Bytes()...)
+		}
+	}
+
+	return nil
+}
+
+func (obj *session) block(name string, depth uint) error {
+	block, err := obj.grammar.Blocks().Fetch(name)
+	if err != nil {
+		return err
+	}
+
+	isExhausted := obj.isExhausted(depth)
+	candidates := []lines.Line{}
+	minDepth := infiniteDepth
+	for _, oneLine := range block.Lines().List() {
+		if !isExhausted {
+			candidates = append(candidates, oneLine)
+			continue
+		}
+
+		// once the limits are reached, only the shallowest lines are kept so that the generation terminates:
+		lineDepth := lineDepth(obj.depths, oneLine)
+		if lineDepth < minDepth {
+			minDepth = lineDepth
+			candidates = []lines.Line{}
+		}
+
+		if lineDepth == minDepth {
+			candidates = append(candidates, oneLine)
+		}
+	}
+
+	line := candidates[obj.random.Intn(len(candidates))]
+	for _, oneToken := range line.Tokens().List() {
+		amount := obj.amount(oneToken.Cardinality(), obj.isExhausted(depth))
+		for i := uint(0); i < amount; i++ {
+			if oneToken.HasReverse() {
+				obj.reverse(obj.isExhausted(depth))
+			} else {
+				err := obj.element(oneToken.Element(), depth+1)
+				if err != nil {
+					return err
+				}
+			}
+
+			err := obj.omission(depth + 1)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (obj *session) amount(cardinality cardinalities.Cardinality, isExhausted bool) uint {
+	min := cardinality.Min()
+	if isExhausted {
+		return min
+	}
+
+	max := min + extraRepetitions
+	if cardinality.HasMax() && *cardinality.Max() < max {
+		max = *cardinality.Max()
+	}
+
+	return min + uint(obj.random.Intn(int(max-min+1)))
+}
+
+func (obj *session) reverse(isExhausted bool) {
+	length := 1
+	if !isExhausted {
+		length += obj.random.Intn(reverseMaxLength)
+	}
+
+	for i := 0; i < length; i++ {
+		obj.output = append(obj.output, reverseAlphabet[obj.random.Intn(len(reverseAlphabet))])
+	}
+}
+
+func (obj *session) omission(depth uint) error {
+	if !obj.grammar.HasOmissions() || obj.random.Intn(omissionOdds) != 0 {
+		return nil
+	}
+
+	list := obj.grammar.Omissions().List()
+	return obj.element(list[obj.random.Intn(len(list))], depth)
+}
+
+func (obj *session) isExhausted(depth uint) bool {
+	return depth >= obj.maxDepth || uint(len(obj.output)) >= obj.maxSize
+}
+
+func blockDepths(grammar grammars.Grammar) map[string]uint {
+	blocksList := grammar.Blocks().List()
+	depths := map[string]uint{}
+	for _, oneBlock := range blocksList {
+		depths[oneBlock.Name()] = infiniteDepth
+	}
+
+	for isChanged := true; isChanged; {
+		isChanged = false
+		for _, oneBlock := range blocksList {
+			name := oneBlock.Name()
+			for _, oneLine := range oneBlock.Lines().List() {
+				depth := lineDepth(depths, oneLine)
+				if depth < depths[name] {
+					depths[name] = depth
+					isChanged = true
+				}
+			}
+		}
+	}
+
+	return depths
+}
+
+func lineDepth(depths map[string]uint, line lines.Line) uint {
+	max := uint(0)
+	for _, oneToken := range line.Tokens().List() {
+		element := oneToken.Element()
+		if oneToken.Cardinality().Min() <= 0 || oneToken.HasReverse() || !element.IsBlock() {
+			continue
+		}
+
+		// a missing block is reported when the block is fetched:
+		depth, ok := depths[element.Block()]
+		if !ok {
+			continue
+		}
+
+		if depth == infiniteDepth {
+			return infiniteDepth
+		}
+
+		if depth > max {
+			max = depth
+		}
+	}
+
+	return max + 1
+}
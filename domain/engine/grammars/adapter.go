@@ -5,8 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"path/filepath"
-	"strconv"
+	"sort"
+	"strings"
 
 	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks"
 	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines"
@@ -23,7 +23,9 @@ import (
 	"github.com/steve-care-software/grammars/domain/engine/grammars/constants"
 	constant_tokens "github.com/steve-care-software/grammars/domain/engine/grammars/constants/tokens"
 	constant_elements "github.com/steve-care-software/grammars/domain/engine/grammars/constants/tokens/elements"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/diffs"
 	"github.com/steve-care-software/grammars/domain/engine/grammars/rules"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
 )
 
 type adapter struct {
@@ -55,8 +57,11 @@ type adapter struct {
 	ruleBuilder                       rules.RuleBuilder
 	cardinalityBuilder                cardinalities.Builder
 	referenceBuilder                  references.Builder
+	versionAdapter                    versions.Adapter
+	diffComparer                      diffs.Comparer
 	filterBytes                       []byte
 	suiteSeparatorPrefix              []byte
+	suiteExpectationPrefix            []byte
 	blockNameAfterFirstByteCharacters []byte
 	possibleLowerCaseLetters          []byte
 	possibleUpperCaseLetters          []byte
@@ -71,6 +76,7 @@ type adapter struct {
 	blockSuffix                       byte
 	suiteLineSuffix                   byte
 	failSeparator                     byte
+	suiteGoldenPrefix                 byte
 	blockDefinitionSeparator          byte
 	linesSeparator                    byte
 	lineSeparator                     byte
@@ -133,8 +139,10 @@ func createAdapter(
 	ruleBuilder rules.RuleBuilder,
 	cardinalityBuilder cardinalities.Builder,
 	referenceBuilder references.Builder,
+	versionAdapter versions.Adapter,
 	filterBytes []byte,
 	suiteSeparatorPrefix []byte,
+	suiteExpectationPrefix []byte,
 	blockNameAfterFirstByteCharacters []byte,
 	possibleLowerCaseLetters []byte,
 	possibleUpperCaseLetters []byte,
@@ -149,6 +157,7 @@ func createAdapter(
 	blockSuffix byte,
 	suiteLineSuffix byte,
 	failSeparator byte,
+	suiteGoldenPrefix byte,
 	blockDefinitionSeparator byte,
 	linesSeparator byte,
 	lineSeparator byte,
@@ -210,8 +219,10 @@ func createAdapter(
 		ruleBuilder:                       ruleBuilder,
 		cardinalityBuilder:                cardinalityBuilder,
 		referenceBuilder:                  referenceBuilder,
+		versionAdapter:                    versionAdapter,
 		filterBytes:                       filterBytes,
 		suiteSeparatorPrefix:              suiteSeparatorPrefix,
+		suiteExpectationPrefix:            suiteExpectationPrefix,
 		blockNameAfterFirstByteCharacters: blockNameAfterFirstByteCharacters,
 		possibleLowerCaseLetters:          possibleLowerCaseLetters,
 		possibleUpperCaseLetters:          possibleUpperCaseLetters,
@@ -225,6 +236,7 @@ func createAdapter(
 		rootSuffix:                        rootSuffix,
 		suiteLineSuffix:                   suiteLineSuffix,
 		failSeparator:                     failSeparator,
+		suiteGoldenPrefix:                 suiteGoldenPrefix,
 		blockDefinitionSeparator:          blockDefinitionSeparator,
 		blockSuffix:                       blockSuffix,
 		linesSeparator:                    linesSeparator,
@@ -259,6 +271,7 @@ func createAdapter(
 		referenceElementSeparator:         referenceElementSeparator,
 	}
 
+	out.diffComparer = diffs.NewComparer(createRenderer(&out))
 	return &out
 }
 
@@ -270,7 +283,7 @@ func (app *adapter) ToGrammar(input []byte) (Grammar, []byte, error) {
 		return nil, nil, err
 	}
 
-	version, err := strconv.Atoi(string(retVersion))
+	version, err := app.versionAdapter.ToVersion(retVersion)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -288,7 +301,7 @@ func (app *adapter) ToGrammar(input []byte) (Grammar, []byte, error) {
 
 	retRootRemaining = filterPrefix(retRootRemaining, app.filterBytes)
 	remaining := retRootRemaining
-	builder := app.grammarBuilder.Create().WithVersion(uint(version)).WithRoot(retRoot)
+	builder := app.grammarBuilder.Create().WithVersion(version).WithRoot(retRoot)
 	retOmissionBytes, retOmissionRemaining, err := extractBetween(retRootRemaining, app.omissionPrefix, app.omissionSuffix, nil)
 	if err == nil {
 		retOmissions, _, err := app.bytesToElementReferences(retOmissionBytes)
@@ -329,6 +342,101 @@ func (app *adapter) ToGrammar(input []byte) (Grammar, []byte, error) {
 	return ins, filterPrefix(retRemaining, app.filterBytes), nil
 }
 
+// ToBytes takes a grammar and converts it to its text representation
+func (app *adapter) ToBytes(grammar Grammar) ([]byte, error) {
+	// the blocks builder reverses its list, so the blocks are written in reverse to preserve their order once parsed:
+	blocksList := grammar.Blocks().List()
+	reversed := []blocks.Block{}
+	for i := len(blocksList) - 1; i >= 0; i-- {
+		reversed = append(reversed, blocksList[i])
+	}
+
+	constantsList := []constants.Constant{}
+	if grammar.HasConstants() {
+		constantsList = grammar.Constants().List()
+	}
+
+	return app.toBytes(grammar, reversed, constantsList, grammar.Rules().List()), nil
+}
+
+// ToCanonical takes a grammar and converts it to its canonical representation
+func (app *adapter) ToCanonical(grammar Grammar) ([]byte, error) {
+	blocksList := append([]blocks.Block{}, grammar.Blocks().List()...)
+	sort.Slice(blocksList, func(i, j int) bool {
+		return blocksList[i].Name() < blocksList[j].Name()
+	})
+
+	constantsList := []constants.Constant{}
+	if grammar.HasConstants() {
+		constantsList = append(constantsList, grammar.Constants().List()...)
+		sort.Slice(constantsList, func(i, j int) bool {
+			return constantsList[i].Name() < constantsList[j].Name()
+		})
+	}
+
+	rulesList := append([]rules.Rule{}, grammar.Rules().List()...)
+	sort.Slice(rulesList, func(i, j int) bool {
+		return rulesList[i].Name() < rulesList[j].Name()
+	})
+
+	return app.toBytes(grammar, blocksList, constantsList, rulesList), nil
+}
+
+// ToDiff compares two versions of a grammar
+func (app *adapter) ToDiff(from Grammar, to Grammar) (diffs.Diff, error) {
+	return app.diffComparer.Compare(from, to)
+}
+
+func (app *adapter) omissionsToBytes(grammar Grammar) []byte {
+	if !grammar.HasOmissions() {
+		return nil
+	}
+
+	omissions := [][]byte{}
+	for _, oneOmission := range grammar.Omissions().List() {
+		omissions = append(omissions, app.elementReferenceToBytes(oneOmission))
+	}
+
+	return bytes.Join(omissions, []byte(" "))
+}
+
+func (app *adapter) linesToBytes(lines lines.Lines) []byte {
+	list := [][]byte{}
+	for _, oneLine := range lines.List() {
+		list = append(list, app.lineToBytes(oneLine))
+	}
+
+	return bytes.Join(list, []byte(fmt.Sprintf(" %c ", app.linesSeparator)))
+}
+
+func (app *adapter) toBytes(grammar Grammar, blocksList []blocks.Block, constantsList []constants.Constant, rulesList []rules.Rule) []byte {
+	buffer := bytes.Buffer{}
+	buffer.WriteString(fmt.Sprintf("%c%s%c\n", app.versionPrefix, grammar.Version().String(), app.versionSuffix))
+	buffer.WriteString(fmt.Sprintf("%c %s%c\n", app.rootPrefix, app.elementReferenceToBytes(grammar.Root()), app.rootSuffix))
+	if grammar.HasOmissions() {
+		buffer.WriteString(fmt.Sprintf("%c %s%c\n", app.omissionPrefix, app.omissionsToBytes(grammar), app.omissionSuffix))
+	}
+
+	for _, oneBlock := range blocksList {
+		buffer.WriteString("\n")
+		buffer.Write(app.blockToBytes(oneBlock))
+	}
+
+	if len(constantsList) > 0 {
+		buffer.WriteString("\n")
+		for _, oneConstant := range constantsList {
+			buffer.Write(app.constantToBytes(oneConstant))
+		}
+	}
+
+	buffer.WriteString("\n")
+	for _, oneRule := range rulesList {
+		buffer.WriteString(fmt.Sprintf("%s%c %s%c\n", oneRule.Name(), app.ruleNameValueSeparator, app.valueToBytes(oneRule.Bytes()), app.blockSuffix))
+	}
+
+	return buffer.Bytes()
+}
+
 func (app *adapter) bytesToConstants(input []byte) (constants.Constants, []byte, error) {
 	cpt := 0
 	remaining := input
@@ -571,6 +679,16 @@ func (app *adapter) bytesToSuite(input []byte) (suites.Suite, []byte, error) {
 		return nil, nil, err
 	}
 
+	retRemainingAfterBetween = filterPrefix(retRemainingAfterBetween, app.filterBytes)
+	if bytes.HasPrefix(retRemainingAfterBetween, app.suiteExpectationPrefix) {
+		retRemainingAfterExpectation, err := app.bytesToSuiteExpectation(builder, retRemainingAfterBetween[len(app.suiteExpectationPrefix):])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		retRemainingAfterBetween = retRemainingAfterExpectation
+	}
+
 	retIns, err := builder.WithInput(retSuiteInput).Now()
 	if err != nil {
 		return nil, nil, err
@@ -587,6 +705,27 @@ func (app *adapter) bytesToSuite(input []byte) (suites.Suite, []byte, error) {
 	return retIns, filterPrefix(retRemainingAfterBetween[1:], app.filterBytes), nil
 }
 
+func (app *adapter) bytesToSuiteExpectation(builder suites.SuiteBuilder, input []byte) ([]byte, error) {
+	remaining := filterPrefix(input, app.filterBytes)
+	if len(remaining) > 0 && remaining[0] == app.suiteGoldenPrefix {
+		retGolden, retRemaining, err := extractBetween(remaining[1:], app.ruleValuePrefix, app.ruleValueSuffix, &app.ruleValueEscape)
+		if err != nil {
+			return nil, err
+		}
+
+		builder.WithGolden(string(retGolden))
+		return filterPrefix(retRemaining, app.filterBytes), nil
+	}
+
+	retExpected, retRemaining, err := extractBetween(remaining, app.ruleValuePrefix, app.ruleValueSuffix, &app.ruleValueEscape)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.WithExpected(retExpected)
+	return filterPrefix(retRemaining, app.filterBytes), nil
+}
+
 func (app *adapter) bytesToBlockDefinition(input []byte) (string, []byte, error) {
 	blockName, retBlockRemaining, err := app.bytesToBlockName(input)
 	if err != nil {
@@ -1177,8 +1316,13 @@ func (app *adapter) bytesToReference(input []byte) (references.Reference, []byte
 		return nil, nil, errors.New("the token was expected to contain the referenceElementSeparator byte")
 	}
 
+	// the separator must be found before the end of the reference:
+	if bytes.IndexByte(remaining[:endPathPos], app.referenceEnd) != -1 {
+		return nil, nil, errors.New("the token was expected to contain the referenceElementSeparator byte before its referenceEnd byte")
+	}
+
 	pathStr := string(remaining[:endPathPos])
-	path := filepath.SplitList(pathStr)
+	path := splitPath(pathStr)
 	remaining = filterPrefix(remaining[endPathPos+1:], app.filterBytes)
 	if len(remaining) <= 0 {
 		return nil, nil, errors.New("the token was expected to contain at least 1 byte")
@@ -1192,13 +1336,13 @@ func (app *adapter) bytesToReference(input []byte) (references.Reference, []byte
 		return nil, nil, errors.New("the token was expected to contain the referenceEnd byte")
 	}
 
-	version, err := strconv.Atoi(string(remaining[:refEndPos]))
+	constraint, err := app.versionAdapter.ToConstraint(remaining[:refEndPos])
 	if err != nil {
-		str := fmt.Sprintf("the reference (path: %s) does not contain a valid version, it should be a positive number", pathStr)
+		str := fmt.Sprintf("the reference (path: %s) does not contain a valid version constraint: %s", pathStr, err.Error())
 		return nil, nil, errors.New(str)
 	}
 
-	retIns, err := app.referenceBuilder.Create().WithPath(path).WithName(retName).WithVersion(uint(version)).Now()
+	retIns, err := app.referenceBuilder.Create().WithPath(path).WithName(retName).WithConstraint(constraint).Now()
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1325,3 +1469,229 @@ func (app *adapter) bytesToRuleName(input []byte) (string, []byte, error) {
 
 	return string(retRuleName), filterPrefix(retRemaining, app.filterBytes), nil
 }
+
+func (app *adapter) blockToBytes(block blocks.Block) []byte {
+	buffer := bytes.Buffer{}
+	buffer.WriteString(fmt.Sprintf("%s%c ", block.Name(), app.blockDefinitionSeparator))
+	for idx, oneLine := range block.Lines().List() {
+		if idx > 0 {
+			buffer.WriteString(fmt.Sprintf("\n\t%c ", app.linesSeparator))
+		}
+
+		buffer.Write(app.lineToBytes(oneLine))
+	}
+
+	if block.HasSuites() {
+		buffer.WriteString(fmt.Sprintf("\n\t%s", app.suiteSeparatorPrefix))
+		for _, oneSuite := range block.Suites().List() {
+			buffer.WriteString("\n\t\t")
+			buffer.Write(app.suiteToBytes(oneSuite))
+		}
+	}
+
+	buffer.WriteString(fmt.Sprintf("\n\t%c\n", app.blockSuffix))
+	return buffer.Bytes()
+}
+
+func (app *adapter) suiteToBytes(suite suites.Suite) []byte {
+	buffer := bytes.Buffer{}
+	buffer.WriteString(fmt.Sprintf("%s%c ", suite.Name(), app.blockDefinitionSeparator))
+	if suite.IsFail() {
+		buffer.WriteByte(app.failSeparator)
+	}
+
+	buffer.Write(app.valueToBytes(suite.Input()))
+	if suite.HasExpected() {
+		buffer.WriteString(fmt.Sprintf(" %s %s", app.suiteExpectationPrefix, app.valueToBytes(suite.Expected())))
+	}
+
+	if suite.HasGolden() {
+		buffer.WriteString(fmt.Sprintf(" %s %c%s", app.suiteExpectationPrefix, app.suiteGoldenPrefix, app.valueToBytes([]byte(suite.Golden()))))
+	}
+
+	buffer.WriteByte(app.suiteLineSuffix)
+	return buffer.Bytes()
+}
+
+func (app *adapter) lineToBytes(line lines.Line) []byte {
+	tokensList := [][]byte{}
+	for _, oneToken := range line.Tokens().List() {
+		tokensList = append(tokensList, app.tokenToBytes(oneToken))
+	}
+
+	output := bytes.Join(tokensList, []byte(" "))
+	if !line.HasBalance() {
+		return output
+	}
+
+	buffer := bytes.Buffer{}
+	buffer.Write(output)
+	buffer.WriteString(fmt.Sprintf(" %c", app.cardinalityOpen))
+	for _, oneSelectors := range line.Balance().Lines() {
+		selectorsList := [][]byte{}
+		for _, oneSelector := range oneSelectors.List() {
+			selectorsList = append(selectorsList, app.selectorToBytes(oneSelector))
+		}
+
+		separator := []byte(fmt.Sprintf(" %c ", app.blockDefinitionSeparator))
+		buffer.WriteString(fmt.Sprintf("%s%c ", bytes.Join(selectorsList, separator), app.blockSuffix))
+	}
+
+	buffer.WriteString(fmt.Sprintf("%c%c", app.cardinalityClose, app.blockSuffix))
+	return buffer.Bytes()
+}
+
+func (app *adapter) selectorToBytes(selector selectors.Selector) []byte {
+	output := []byte{}
+	if selector.IsNot() {
+		output = append(output, app.tokenReversePrefix)
+	}
+
+	output = append(output, app.tokenReferenceSeparator)
+	return append(output, app.selectorChainToBytes(selector.Chain())...)
+}
+
+func (app *adapter) selectorChainToBytes(chain chains.Chain) []byte {
+	output := app.elementToBytes(chain.Element())
+	if !chain.HasToken() {
+		return output
+	}
+
+	token := chain.Token()
+	output = append(output, []byte(fmt.Sprintf("%c%d%c", app.cardinalityOpen, token.Index(), app.cardinalityClose))...)
+	if !token.HasElement() {
+		return output
+	}
+
+	element := token.Element()
+	output = append(output, []byte(fmt.Sprintf("%c%d%c", app.cardinalityOpen, element.Index(), app.cardinalityClose))...)
+	if !element.HasChain() {
+		return output
+	}
+
+	output = append(output, app.selectorChainElementPrefix...)
+	return append(output, app.selectorChainToBytes(element.Chain())...)
+}
+
+func (app *adapter) tokenToBytes(token tokens.Token) []byte {
+	output := []byte{}
+	if token.HasUnique() {
+		unique := token.Unique()
+		if unique.MustBe() {
+			output = append(output, app.tokenMustBeUnique)
+		}
+
+		if unique.MustNot() {
+			output = append(output, app.tokenMustNotBeUnique)
+		}
+
+		output = append(output, app.elementReferenceToBytes(unique.Element())...)
+		if unique.Index() > 0 {
+			output = append(output, []byte(fmt.Sprintf("%c%d%c", app.cardinalityOpen, unique.Index(), app.cardinalityClose))...)
+		}
+
+		output = append(output, ' ')
+	}
+
+	if token.HasReverse() {
+		output = append(output, app.tokenReversePrefix)
+		reverse := token.Reverse()
+		if reverse.HasEscape() {
+			output = append(output, app.tokenReverseEscapePrefix)
+			output = append(output, app.elementReferenceToBytes(reverse.Escape())...)
+			output = append(output, app.tokenReverseEscapeSuffix)
+		}
+	}
+
+	output = append(output, app.elementReferenceToBytes(token.Element())...)
+	return append(output, app.cardinalityToBytes(token.Cardinality())...)
+}
+
+func (app *adapter) cardinalityToBytes(cardinality cardinalities.Cardinality) []byte {
+	min := cardinality.Min()
+	if !cardinality.HasMax() {
+		if min == 0 {
+			return []byte{app.cardinalityZeroPlus}
+		}
+
+		if min == 1 {
+			return []byte{app.cardinalityOnePlus}
+		}
+
+		return []byte(fmt.Sprintf("%c%d%c%c", app.cardinalityOpen, min, app.cardinalitySeparator, app.cardinalityClose))
+	}
+
+	max := *cardinality.Max()
+	if min == 1 && max == 1 {
+		return []byte{}
+	}
+
+	if min == 0 && max == 1 {
+		return []byte{app.cardinalityOptional}
+	}
+
+	if min == max {
+		return []byte(fmt.Sprintf("%c%d%c", app.cardinalityOpen, min, app.cardinalityClose))
+	}
+
+	return []byte(fmt.Sprintf("%c%d%c%d%c", app.cardinalityOpen, min, app.cardinalitySeparator, max, app.cardinalityClose))
+}
+
+func (app *adapter) constantToBytes(constant constants.Constant) []byte {
+	return []byte(fmt.Sprintf("%s%c %s%c\n", constant.Name(), app.blockDefinitionSeparator, app.constantTokensToBytes(constant), app.blockSuffix))
+}
+
+func (app *adapter) constantTokensToBytes(constant constants.Constant) []byte {
+	tokensList := [][]byte{}
+	for _, oneToken := range constant.Tokens().List() {
+		element := oneToken.Element()
+		name := element.Rule()
+		if element.IsConstant() {
+			name = element.Constant()
+		}
+
+		value := []byte(fmt.Sprintf("%c%s", app.tokenReferenceSeparator, name))
+		if oneToken.Amount() != 1 {
+			value = append(value, []byte(fmt.Sprintf("%c%d%c", app.cardinalityOpen, oneToken.Amount(), app.cardinalityClose))...)
+		}
+
+		tokensList = append(tokensList, value)
+	}
+
+	return bytes.Join(tokensList, []byte(" "))
+}
+
+func (app *adapter) elementReferenceToBytes(element elements.Element) []byte {
+	return append([]byte{app.tokenReferenceSeparator}, app.elementToBytes(element)...)
+}
+
+func (app *adapter) elementToBytes(element elements.Element) []byte {
+	if !element.IsReference() {
+		return []byte(element.Name())
+	}
+
+	reference := element.Reference()
+	path := strings.Join(reference.Path(), string(app.referencePathSeparator))
+	return []byte(fmt.Sprintf(
+		"%s%c%s%c %s%c",
+		reference.Name(),
+		app.referenceBegin,
+		path,
+		app.referenceElementSeparator,
+		reference.Constraint().String(),
+		app.referenceEnd,
+	))
+}
+
+func (app *adapter) valueToBytes(value []byte) []byte {
+	output := []byte{app.ruleValuePrefix}
+	for _, oneByte := range value {
+		if oneByte == app.ruleValueSuffix || oneByte == app.ruleValueEscape {
+			output = append(output, app.ruleValueEscape)
+		}
+
+		output = append(output, oneByte)
+	}
+
+	return append(output, app.ruleValueSuffix)
+}
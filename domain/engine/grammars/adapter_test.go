@@ -2,7 +2,10 @@ package grammars
 
 import (
 	"bytes"
+	"strings"
 	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars/diffs"
 )
 
 func TestAdapter_Success(t *testing.T) {
@@ -67,8 +70,8 @@ func TestAdapter_Success(t *testing.T) {
 		return
 	}
 
-	if retGrammar.Version() != 1 {
-		t.Errorf("the version was expected to be %d, %d returned", 1, retGrammar.Version())
+	if retGrammar.Version().String() != "1.0.0" {
+		t.Errorf("the version was expected to be %s, %s returned", "1.0.0", retGrammar.Version().String())
 		return
 	}
 
@@ -597,3 +600,178 @@ func TestAdapter_cardinality_withInvalidInput_returnsError(t *testing.T) {
 		return
 	}
 }
+
+func TestAdapter_toBytes_isReversible_Success(t *testing.T) {
+	input := []byte(`
+		v1;
+		> .myRoot;
+		# .SPACE .EOL;
+
+		myRoot: #.myRoot[1] .mySecond* ![._myConstant].QUOTE .myThird+ .myFourth? .myFifth[1,] .MY_RULE[2,4] .myValue[/my/path/to/grammar.grammar, 1]
+					[
+						.myFirst[0][1]->MY_RULE[0][1] :
+							!.myFirst[0][1]->mySecond[0][0]->myThird[0]
+						;
+
+						.myFirst[0];
+					];
+				 | $.myRoot .mySecond[3] !.QUOTE
+				 ---
+					first: "this is some \"value\"";
+					second: !"this is \\ some value";
+					third: "value" => "(myRoot#1 (mySecond \"value\"))";
+					fourth: "value" => @"golden/fourth.ast";
+				 ;
+
+		mySecond: .MY_RULE
+				;
+
+		_myConstant: .MY_RULE .MY_SECOND_RULE[2] ._mySubConstant;
+		_mySubConstant: .MY_RULE;
+
+		MY_RULE: "this \" with escape";
+		MY_SECOND_RULE: "some value";
+		QUOTE: "\"";
+		SPACE: " ";
+		EOL: "
+";
+	`)
+
+	retAdapter := NewAdapter()
+	retGrammar, _, err := retAdapter.ToGrammar(input)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retBytes, err := retAdapter.ToBytes(retGrammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retReparsedGrammar, retRemaining, err := retAdapter.ToGrammar(retBytes)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(retRemaining) > 0 {
+		t.Errorf("the remaining was expected to be empty, '%s' returned", retRemaining)
+		return
+	}
+
+	retReparsedBytes, err := retAdapter.ToBytes(retReparsedGrammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !bytes.Equal(retBytes, retReparsedBytes) {
+		t.Errorf("the grammar bytes were expected to be:\n%s\nreturned:\n%s", retBytes, retReparsedBytes)
+		return
+	}
+
+	retBlocks := retReparsedGrammar.Blocks().List()
+	if retBlocks[len(retBlocks)-1].Name() != "myRoot" {
+		t.Errorf("the order of the blocks was expected to be preserved")
+		return
+	}
+
+	retSuites := retBlocks[len(retBlocks)-1].Suites().List()
+	if len(retSuites) != 4 || string(retSuites[0].Input()) != `this is some "value"` || retSuites[3].Golden() != "golden/fourth.ast" {
+		t.Errorf("the suites were expected to be preserved")
+		return
+	}
+}
+
+func TestAdapter_toDiff_Success(t *testing.T) {
+	adapter := NewAdapter()
+	from, _, err := adapter.ToGrammar([]byte(`
+		v1;
+		> .program;
+		# .SPACE;
+
+		program: .name .SEMICOLON
+				| .name
+				;
+
+		name: .LL_A
+			| ._letters
+			;
+
+		_letters: .LL_A .LL_B;
+
+		LL_A: "a";
+		LL_B: "b";
+		SEMICOLON: ";";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	to, _, err := adapter.ToGrammar([]byte(`
+		v2;
+		> .main;
+		# .SPACE .TAB;
+
+		program: .name .SEMICOLON
+				;
+
+		main: .program+
+			;
+
+		name: .LL_A
+			| .LL_B
+			;
+
+		LL_A: "a";
+		LL_B: "B";
+		SEMICOLON: ";";
+		SPACE: " ";
+		TAB: "-";
+	`))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retDiff, err := adapter.ToDiff(from, to)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := []string{
+		"~ root root: .program -> .main",
+		"~ omissions omissions: .SPACE -> .SPACE .TAB",
+		"- line program[1]: .name",
+		"~ line name[1]: ._letters -> .LL_B",
+		"+ block main: .program+",
+		"- constant _letters: .LL_A .LL_B",
+		"~ rule LL_B: \"b\" -> \"B\"",
+		"+ rule TAB: \"-\"",
+		"",
+	}
+
+	retText := diffs.NewAdapter().ToText(retDiff)
+	if string(retText) != strings.Join(expected, "\n") {
+		t.Errorf("the diff was expected to be:\n%s\nreturned:\n%s", strings.Join(expected, "\n"), retText)
+		return
+	}
+
+	retDiff, err = adapter.ToDiff(from, from)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !retDiff.IsEmpty() {
+		t.Errorf("the diff of a grammar with itself was expected to be empty")
+		return
+	}
+}
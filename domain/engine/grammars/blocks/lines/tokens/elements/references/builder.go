@@ -1,18 +1,22 @@
 package references
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+)
 
 type builder struct {
-	path     []string
-	name     string
-	pVersion *uint
+	path       []string
+	name       string
+	constraint versions.Constraint
 }
 
 func createBuilder() Builder {
 	out := builder{
-		path:     nil,
-		name:     "",
-		pVersion: nil,
+		path:       nil,
+		name:       "",
+		constraint: nil,
 	}
 
 	return &out
@@ -35,9 +39,9 @@ func (app *builder) WithName(name string) Builder {
 	return app
 }
 
-// WithVersion adds a version to the builder
-func (app *builder) WithVersion(version uint) Builder {
-	app.pVersion = &version
+// WithConstraint adds a version constraint to the builder
+func (app *builder) WithConstraint(constraint versions.Constraint) Builder {
+	app.constraint = constraint
 	return app
 }
 
@@ -47,8 +51,8 @@ func (app *builder) Now() (Reference, error) {
 		return nil, errors.New("the path is mandatory in order to build a Reference instance")
 	}
 
-	if app.pVersion == nil {
-		return nil, errors.New("the version is mandatory in order to build a Reference instance")
+	if app.constraint == nil {
+		return nil, errors.New("the version constraint is mandatory in order to build a Reference instance")
 	}
 
 	if app.name == "" {
@@ -58,6 +62,6 @@ func (app *builder) Now() (Reference, error) {
 	return createReference(
 		app.path,
 		app.name,
-		*app.pVersion,
+		app.constraint,
 	), nil
 }
@@ -1,20 +1,22 @@
 package references
 
+import "github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+
 type reference struct {
-	path    []string
-	name    string
-	version uint
+	path       []string
+	name       string
+	constraint versions.Constraint
 }
 
 func createReference(
 	path []string,
 	name string,
-	version uint,
+	constraint versions.Constraint,
 ) Reference {
 	out := reference{
-		path:    path,
-		name:    name,
-		version: version,
+		path:       path,
+		name:       name,
+		constraint: constraint,
 	}
 
 	return &out
@@ -30,7 +32,7 @@ func (obj *reference) Name() string {
 	return obj.name
 }
 
-// Version returns the version
-func (obj *reference) Version() uint {
-	return obj.version
+// Constraint returns the version constraint
+func (obj *reference) Constraint() versions.Constraint {
+	return obj.constraint
 }
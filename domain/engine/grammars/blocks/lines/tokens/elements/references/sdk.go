@@ -1,5 +1,7 @@
 package references
 
+import "github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+
 // NewBuilder creates a new version instance
 func NewBuilder() Builder {
 	return createBuilder()
@@ -10,7 +12,7 @@ type Builder interface {
 	Create() Builder
 	WithPath(path []string) Builder
 	WithName(name string) Builder
-	WithVersion(version uint) Builder
+	WithConstraint(constraint versions.Constraint) Builder
 	Now() (Reference, error)
 }
 
@@ -18,5 +20,5 @@ type Builder interface {
 type Reference interface {
 	Path() []string
 	Name() string
-	Version() uint
+	Constraint() versions.Constraint
 }
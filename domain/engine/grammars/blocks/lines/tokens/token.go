@@ -29,7 +29,7 @@ func createTokenWithReverse(
 	return createTokenInternally(element, cardinality, reverse, nil)
 }
 
-func createTokenWithReverseWithUnique(
+func createTokenWithUnique(
 	element elements.Element,
 	cardinality cardinalities.Cardinality,
 	unique uniques.Unique,
@@ -66,27 +66,27 @@ func (app *tokenBuilder) Now() (Token, error) {
 		return nil, errors.New("the cardinality is mandatory in order to build a Token instance")
 	}
 
-	if app.reverse != nil {
-		return createTokenWithReverse(
+	if app.reverse != nil && app.unique != nil {
+		return createTokenWithReverseAndUnique(
 			app.element,
 			app.cardinality,
 			app.reverse,
+			app.unique,
 		), nil
 	}
 
-	if app.unique != nil {
-		return createTokenWithReverseWithUnique(
+	if app.reverse != nil {
+		return createTokenWithReverse(
 			app.element,
 			app.cardinality,
-			app.unique,
+			app.reverse,
 		), nil
 	}
 
-	if app.reverse != nil && app.unique != nil {
-		return createTokenWithReverseAndUnique(
+	if app.unique != nil {
+		return createTokenWithUnique(
 			app.element,
 			app.cardinality,
-			app.reverse,
 			app.unique,
 		), nil
 	}
@@ -27,6 +27,8 @@ type SuiteBuilder interface {
 	Create() SuiteBuilder
 	WithName(name string) SuiteBuilder
 	WithInput(input []byte) SuiteBuilder
+	WithExpected(expected []byte) SuiteBuilder
+	WithGolden(golden string) SuiteBuilder
 	IsFail() SuiteBuilder
 	Now() (Suite, error)
 }
@@ -36,4 +38,8 @@ type Suite interface {
 	Name() string
 	Input() []byte
 	IsFail() bool
+	HasExpected() bool
+	Expected() []byte
+	HasGolden() bool
+	Golden() string
 }
@@ -1,20 +1,50 @@
 package suites
 
 type suite struct {
-	name   string
-	input  []byte
-	isFail bool
+	name     string
+	input    []byte
+	isFail   bool
+	expected []byte
+	golden   string
 }
 
 func createSuite(
 	name string,
 	input []byte,
 	isFail bool,
+) Suite {
+	return createSuiteInternally(name, input, isFail, nil, "")
+}
+
+func createSuiteWithExpected(
+	name string,
+	input []byte,
+	expected []byte,
+) Suite {
+	return createSuiteInternally(name, input, false, expected, "")
+}
+
+func createSuiteWithGolden(
+	name string,
+	input []byte,
+	golden string,
+) Suite {
+	return createSuiteInternally(name, input, false, nil, golden)
+}
+
+func createSuiteInternally(
+	name string,
+	input []byte,
+	isFail bool,
+	expected []byte,
+	golden string,
 ) Suite {
 	out := suite{
-		name:   name,
-		input:  input,
-		isFail: isFail,
+		name:     name,
+		input:    input,
+		isFail:   isFail,
+		expected: expected,
+		golden:   golden,
 	}
 
 	return &out
@@ -34,3 +64,23 @@ func (obj *suite) Input() []byte {
 func (obj *suite) IsFail() bool {
 	return obj.isFail
 }
+
+// HasExpected returns true if there is an expected AST snapshot, false otherwise
+func (obj *suite) HasExpected() bool {
+	return obj.expected != nil
+}
+
+// Expected returns the expected AST snapshot, if any
+func (obj *suite) Expected() []byte {
+	return obj.expected
+}
+
+// HasGolden returns true if there is a golden file, false otherwise
+func (obj *suite) HasGolden() bool {
+	return obj.golden != ""
+}
+
+// Golden returns the golden file path, if any
+func (obj *suite) Golden() string {
+	return obj.golden
+}
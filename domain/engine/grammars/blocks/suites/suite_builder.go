@@ -2,19 +2,24 @@ package suites
 
 import (
 	"errors"
+	"fmt"
 )
 
 type suiteBuilder struct {
-	name   string
-	input  []byte
-	isFail bool
+	name     string
+	input    []byte
+	isFail   bool
+	expected []byte
+	golden   string
 }
 
 func createSuiteBuilder() SuiteBuilder {
 	out := suiteBuilder{
-		name:   "",
-		input:  nil,
-		isFail: false,
+		name:     "",
+		input:    nil,
+		isFail:   false,
+		expected: nil,
+		golden:   "",
 	}
 
 	return &out
@@ -37,6 +42,18 @@ func (app *suiteBuilder) WithInput(input []byte) SuiteBuilder {
 	return app
 }
 
+// WithExpected adds an expected AST snapshot to the builder
+func (app *suiteBuilder) WithExpected(expected []byte) SuiteBuilder {
+	app.expected = expected
+	return app
+}
+
+// WithGolden adds a golden file path to the builder
+func (app *suiteBuilder) WithGolden(golden string) SuiteBuilder {
+	app.golden = golden
+	return app
+}
+
 // IsFail flags the suite as fail
 func (app *suiteBuilder) IsFail() SuiteBuilder {
 	app.isFail = true
@@ -53,5 +70,27 @@ func (app *suiteBuilder) Now() (Suite, error) {
 		return nil, errors.New("the name is mandatory in order to build a Suite instance")
 	}
 
+	if app.expected != nil && len(app.expected) <= 0 {
+		app.expected = nil
+	}
+
+	if app.expected != nil && app.golden != "" {
+		str := fmt.Sprintf("the suite (%s) cannot contain both an expected AST snapshot and a golden file", app.name)
+		return nil, errors.New(str)
+	}
+
+	if app.isFail && (app.expected != nil || app.golden != "") {
+		str := fmt.Sprintf("the suite (%s) is expected to fail and therefore cannot contain an expected AST snapshot or a golden file", app.name)
+		return nil, errors.New(str)
+	}
+
+	if app.expected != nil {
+		return createSuiteWithExpected(app.name, app.input, app.expected), nil
+	}
+
+	if app.golden != "" {
+		return createSuiteWithGolden(app.name, app.input, app.golden), nil
+	}
+
 	return createSuite(app.name, app.input, app.isFail), nil
 }
@@ -7,10 +7,11 @@ import (
 	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements"
 	"github.com/steve-care-software/grammars/domain/engine/grammars/constants"
 	"github.com/steve-care-software/grammars/domain/engine/grammars/rules"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
 )
 
 type builder struct {
-	pVersion  *uint
+	version   versions.Version
 	root      elements.Element
 	rules     rules.Rules
 	blocks    blocks.Blocks
@@ -20,7 +21,7 @@ type builder struct {
 
 func createBuilder() Builder {
 	out := builder{
-		pVersion:  nil,
+		version:   nil,
 		root:      nil,
 		rules:     nil,
 		blocks:    nil,
@@ -37,8 +38,8 @@ func (app *builder) Create() Builder {
 }
 
 // WithVersion adds a version to the builder
-func (app *builder) WithVersion(version uint) Builder {
-	app.pVersion = &version
+func (app *builder) WithVersion(version versions.Version) Builder {
+	app.version = version
 	return app
 }
 
@@ -74,7 +75,7 @@ func (app *builder) WithConstants(constants constants.Constants) Builder {
 
 // Now builds a new Grammar instance
 func (app *builder) Now() (Grammar, error) {
-	if app.pVersion == nil {
+	if app.version == nil {
 		return nil, errors.New("the version is mandatory in order to build a Grammar instance")
 	}
 
@@ -91,16 +92,16 @@ func (app *builder) Now() (Grammar, error) {
 	}
 
 	if app.omissions != nil && app.constants != nil {
-		return createGrammarWithOmissionsAndConstants(*app.pVersion, app.root, app.rules, app.blocks, app.omissions, app.constants), nil
+		return createGrammarWithOmissionsAndConstants(app.version, app.root, app.rules, app.blocks, app.omissions, app.constants), nil
 	}
 
 	if app.omissions != nil {
-		return createGrammarWithOmissions(*app.pVersion, app.root, app.rules, app.blocks, app.omissions), nil
+		return createGrammarWithOmissions(app.version, app.root, app.rules, app.blocks, app.omissions), nil
 	}
 
 	if app.constants != nil {
-		return createGrammarWithConstants(*app.pVersion, app.root, app.rules, app.blocks, app.constants), nil
+		return createGrammarWithConstants(app.version, app.root, app.rules, app.blocks, app.constants), nil
 	}
 
-	return createGrammar(*app.pVersion, app.root, app.rules, app.blocks), nil
+	return createGrammar(app.version, app.root, app.rules, app.blocks), nil
 }
@@ -0,0 +1,92 @@
+package diffs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+type jsonDiff struct {
+	Changes []jsonChange `json:"changes"`
+}
+
+type jsonChange struct {
+	Kind    string `json:"kind"`
+	Subject string `json:"subject"`
+	Name    string `json:"name"`
+	Index   *uint  `json:"index,omitempty"`
+	Before  string `json:"before,omitempty"`
+	After   string `json:"after,omitempty"`
+}
+
+type adapter struct {
+}
+
+func createAdapter() Adapter {
+	out := adapter{}
+	return &out
+}
+
+// ToText renders the diff as text, one change per line
+func (app *adapter) ToText(diff Diff) []byte {
+	buffer := bytes.Buffer{}
+	for _, oneChange := range diff.List() {
+		name := oneChange.Name()
+		if oneChange.HasIndex() {
+			name = fmt.Sprintf("%s[%d]", name, oneChange.Index())
+		}
+
+		line := ""
+		if oneChange.IsAdded() {
+			line = fmt.Sprintf("+ %s %s: %s", oneChange.Subject().String(), name, oneChange.After())
+		}
+
+		if oneChange.IsRemoved() {
+			line = fmt.Sprintf("- %s %s: %s", oneChange.Subject().String(), name, oneChange.Before())
+		}
+
+		if oneChange.IsChanged() {
+			line = fmt.Sprintf("~ %s %s: %s -> %s", oneChange.Subject().String(), name, oneChange.Before(), oneChange.After())
+		}
+
+		buffer.WriteString(line)
+		buffer.WriteString("\n")
+	}
+
+	return buffer.Bytes()
+}
+
+// ToJSON renders the diff as JSON
+func (app *adapter) ToJSON(diff Diff) ([]byte, error) {
+	output := jsonDiff{
+		Changes: []jsonChange{},
+	}
+
+	for _, oneChange := range diff.List() {
+		kind := "changed"
+		if oneChange.IsAdded() {
+			kind = "added"
+		}
+
+		if oneChange.IsRemoved() {
+			kind = "removed"
+		}
+
+		var pIndex *uint
+		if oneChange.HasIndex() {
+			index := oneChange.Index()
+			pIndex = &index
+		}
+
+		output.Changes = append(output.Changes, jsonChange{
+			Kind:    kind,
+			Subject: oneChange.Subject().String(),
+			Name:    oneChange.Name(),
+			Index:   pIndex,
+			Before:  string(oneChange.Before()),
+			After:   string(oneChange.After()),
+		})
+	}
+
+	return json.Marshal(output)
+}
@@ -0,0 +1,35 @@
+package diffs
+
+import "errors"
+
+type builder struct {
+	list []Change
+}
+
+func createBuilder() Builder {
+	out := builder{
+		list: nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *builder) Create() Builder {
+	return createBuilder()
+}
+
+// WithList adds a list to the builder
+func (app *builder) WithList(list []Change) Builder {
+	app.list = list
+	return app
+}
+
+// Now builds a new Diff instance
+func (app *builder) Now() (Diff, error) {
+	if app.list == nil {
+		return nil, errors.New("the list is mandatory in order to build a Diff instance")
+	}
+
+	return createDiff(app.list), nil
+}
@@ -0,0 +1,86 @@
+package diffs
+
+type change struct {
+	subject Subject
+	name    string
+	pIndex  *uint
+	before  []byte
+	after   []byte
+}
+
+func createChange(
+	subject Subject,
+	name string,
+	pIndex *uint,
+	before []byte,
+	after []byte,
+) Change {
+	out := change{
+		subject: subject,
+		name:    name,
+		pIndex:  pIndex,
+		before:  before,
+		after:   after,
+	}
+
+	return &out
+}
+
+// Subject returns the subject
+func (obj *change) Subject() Subject {
+	return obj.subject
+}
+
+// Name returns the name of the block, constant or rule, or the name of the subject for the root and omissions
+func (obj *change) Name() string {
+	return obj.name
+}
+
+// HasIndex returns true if there is an index, false otherwise
+func (obj *change) HasIndex() bool {
+	return obj.pIndex != nil
+}
+
+// Index returns the index of the line, if any
+func (obj *change) Index() uint {
+	if obj.pIndex == nil {
+		return 0
+	}
+
+	return *obj.pIndex
+}
+
+// IsAdded returns true if the subject only exists in the new grammar, false otherwise
+func (obj *change) IsAdded() bool {
+	return obj.before == nil
+}
+
+// IsRemoved returns true if the subject only exists in the old grammar, false otherwise
+func (obj *change) IsRemoved() bool {
+	return obj.after == nil
+}
+
+// IsChanged returns true if the subject exists in both grammars, false otherwise
+func (obj *change) IsChanged() bool {
+	return obj.before != nil && obj.after != nil
+}
+
+// HasBefore returns true if there is a before, false otherwise
+func (obj *change) HasBefore() bool {
+	return obj.before != nil
+}
+
+// Before returns the text of the subject in the old grammar, if any
+func (obj *change) Before() []byte {
+	return obj.before
+}
+
+// HasAfter returns true if there is an after, false otherwise
+func (obj *change) HasAfter() bool {
+	return obj.after != nil
+}
+
+// After returns the text of the subject in the new grammar, if any
+func (obj *change) After() []byte {
+	return obj.after
+}
@@ -0,0 +1,98 @@
+package diffs
+
+import (
+	"errors"
+	"fmt"
+)
+
+type changeBuilder struct {
+	pSubject *Subject
+	name     string
+	pIndex   *uint
+	before   []byte
+	after    []byte
+}
+
+func createChangeBuilder() ChangeBuilder {
+	out := changeBuilder{
+		pSubject: nil,
+		name:     "",
+		pIndex:   nil,
+		before:   nil,
+		after:    nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *changeBuilder) Create() ChangeBuilder {
+	return createChangeBuilder()
+}
+
+// WithSubject adds a subject to the builder
+func (app *changeBuilder) WithSubject(subject Subject) ChangeBuilder {
+	app.pSubject = &subject
+	return app
+}
+
+// WithName adds a name to the builder
+func (app *changeBuilder) WithName(name string) ChangeBuilder {
+	app.name = name
+	return app
+}
+
+// WithIndex adds an index to the builder
+func (app *changeBuilder) WithIndex(index uint) ChangeBuilder {
+	app.pIndex = &index
+	return app
+}
+
+// WithBefore adds a before to the builder
+func (app *changeBuilder) WithBefore(before []byte) ChangeBuilder {
+	app.before = before
+	return app
+}
+
+// WithAfter adds an after to the builder
+func (app *changeBuilder) WithAfter(after []byte) ChangeBuilder {
+	app.after = after
+	return app
+}
+
+// Now builds a new Change instance
+func (app *changeBuilder) Now() (Change, error) {
+	if app.pSubject == nil {
+		return nil, errors.New("the subject is mandatory in order to build a Change instance")
+	}
+
+	if app.name == "" {
+		return nil, errors.New("the name is mandatory in order to build a Change instance")
+	}
+
+	if app.before != nil && len(app.before) <= 0 {
+		app.before = nil
+	}
+
+	if app.after != nil && len(app.after) <= 0 {
+		app.after = nil
+	}
+
+	if app.before == nil && app.after == nil {
+		return nil, errors.New("the before or after is mandatory in order to build a Change instance")
+	}
+
+	subject := *app.pSubject
+	if subject == SubjectLine && app.pIndex == nil {
+		str := fmt.Sprintf("the index is mandatory in order to build a Change instance whose subject is a %s", subject.String())
+		return nil, errors.New(str)
+	}
+
+	return createChange(
+		subject,
+		app.name,
+		app.pIndex,
+		app.before,
+		app.after,
+	), nil
+}
@@ -0,0 +1,233 @@
+package diffs
+
+import (
+	"bytes"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks"
+)
+
+type comparer struct {
+	builder       Builder
+	changeBuilder ChangeBuilder
+	renderer      Renderer
+}
+
+func createComparer(
+	builder Builder,
+	changeBuilder ChangeBuilder,
+	renderer Renderer,
+) Comparer {
+	out := comparer{
+		builder:       builder,
+		changeBuilder: changeBuilder,
+		renderer:      renderer,
+	}
+
+	return &out
+}
+
+// Compare compares two versions of a grammar
+func (app *comparer) Compare(from Grammar, to Grammar) (Diff, error) {
+	changes := []Change{}
+	retChange, err := app.change(SubjectRoot, SubjectRoot.String(), nil, app.renderer.ElementToBytes(from.Root()), app.renderer.ElementToBytes(to.Root()))
+	if err != nil {
+		return nil, err
+	}
+
+	changes = appendChange(changes, retChange)
+	retChange, err = app.change(SubjectOmissions, SubjectOmissions.String(), nil, app.omissionsToBytes(from), app.omissionsToBytes(to))
+	if err != nil {
+		return nil, err
+	}
+
+	changes = appendChange(changes, retChange)
+	retBlockChanges, err := app.blocks(from.Blocks(), to.Blocks())
+	if err != nil {
+		return nil, err
+	}
+
+	changes = append(changes, retBlockChanges...)
+	fromConstants := map[string][]byte{}
+	fromConstantNames := []string{}
+	if from.HasConstants() {
+		for _, oneConstant := range from.Constants().List() {
+			fromConstants[oneConstant.Name()] = app.renderer.ConstantToBytes(oneConstant)
+			fromConstantNames = append(fromConstantNames, oneConstant.Name())
+		}
+	}
+
+	toConstants := map[string][]byte{}
+	toConstantNames := []string{}
+	if to.HasConstants() {
+		for _, oneConstant := range to.Constants().List() {
+			toConstants[oneConstant.Name()] = app.renderer.ConstantToBytes(oneConstant)
+			toConstantNames = append(toConstantNames, oneConstant.Name())
+		}
+	}
+
+	retConstantChanges, err := app.named(SubjectConstant, fromConstantNames, fromConstants, toConstantNames, toConstants)
+	if err != nil {
+		return nil, err
+	}
+
+	changes = append(changes, retConstantChanges...)
+	fromRules := map[string][]byte{}
+	fromRuleNames := []string{}
+	for _, oneRule := range from.Rules().List() {
+		fromRules[oneRule.Name()] = app.renderer.RuleToBytes(oneRule)
+		fromRuleNames = append(fromRuleNames, oneRule.Name())
+	}
+
+	toRules := map[string][]byte{}
+	toRuleNames := []string{}
+	for _, oneRule := range to.Rules().List() {
+		toRules[oneRule.Name()] = app.renderer.RuleToBytes(oneRule)
+		toRuleNames = append(toRuleNames, oneRule.Name())
+	}
+
+	retRuleChanges, err := app.named(SubjectRule, fromRuleNames, fromRules, toRuleNames, toRules)
+	if err != nil {
+		return nil, err
+	}
+
+	changes = append(changes, retRuleChanges...)
+	return app.builder.Create().
+		WithList(changes).
+		Now()
+}
+
+// blocks compares the blocks line by line, the added and removed blocks containing all their lines
+func (app *comparer) blocks(from blocks.Blocks, to blocks.Blocks) ([]Change, error) {
+	// the blocks builder reverses its list, so the blocks are compared in reverse to follow their declaration order:
+	fromNames := []string{}
+	fromBlocks := map[string][]byte{}
+	fromList := from.List()
+	for i := len(fromList) - 1; i >= 0; i-- {
+		fromNames = append(fromNames, fromList[i].Name())
+		fromBlocks[fromList[i].Name()] = app.renderer.LinesToBytes(fromList[i].Lines())
+	}
+
+	toNames := []string{}
+	toBlocks := map[string][]byte{}
+	toList := to.List()
+	for i := len(toList) - 1; i >= 0; i-- {
+		toNames = append(toNames, toList[i].Name())
+		toBlocks[toList[i].Name()] = app.renderer.LinesToBytes(toList[i].Lines())
+	}
+
+	changes := []Change{}
+	for _, oneName := range fromNames {
+		toBlock, err := to.Fetch(oneName)
+		if err != nil {
+			retChange, err := app.change(SubjectBlock, oneName, nil, fromBlocks[oneName], nil)
+			if err != nil {
+				return nil, err
+			}
+
+			changes = appendChange(changes, retChange)
+			continue
+		}
+
+		fromBlock, err := from.Fetch(oneName)
+		if err != nil {
+			return nil, err
+		}
+
+		fromLines := fromBlock.Lines().List()
+		toLines := toBlock.Lines().List()
+		for idx := 0; idx < max(len(fromLines), len(toLines)); idx++ {
+			var before []byte
+			if idx < len(fromLines) {
+				before = app.renderer.LineToBytes(fromLines[idx])
+			}
+
+			var after []byte
+			if idx < len(toLines) {
+				after = app.renderer.LineToBytes(toLines[idx])
+			}
+
+			index := uint(idx)
+			retChange, err := app.change(SubjectLine, oneName, &index, before, after)
+			if err != nil {
+				return nil, err
+			}
+
+			changes = appendChange(changes, retChange)
+		}
+	}
+
+	for _, oneName := range toNames {
+		if _, ok := fromBlocks[oneName]; ok {
+			continue
+		}
+
+		retChange, err := app.change(SubjectBlock, oneName, nil, nil, toBlocks[oneName])
+		if err != nil {
+			return nil, err
+		}
+
+		changes = appendChange(changes, retChange)
+	}
+
+	return changes, nil
+}
+
+// named compares the values by name, the removed and changed ones in the order of the old names, then the added ones
+func (app *comparer) named(subject Subject, fromNames []string, from map[string][]byte, toNames []string, to map[string][]byte) ([]Change, error) {
+	changes := []Change{}
+	for _, oneName := range fromNames {
+		retChange, err := app.change(subject, oneName, nil, from[oneName], to[oneName])
+		if err != nil {
+			return nil, err
+		}
+
+		changes = appendChange(changes, retChange)
+	}
+
+	for _, oneName := range toNames {
+		if _, ok := from[oneName]; ok {
+			continue
+		}
+
+		retChange, err := app.change(subject, oneName, nil, nil, to[oneName])
+		if err != nil {
+			return nil, err
+		}
+
+		changes = appendChange(changes, retChange)
+	}
+
+	return changes, nil
+}
+
+// change builds the change, or returns nil if the before and after are the same
+func (app *comparer) change(subject Subject, name string, pIndex *uint, before []byte, after []byte) (Change, error) {
+	if bytes.Equal(before, after) {
+		return nil, nil
+	}
+
+	builder := app.changeBuilder.Create().
+		WithSubject(subject).
+		WithName(name).
+		WithBefore(before).
+		WithAfter(after)
+
+	if pIndex != nil {
+		builder.WithIndex(*pIndex)
+	}
+
+	return builder.Now()
+}
+
+func (app *comparer) omissionsToBytes(grammar Grammar) []byte {
+	if !grammar.HasOmissions() {
+		return nil
+	}
+
+	omissions := [][]byte{}
+	for _, oneOmission := range grammar.Omissions().List() {
+		omissions = append(omissions, app.renderer.ElementToBytes(oneOmission))
+	}
+
+	return bytes.Join(omissions, []byte(" "))
+}
@@ -0,0 +1,346 @@
+package diffs
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/cardinalities"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/constants"
+	constant_tokens "github.com/steve-care-software/grammars/domain/engine/grammars/constants/tokens"
+	constant_elements "github.com/steve-care-software/grammars/domain/engine/grammars/constants/tokens/elements"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/rules"
+)
+
+type testGrammar struct {
+	root      elements.Element
+	rules     rules.Rules
+	blocks    blocks.Blocks
+	omissions elements.Elements
+	constants constants.Constants
+}
+
+func (obj *testGrammar) Root() elements.Element {
+	return obj.root
+}
+
+func (obj *testGrammar) Rules() rules.Rules {
+	return obj.rules
+}
+
+func (obj *testGrammar) Blocks() blocks.Blocks {
+	return obj.blocks
+}
+
+func (obj *testGrammar) HasOmissions() bool {
+	return obj.omissions != nil
+}
+
+func (obj *testGrammar) Omissions() elements.Elements {
+	return obj.omissions
+}
+
+func (obj *testGrammar) HasConstants() bool {
+	return obj.constants != nil
+}
+
+func (obj *testGrammar) Constants() constants.Constants {
+	return obj.constants
+}
+
+type testRenderer struct {
+}
+
+func (app *testRenderer) ElementToBytes(element elements.Element) []byte {
+	return []byte("." + element.Name())
+}
+
+func (app *testRenderer) LineToBytes(line lines.Line) []byte {
+	list := [][]byte{}
+	for _, oneToken := range line.Tokens().List() {
+		list = append(list, app.ElementToBytes(oneToken.Element()))
+	}
+
+	return bytes.Join(list, []byte(" "))
+}
+
+func (app *testRenderer) LinesToBytes(lines lines.Lines) []byte {
+	list := [][]byte{}
+	for _, oneLine := range lines.List() {
+		list = append(list, app.LineToBytes(oneLine))
+	}
+
+	return bytes.Join(list, []byte(" | "))
+}
+
+func (app *testRenderer) ConstantToBytes(constant constants.Constant) []byte {
+	list := []string{}
+	for _, oneToken := range constant.Tokens().List() {
+		list = append(list, "."+oneToken.Element().Rule())
+	}
+
+	return []byte(strings.Join(list, " "))
+}
+
+func (app *testRenderer) RuleToBytes(rule rules.Rule) []byte {
+	return []byte(`"` + string(rule.Bytes()) + `"`)
+}
+
+func TestComparer_Success(t *testing.T) {
+	from, err := createTestGrammar(
+		"program",
+		[]string{"SPACE"},
+		[]string{"program", "name"},
+		map[string][][]string{
+			"program": {{"name"}, {"LL_A"}},
+			"name":    {{"LL_A"}, {"LL_B"}},
+		},
+		map[string][]string{
+			"_letters": {"LL_A", "LL_B"},
+		},
+		map[string]string{
+			"LL_A":  "a",
+			"LL_B":  "b",
+			"SPACE": " ",
+		},
+	)
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	to, err := createTestGrammar(
+		"main",
+		[]string{"SPACE", "TAB"},
+		[]string{"program", "name", "main"},
+		map[string][][]string{
+			"program": {{"name"}},
+			"name":    {{"LL_A"}, {"LL_A", "LL_B"}},
+			"main":    {{"program"}},
+		},
+		nil,
+		map[string]string{
+			"LL_A":  "a",
+			"LL_B":  "B",
+			"SPACE": " ",
+			"TAB":   "-",
+		},
+	)
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	comparer := NewComparer(&testRenderer{})
+	retDiff, err := comparer.Compare(from, to)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := []string{
+		"~ root root: .program -> .main",
+		"~ omissions omissions: .SPACE -> .SPACE .TAB",
+		"- line program[1]: .LL_A",
+		"~ line name[1]: .LL_B -> .LL_A .LL_B",
+		"+ block main: .program",
+		"- constant _letters: .LL_A .LL_B",
+		"~ rule LL_B: \"b\" -> \"B\"",
+		"+ rule TAB: \"-\"",
+		"",
+	}
+
+	retText := NewAdapter().ToText(retDiff)
+	if string(retText) != strings.Join(expected, "\n") {
+		t.Errorf("the diff was expected to be:\n%s\nreturned:\n%s", strings.Join(expected, "\n"), retText)
+		return
+	}
+
+	retDiff, err = comparer.Compare(to, from)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	list := retDiff.List()
+	if len(list) != len(expected)-1 {
+		t.Errorf("the reversed diff was expected to contain %d changes, %d returned", len(expected)-1, len(list))
+		return
+	}
+
+	if !list[4].IsRemoved() || list[4].Subject() != SubjectBlock || list[4].Name() != "main" {
+		t.Errorf("the reversed diff was expected to remove the main block")
+		return
+	}
+
+	retDiff, err = comparer.Compare(from, from)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !retDiff.IsEmpty() {
+		t.Errorf("the diff of a grammar with itself was expected to be empty")
+		return
+	}
+}
+
+func createTestGrammar(
+	root string,
+	omissions []string,
+	blockNames []string,
+	blockLines map[string][][]string,
+	constantTokens map[string][]string,
+	ruleValues map[string]string,
+) (Grammar, error) {
+	rootElement, err := createTestElement(root)
+	if err != nil {
+		return nil, err
+	}
+
+	omissionsList := []elements.Element{}
+	for _, oneName := range omissions {
+		retElement, err := createTestElement(oneName)
+		if err != nil {
+			return nil, err
+		}
+
+		omissionsList = append(omissionsList, retElement)
+	}
+
+	retOmissions, err := elements.NewBuilder().Create().WithList(omissionsList).Now()
+	if err != nil {
+		return nil, err
+	}
+
+	blocksList := []blocks.Block{}
+	for _, oneName := range blockNames {
+		linesList := []lines.Line{}
+		for _, oneLine := range blockLines[oneName] {
+			tokensList := []tokens.Token{}
+			for _, oneElementName := range oneLine {
+				retElement, err := createTestElement(oneElementName)
+				if err != nil {
+					return nil, err
+				}
+
+				retCardinality, err := cardinalities.NewBuilder().Create().WithMin(1).WithMax(1).Now()
+				if err != nil {
+					return nil, err
+				}
+
+				retToken, err := tokens.NewTokenBuilder().Create().WithElement(retElement).WithCardinality(retCardinality).Now()
+				if err != nil {
+					return nil, err
+				}
+
+				tokensList = append(tokensList, retToken)
+			}
+
+			retTokens, err := tokens.NewBuilder().Create().WithList(tokensList).Now()
+			if err != nil {
+				return nil, err
+			}
+
+			retLine, err := lines.NewLineBuilder().Create().WithTokens(retTokens).Now()
+			if err != nil {
+				return nil, err
+			}
+
+			linesList = append(linesList, retLine)
+		}
+
+		retLines, err := lines.NewBuilder().Create().WithList(linesList).Now()
+		if err != nil {
+			return nil, err
+		}
+
+		retBlock, err := blocks.NewBlockBuilder().Create().WithName(oneName).WithLines(retLines).Now()
+		if err != nil {
+			return nil, err
+		}
+
+		blocksList = append(blocksList, retBlock)
+	}
+
+	retBlocks, err := blocks.NewBuilder().Create().WithList(blocksList).Now()
+	if err != nil {
+		return nil, err
+	}
+
+	var retConstants constants.Constants
+	if len(constantTokens) > 0 {
+		constantsList := []constants.Constant{}
+		for oneName, oneRules := range constantTokens {
+			tokensList := []constant_tokens.Token{}
+			for _, oneRule := range oneRules {
+				retElement, err := constant_elements.NewBuilder().Create().WithRule(oneRule).Now()
+				if err != nil {
+					return nil, err
+				}
+
+				retToken, err := constant_tokens.NewTokenBuilder().Create().WithElement(retElement).WithAmount(1).Now()
+				if err != nil {
+					return nil, err
+				}
+
+				tokensList = append(tokensList, retToken)
+			}
+
+			retTokens, err := constant_tokens.NewBuilder().Create().WithList(tokensList).Now()
+			if err != nil {
+				return nil, err
+			}
+
+			retConstant, err := constants.NewConstantBuilder().Create().WithName(oneName).WithTokens(retTokens).Now()
+			if err != nil {
+				return nil, err
+			}
+
+			constantsList = append(constantsList, retConstant)
+		}
+
+		retConstants, err = constants.NewBuilder().Create().WithList(constantsList).Now()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rulesList := []rules.Rule{}
+	for oneName, oneValue := range ruleValues {
+		retRule, err := rules.NewRuleBuilder().Create().WithName(oneName).WithBytes([]byte(oneValue)).Now()
+		if err != nil {
+			return nil, err
+		}
+
+		rulesList = append(rulesList, retRule)
+	}
+
+	retRules, err := rules.NewBuilder().Create().WithList(rulesList).Now()
+	if err != nil {
+		return nil, err
+	}
+
+	return &testGrammar{
+		root:      rootElement,
+		rules:     retRules,
+		blocks:    retBlocks,
+		omissions: retOmissions,
+		constants: retConstants,
+	}, nil
+}
+
+func createTestElement(name string) (elements.Element, error) {
+	builder := elements.NewElementBuilder().Create()
+	if strings.ToUpper(name) == name {
+		return builder.WithRule(name).Now()
+	}
+
+	return builder.WithBlock(name).Now()
+}
@@ -0,0 +1,9 @@
+package diffs
+
+func appendChange(changes []Change, change Change) []Change {
+	if change == nil {
+		return changes
+	}
+
+	return append(changes, change)
+}
@@ -0,0 +1,132 @@
+package diffs
+
+import (
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/constants"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/rules"
+)
+
+// Subject represents what a change is about
+type Subject uint8
+
+const (
+	// SubjectRoot represents the root element of the grammar
+	SubjectRoot Subject = iota
+
+	// SubjectOmissions represents the omitted elements of the grammar
+	SubjectOmissions
+
+	// SubjectBlock represents a block
+	SubjectBlock
+
+	// SubjectLine represents a line of a block
+	SubjectLine
+
+	// SubjectConstant represents a constant
+	SubjectConstant
+
+	// SubjectRule represents a rule
+	SubjectRule
+)
+
+// NewBuilder creates a new builder
+func NewBuilder() Builder {
+	return createBuilder()
+}
+
+// NewChangeBuilder creates a new change builder
+func NewChangeBuilder() ChangeBuilder {
+	return createChangeBuilder()
+}
+
+// NewAdapter creates a new adapter
+func NewAdapter() Adapter {
+	return createAdapter()
+}
+
+// NewComparer creates a new comparer that uses the renderer to compare the grammars
+func NewComparer(renderer Renderer) Comparer {
+	builder := NewBuilder()
+	changeBuilder := NewChangeBuilder()
+	return createComparer(
+		builder,
+		changeBuilder,
+		renderer,
+	)
+}
+
+// Comparer compares two versions of a grammar
+type Comparer interface {
+	// Compare returns the root, omissions, blocks, lines, constants and rules that are added, removed or changed
+	Compare(from Grammar, to Grammar) (Diff, error)
+}
+
+// Renderer renders the parts of a grammar to their text representation
+type Renderer interface {
+	ElementToBytes(element elements.Element) []byte
+	LineToBytes(line lines.Line) []byte
+	LinesToBytes(lines lines.Lines) []byte
+	ConstantToBytes(constant constants.Constant) []byte
+	RuleToBytes(rule rules.Rule) []byte
+}
+
+// Grammar represents the grammar to compare
+type Grammar interface {
+	Root() elements.Element
+	Rules() rules.Rules
+	Blocks() blocks.Blocks
+	HasOmissions() bool
+	Omissions() elements.Elements
+	HasConstants() bool
+	Constants() constants.Constants
+}
+
+// Adapter renders the grammar diffs
+type Adapter interface {
+	// ToText renders the diff as text, one change per line
+	ToText(diff Diff) []byte
+
+	// ToJSON renders the diff as JSON
+	ToJSON(diff Diff) ([]byte, error)
+}
+
+// Builder represents the diff builder
+type Builder interface {
+	Create() Builder
+	WithList(list []Change) Builder
+	Now() (Diff, error)
+}
+
+// Diff represents the changes between two versions of a grammar
+type Diff interface {
+	List() []Change
+	IsEmpty() bool
+}
+
+// ChangeBuilder represents the change builder, a change without before is added and a change without after is removed
+type ChangeBuilder interface {
+	Create() ChangeBuilder
+	WithSubject(subject Subject) ChangeBuilder
+	WithName(name string) ChangeBuilder
+	WithIndex(index uint) ChangeBuilder
+	WithBefore(before []byte) ChangeBuilder
+	WithAfter(after []byte) ChangeBuilder
+	Now() (Change, error)
+}
+
+// Change represents a change, whose before and after contain the text representation of its subject
+type Change interface {
+	Subject() Subject
+	Name() string
+	HasIndex() bool
+	Index() uint
+	IsAdded() bool
+	IsRemoved() bool
+	IsChanged() bool
+	HasBefore() bool
+	Before() []byte
+	HasAfter() bool
+	After() []byte
+}
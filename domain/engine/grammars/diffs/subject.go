@@ -0,0 +1,21 @@
+package diffs
+
+// String returns the name of the subject
+func (obj Subject) String() string {
+	switch obj {
+	case SubjectRoot:
+		return "root"
+	case SubjectOmissions:
+		return "omissions"
+	case SubjectBlock:
+		return "block"
+	case SubjectLine:
+		return "line"
+	case SubjectConstant:
+		return "constant"
+	case SubjectRule:
+		return "rule"
+	}
+
+	return "unknown"
+}
@@ -5,10 +5,11 @@ import (
 	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements"
 	"github.com/steve-care-software/grammars/domain/engine/grammars/constants"
 	"github.com/steve-care-software/grammars/domain/engine/grammars/rules"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
 )
 
 type grammar struct {
-	version   uint
+	version   versions.Version
 	root      elements.Element
 	rules     rules.Rules
 	blocks    blocks.Blocks
@@ -17,7 +18,7 @@ type grammar struct {
 }
 
 func createGrammar(
-	version uint,
+	version versions.Version,
 	root elements.Element,
 	rules rules.Rules,
 	blocks blocks.Blocks,
@@ -26,7 +27,7 @@ func createGrammar(
 }
 
 func createGrammarWithOmissions(
-	version uint,
+	version versions.Version,
 	root elements.Element,
 	rules rules.Rules,
 	blocks blocks.Blocks,
@@ -36,7 +37,7 @@ func createGrammarWithOmissions(
 }
 
 func createGrammarWithConstants(
-	version uint,
+	version versions.Version,
 	root elements.Element,
 	rules rules.Rules,
 	blocks blocks.Blocks,
@@ -46,7 +47,7 @@ func createGrammarWithConstants(
 }
 
 func createGrammarWithOmissionsAndConstants(
-	version uint,
+	version versions.Version,
 	root elements.Element,
 	rules rules.Rules,
 	blocks blocks.Blocks,
@@ -57,7 +58,7 @@ func createGrammarWithOmissionsAndConstants(
 }
 
 func createGrammarInternally(
-	version uint,
+	version versions.Version,
 	root elements.Element,
 	rules rules.Rules,
 	blocks blocks.Blocks,
@@ -77,7 +78,7 @@ func createGrammarInternally(
 }
 
 // Version returns the version
-func (obj *grammar) Version() uint {
+func (obj *grammar) Version() versions.Version {
 	return obj.version
 }
 
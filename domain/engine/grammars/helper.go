@@ -4,7 +4,11 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
 )
 
 func blockName(
@@ -431,3 +435,56 @@ func createPossibleNumbers() []byte {
 		[]byte(nNine)[0],
 	}
 }
+
+func splitPath(path string) []string {
+	output := []string{}
+	for _, oneSegment := range strings.Split(path, referencePathSeparator) {
+		if oneSegment == "" {
+			continue
+		}
+
+		output = append(output, oneSegment)
+	}
+
+	return output
+}
+
+func grammarVersions(grammars map[string]Grammar) []versions.Version {
+	output := []versions.Version{}
+	for _, oneGrammar := range grammars {
+		output = append(output, oneGrammar.Version())
+	}
+
+	return sortVersions(output)
+}
+
+func isFileName(name string) bool {
+	return strings.HasPrefix(name, repositoryFileVersionPrefix) && strings.HasSuffix(name, repositoryFileExtension)
+}
+
+func fileNameToVersion(adapter versions.Adapter, name string) (versions.Version, error) {
+	if !isFileName(name) {
+		str := fmt.Sprintf("the file name (%s) was expected to be prefixed by (%s) and suffixed by (%s)", name, repositoryFileVersionPrefix, repositoryFileExtension)
+		return nil, errors.New(str)
+	}
+
+	number := strings.TrimSuffix(strings.TrimPrefix(name, repositoryFileVersionPrefix), repositoryFileExtension)
+	return adapter.ToVersion([]byte(number))
+}
+
+func versionToFileName(version versions.Version) string {
+	return fmt.Sprintf("%s%s%s", repositoryFileVersionPrefix, version.String(), repositoryFileExtension)
+}
+
+func inexactDeleteError(path string, constraint versions.Constraint) error {
+	str := fmt.Sprintf("the version (%s) of the grammar to delete at the provided path (%s) was expected to be exact, such as 1.2.3", constraint.String(), path)
+	return errors.New(str)
+}
+
+func sortVersions(list []versions.Version) []versions.Version {
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Compare(list[j]) < 0
+	})
+
+	return list
+}
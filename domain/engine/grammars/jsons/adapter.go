@@ -0,0 +1,908 @@
+package jsons
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/balances"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/balances/selectors"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/balances/selectors/chains"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/cardinalities"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements/references"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/reverses"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/uniques"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/suites"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/constants"
+	constant_tokens "github.com/steve-care-software/grammars/domain/engine/grammars/constants/tokens"
+	constant_elements "github.com/steve-care-software/grammars/domain/engine/grammars/constants/tokens/elements"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/rules"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+)
+
+type jsonGrammar struct {
+	Schema    uint           `json:"schema"`
+	Version   string         `json:"version"`
+	Root      jsonElement    `json:"root"`
+	Omissions []jsonElement  `json:"omissions,omitempty"`
+	Blocks    []jsonBlock    `json:"blocks"`
+	Constants []jsonConstant `json:"constants,omitempty"`
+	Rules     []jsonRule     `json:"rules"`
+}
+
+type jsonElement struct {
+	Rule      string         `json:"rule,omitempty"`
+	Block     string         `json:"block,omitempty"`
+	Constant  string         `json:"constant,omitempty"`
+	Reference *jsonReference `json:"reference,omitempty"`
+}
+
+type jsonReference struct {
+	Path       []string `json:"path"`
+	Name       string   `json:"name"`
+	Constraint string   `json:"constraint"`
+}
+
+type jsonBlock struct {
+	Name   string      `json:"name"`
+	Lines  []jsonLine  `json:"lines"`
+	Suites []jsonSuite `json:"suites,omitempty"`
+}
+
+type jsonLine struct {
+	Tokens  []jsonToken      `json:"tokens"`
+	Balance [][]jsonSelector `json:"balance,omitempty"`
+}
+
+type jsonToken struct {
+	Element     jsonElement     `json:"element"`
+	Cardinality jsonCardinality `json:"cardinality"`
+	Reverse     *jsonReverse    `json:"reverse,omitempty"`
+	Unique      *jsonUnique     `json:"unique,omitempty"`
+}
+
+type jsonCardinality struct {
+	Min uint  `json:"min"`
+	Max *uint `json:"max,omitempty"`
+}
+
+type jsonReverse struct {
+	Escape *jsonElement `json:"escape,omitempty"`
+}
+
+type jsonUnique struct {
+	Mode    string      `json:"mode"`
+	Element jsonElement `json:"element"`
+	Index   uint        `json:"index"`
+}
+
+type jsonSelector struct {
+	Not   bool      `json:"not,omitempty"`
+	Chain jsonChain `json:"chain"`
+}
+
+type jsonChain struct {
+	Element jsonElement     `json:"element"`
+	Token   *jsonChainToken `json:"token,omitempty"`
+}
+
+type jsonChainToken struct {
+	Index   uint              `json:"index"`
+	Element *jsonChainElement `json:"element,omitempty"`
+}
+
+type jsonChainElement struct {
+	Index uint       `json:"index"`
+	Chain *jsonChain `json:"chain,omitempty"`
+}
+
+type jsonSuite struct {
+	Name     string `json:"name"`
+	Input    []byte `json:"input,omitempty"`
+	Fail     bool   `json:"fail,omitempty"`
+	Expected []byte `json:"expected,omitempty"`
+	Golden   string `json:"golden,omitempty"`
+}
+
+type jsonConstant struct {
+	Name   string              `json:"name"`
+	Tokens []jsonConstantToken `json:"tokens"`
+}
+
+type jsonConstantToken struct {
+	Element jsonConstantElement `json:"element"`
+	Amount  uint                `json:"amount"`
+}
+
+type jsonConstantElement struct {
+	Rule     string `json:"rule,omitempty"`
+	Constant string `json:"constant,omitempty"`
+}
+
+type jsonRule struct {
+	Name  string `json:"name"`
+	Value []byte `json:"value"`
+}
+
+type adapter struct {
+	grammarBuilder         grammars.Builder
+	constantsBuilder       constants.Builder
+	constantBuilder        constants.ConstantBuilder
+	constantTokensBuilder  constant_tokens.Builder
+	constantTokenBuilder   constant_tokens.TokenBuilder
+	constantElementBuilder constant_elements.Builder
+	blocksBuilder          blocks.Builder
+	blockBuilder           blocks.BlockBuilder
+	suitesBuilder          suites.Builder
+	suiteBuilder           suites.SuiteBuilder
+	linesBuilder           lines.Builder
+	lineBuilder            lines.LineBuilder
+	balanceBuilder         balances.Builder
+	selectorsBuilder       selectors.Builder
+	selectorBuilder        selectors.SelectorBuilder
+	chainBuilder           chains.Builder
+	chainTokenBuilder      chains.TokenBuilder
+	chainElementBuilder    chains.ElementBuilder
+	tokensBuilder          tokens.Builder
+	tokenBuilder           tokens.TokenBuilder
+	cardinalityBuilder     cardinalities.Builder
+	reverseBuilder         reverses.Builder
+	uniqueBuilder          uniques.Builder
+	elementsBuilder        elements.Builder
+	elementBuilder         elements.ElementBuilder
+	referenceBuilder       references.Builder
+	rulesBuilder           rules.Builder
+	ruleBuilder            rules.RuleBuilder
+	versionAdapter         versions.Adapter
+}
+
+func createAdapter(
+	grammarBuilder grammars.Builder,
+	constantsBuilder constants.Builder,
+	constantBuilder constants.ConstantBuilder,
+	constantTokensBuilder constant_tokens.Builder,
+	constantTokenBuilder constant_tokens.TokenBuilder,
+	constantElementBuilder constant_elements.Builder,
+	blocksBuilder blocks.Builder,
+	blockBuilder blocks.BlockBuilder,
+	suitesBuilder suites.Builder,
+	suiteBuilder suites.SuiteBuilder,
+	linesBuilder lines.Builder,
+	lineBuilder lines.LineBuilder,
+	balanceBuilder balances.Builder,
+	selectorsBuilder selectors.Builder,
+	selectorBuilder selectors.SelectorBuilder,
+	chainBuilder chains.Builder,
+	chainTokenBuilder chains.TokenBuilder,
+	chainElementBuilder chains.ElementBuilder,
+	tokensBuilder tokens.Builder,
+	tokenBuilder tokens.TokenBuilder,
+	cardinalityBuilder cardinalities.Builder,
+	reverseBuilder reverses.Builder,
+	uniqueBuilder uniques.Builder,
+	elementsBuilder elements.Builder,
+	elementBuilder elements.ElementBuilder,
+	referenceBuilder references.Builder,
+	rulesBuilder rules.Builder,
+	ruleBuilder rules.RuleBuilder,
+	versionAdapter versions.Adapter,
+) Adapter {
+	out := adapter{
+		grammarBuilder:         grammarBuilder,
+		constantsBuilder:       constantsBuilder,
+		constantBuilder:        constantBuilder,
+		constantTokensBuilder:  constantTokensBuilder,
+		constantTokenBuilder:   constantTokenBuilder,
+		constantElementBuilder: constantElementBuilder,
+		blocksBuilder:          blocksBuilder,
+		blockBuilder:           blockBuilder,
+		suitesBuilder:          suitesBuilder,
+		suiteBuilder:           suiteBuilder,
+		linesBuilder:           linesBuilder,
+		lineBuilder:            lineBuilder,
+		balanceBuilder:         balanceBuilder,
+		selectorsBuilder:       selectorsBuilder,
+		selectorBuilder:        selectorBuilder,
+		chainBuilder:           chainBuilder,
+		chainTokenBuilder:      chainTokenBuilder,
+		chainElementBuilder:    chainElementBuilder,
+		tokensBuilder:          tokensBuilder,
+		tokenBuilder:           tokenBuilder,
+		cardinalityBuilder:     cardinalityBuilder,
+		reverseBuilder:         reverseBuilder,
+		uniqueBuilder:          uniqueBuilder,
+		elementsBuilder:        elementsBuilder,
+		elementBuilder:         elementBuilder,
+		referenceBuilder:       referenceBuilder,
+		rulesBuilder:           rulesBuilder,
+		ruleBuilder:            ruleBuilder,
+		versionAdapter:         versionAdapter,
+	}
+
+	return &out
+}
+
+// ToJSON converts a grammar to its JSON representation
+func (app *adapter) ToJSON(grammar grammars.Grammar) ([]byte, error) {
+	output := jsonGrammar{
+		Schema:  SchemaVersion,
+		Version: grammar.Version().String(),
+		Root:    toJSONElement(grammar.Root()),
+		Blocks:  []jsonBlock{},
+		Rules:   []jsonRule{},
+	}
+
+	if grammar.HasOmissions() {
+		for _, oneOmission := range grammar.Omissions().List() {
+			output.Omissions = append(output.Omissions, toJSONElement(oneOmission))
+		}
+	}
+
+	// the blocks builder reverses its list, so the blocks are written in their declaration order:
+	blocksList := grammar.Blocks().List()
+	for i := len(blocksList) - 1; i >= 0; i-- {
+		output.Blocks = append(output.Blocks, toJSONBlock(blocksList[i]))
+	}
+
+	if grammar.HasConstants() {
+		for _, oneConstant := range grammar.Constants().List() {
+			constant := jsonConstant{
+				Name:   oneConstant.Name(),
+				Tokens: []jsonConstantToken{},
+			}
+
+			for _, oneToken := range oneConstant.Tokens().List() {
+				element := oneToken.Element()
+				token := jsonConstantToken{
+					Amount: oneToken.Amount(),
+				}
+
+				if element.IsRule() {
+					token.Element.Rule = element.Rule()
+				}
+
+				if element.IsConstant() {
+					token.Element.Constant = element.Constant()
+				}
+
+				constant.Tokens = append(constant.Tokens, token)
+			}
+
+			output.Constants = append(output.Constants, constant)
+		}
+	}
+
+	for _, oneRule := range grammar.Rules().List() {
+		output.Rules = append(output.Rules, jsonRule{
+			Name:  oneRule.Name(),
+			Value: oneRule.Bytes(),
+		})
+	}
+
+	return json.MarshalIndent(output, "", "\t")
+}
+
+// ToGrammar converts a JSON representation to a grammar
+func (app *adapter) ToGrammar(input []byte) (grammars.Grammar, error) {
+	decoded := jsonGrammar{}
+	err := json.Unmarshal(input, &decoded)
+	if err != nil {
+		return nil, err
+	}
+
+	if decoded.Schema != SchemaVersion {
+		str := fmt.Sprintf("the schema version (%d) is not supported, %d expected", decoded.Schema, SchemaVersion)
+		return nil, errors.New(str)
+	}
+
+	version, err := app.versionAdapter.ToVersion([]byte(decoded.Version))
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := app.toElement(decoded.Root)
+	if err != nil {
+		return nil, err
+	}
+
+	blocksList := []blocks.Block{}
+	for _, oneBlock := range decoded.Blocks {
+		block, err := app.toBlock(oneBlock)
+		if err != nil {
+			return nil, err
+		}
+
+		blocksList = append(blocksList, block)
+	}
+
+	blocksIns, err := app.blocksBuilder.Create().
+		WithList(blocksList).
+		Now()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rulesList := []rules.Rule{}
+	for _, oneRule := range decoded.Rules {
+		rule, err := app.ruleBuilder.Create().
+			WithName(oneRule.Name).
+			WithBytes(oneRule.Value).
+			Now()
+
+		if err != nil {
+			return nil, err
+		}
+
+		rulesList = append(rulesList, rule)
+	}
+
+	rulesIns, err := app.rulesBuilder.Create().
+		WithList(rulesList).
+		Now()
+
+	if err != nil {
+		return nil, err
+	}
+
+	builder := app.grammarBuilder.Create().
+		WithVersion(version).
+		WithRoot(root).
+		WithBlocks(blocksIns).
+		WithRules(rulesIns)
+
+	if len(decoded.Omissions) > 0 {
+		omissions, err := app.toElements(decoded.Omissions)
+		if err != nil {
+			return nil, err
+		}
+
+		builder.WithOmissions(omissions)
+	}
+
+	if len(decoded.Constants) > 0 {
+		constantsIns, err := app.toConstants(decoded.Constants)
+		if err != nil {
+			return nil, err
+		}
+
+		builder.WithConstants(constantsIns)
+	}
+
+	return builder.Now()
+}
+
+func (app *adapter) toConstants(list []jsonConstant) (constants.Constants, error) {
+	constantsList := []constants.Constant{}
+	for _, oneConstant := range list {
+		tokensList := []constant_tokens.Token{}
+		for _, oneToken := range oneConstant.Tokens {
+			elementBuilder := app.constantElementBuilder.Create()
+			if oneToken.Element.Rule != "" {
+				elementBuilder.WithRule(oneToken.Element.Rule)
+			}
+
+			if oneToken.Element.Constant != "" {
+				elementBuilder.WithConstant(oneToken.Element.Constant)
+			}
+
+			if oneToken.Element.Rule != "" && oneToken.Element.Constant != "" {
+				str := fmt.Sprintf("the constant (%s) contains an element that is both a rule and a constant", oneConstant.Name)
+				return nil, errors.New(str)
+			}
+
+			element, err := elementBuilder.Now()
+			if err != nil {
+				return nil, err
+			}
+
+			token, err := app.constantTokenBuilder.Create().
+				WithElement(element).
+				WithAmount(oneToken.Amount).
+				Now()
+
+			if err != nil {
+				return nil, err
+			}
+
+			tokensList = append(tokensList, token)
+		}
+
+		tokensIns, err := app.constantTokensBuilder.Create().
+			WithList(tokensList).
+			Now()
+
+		if err != nil {
+			return nil, err
+		}
+
+		constant, err := app.constantBuilder.Create().
+			WithName(oneConstant.Name).
+			WithTokens(tokensIns).
+			Now()
+
+		if err != nil {
+			return nil, err
+		}
+
+		constantsList = append(constantsList, constant)
+	}
+
+	return app.constantsBuilder.Create().
+		WithList(constantsList).
+		Now()
+}
+
+func (app *adapter) toBlock(input jsonBlock) (blocks.Block, error) {
+	linesList := []lines.Line{}
+	for _, oneLine := range input.Lines {
+		line, err := app.toLine(oneLine)
+		if err != nil {
+			return nil, err
+		}
+
+		linesList = append(linesList, line)
+	}
+
+	linesIns, err := app.linesBuilder.Create().
+		WithList(linesList).
+		Now()
+
+	if err != nil {
+		return nil, err
+	}
+
+	builder := app.blockBuilder.Create().
+		WithName(input.Name).
+		WithLines(linesIns)
+
+	if len(input.Suites) > 0 {
+		suitesList := []suites.Suite{}
+		for _, oneSuite := range input.Suites {
+			suiteBuilder := app.suiteBuilder.Create().
+				WithName(oneSuite.Name).
+				WithInput(oneSuite.Input).
+				WithExpected(oneSuite.Expected).
+				WithGolden(oneSuite.Golden)
+
+			if oneSuite.Fail {
+				suiteBuilder.IsFail()
+			}
+
+			suite, err := suiteBuilder.Now()
+			if err != nil {
+				return nil, err
+			}
+
+			suitesList = append(suitesList, suite)
+		}
+
+		suitesIns, err := app.suitesBuilder.Create().
+			WithList(suitesList).
+			Now()
+
+		if err != nil {
+			return nil, err
+		}
+
+		builder.WithSuites(suitesIns)
+	}
+
+	return builder.Now()
+}
+
+func (app *adapter) toLine(input jsonLine) (lines.Line, error) {
+	tokensList := []tokens.Token{}
+	for _, oneToken := range input.Tokens {
+		token, err := app.toToken(oneToken)
+		if err != nil {
+			return nil, err
+		}
+
+		tokensList = append(tokensList, token)
+	}
+
+	tokensIns, err := app.tokensBuilder.Create().
+		WithList(tokensList).
+		Now()
+
+	if err != nil {
+		return nil, err
+	}
+
+	builder := app.lineBuilder.Create().
+		WithTokens(tokensIns)
+
+	if len(input.Balance) > 0 {
+		balanceLines := []selectors.Selectors{}
+		for _, oneBalanceLine := range input.Balance {
+			selectorsList := []selectors.Selector{}
+			for _, oneSelector := range oneBalanceLine {
+				chain, err := app.toChain(oneSelector.Chain)
+				if err != nil {
+					return nil, err
+				}
+
+				selectorBuilder := app.selectorBuilder.Create().
+					WithChain(chain)
+
+				if oneSelector.Not {
+					selectorBuilder.IsNot()
+				}
+
+				selector, err := selectorBuilder.Now()
+				if err != nil {
+					return nil, err
+				}
+
+				selectorsList = append(selectorsList, selector)
+			}
+
+			selectorsIns, err := app.selectorsBuilder.Create().
+				WithList(selectorsList).
+				Now()
+
+			if err != nil {
+				return nil, err
+			}
+
+			balanceLines = append(balanceLines, selectorsIns)
+		}
+
+		balance, err := app.balanceBuilder.Create().
+			WithLines(balanceLines).
+			Now()
+
+		if err != nil {
+			return nil, err
+		}
+
+		builder.WithBalance(balance)
+	}
+
+	return builder.Now()
+}
+
+func (app *adapter) toChain(input jsonChain) (chains.Chain, error) {
+	element, err := app.toElement(input.Element)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := app.chainBuilder.Create().
+		WithElement(element)
+
+	if input.Token != nil {
+		tokenBuilder := app.chainTokenBuilder.Create().
+			WithIndex(input.Token.Index)
+
+		if input.Token.Element != nil {
+			elementBuilder := app.chainElementBuilder.Create().
+				WithIndex(input.Token.Element.Index)
+
+			if input.Token.Element.Chain != nil {
+				subChain, err := app.toChain(*input.Token.Element.Chain)
+				if err != nil {
+					return nil, err
+				}
+
+				elementBuilder.WithChain(subChain)
+			}
+
+			chainElement, err := elementBuilder.Now()
+			if err != nil {
+				return nil, err
+			}
+
+			tokenBuilder.WithElement(chainElement)
+		}
+
+		token, err := tokenBuilder.Now()
+		if err != nil {
+			return nil, err
+		}
+
+		builder.WithToken(token)
+	}
+
+	return builder.Now()
+}
+
+func (app *adapter) toToken(input jsonToken) (tokens.Token, error) {
+	element, err := app.toElement(input.Element)
+	if err != nil {
+		return nil, err
+	}
+
+	cardinalityBuilder := app.cardinalityBuilder.Create().
+		WithMin(input.Cardinality.Min)
+
+	if input.Cardinality.Max != nil {
+		if *input.Cardinality.Max < input.Cardinality.Min {
+			str := fmt.Sprintf("the cardinality of the token (%s) contains a max (%d) that is smaller than its min (%d)", element.Name(), *input.Cardinality.Max, input.Cardinality.Min)
+			return nil, errors.New(str)
+		}
+
+		cardinalityBuilder.WithMax(*input.Cardinality.Max)
+	}
+
+	cardinality, err := cardinalityBuilder.Now()
+	if err != nil {
+		return nil, err
+	}
+
+	builder := app.tokenBuilder.Create().
+		WithElement(element).
+		WithCardinality(cardinality)
+
+	if input.Reverse != nil {
+		reverseBuilder := app.reverseBuilder.Create()
+		if input.Reverse.Escape != nil {
+			escape, err := app.toElement(*input.Reverse.Escape)
+			if err != nil {
+				return nil, err
+			}
+
+			reverseBuilder.WithEscape(escape)
+		}
+
+		reverse, err := reverseBuilder.Now()
+		if err != nil {
+			return nil, err
+		}
+
+		builder.WithReverse(reverse)
+	}
+
+	if input.Unique != nil {
+		uniqueElement, err := app.toElement(input.Unique.Element)
+		if err != nil {
+			return nil, err
+		}
+
+		uniqueBuilder := app.uniqueBuilder.Create().
+			WithElement(uniqueElement).
+			WithIndex(input.Unique.Index)
+
+		switch input.Unique.Mode {
+		case uniqueModeMustBe:
+			uniqueBuilder.MustBe()
+		case uniqueModeMustNot:
+			uniqueBuilder.MustNot()
+		default:
+			str := fmt.Sprintf("the unique mode (%s) of the token (%s) is invalid, %s or %s expected", input.Unique.Mode, element.Name(), uniqueModeMustBe, uniqueModeMustNot)
+			return nil, errors.New(str)
+		}
+
+		unique, err := uniqueBuilder.Now()
+		if err != nil {
+			return nil, err
+		}
+
+		builder.WithUnique(unique)
+	}
+
+	return builder.Now()
+}
+
+func (app *adapter) toElements(list []jsonElement) (elements.Elements, error) {
+	elementsList := []elements.Element{}
+	for _, oneElement := range list {
+		element, err := app.toElement(oneElement)
+		if err != nil {
+			return nil, err
+		}
+
+		elementsList = append(elementsList, element)
+	}
+
+	return app.elementsBuilder.Create().
+		WithList(elementsList).
+		Now()
+}
+
+func (app *adapter) toElement(input jsonElement) (elements.Element, error) {
+	amount := 0
+	builder := app.elementBuilder.Create()
+	if input.Rule != "" {
+		builder.WithRule(input.Rule)
+		amount++
+	}
+
+	if input.Block != "" {
+		builder.WithBlock(input.Block)
+		amount++
+	}
+
+	if input.Constant != "" {
+		builder.WithConstant(input.Constant)
+		amount++
+	}
+
+	if input.Reference != nil {
+		constraint, err := app.versionAdapter.ToConstraint([]byte(input.Reference.Constraint))
+		if err != nil {
+			return nil, err
+		}
+
+		reference, err := app.referenceBuilder.Create().
+			WithPath(input.Reference.Path).
+			WithName(input.Reference.Name).
+			WithConstraint(constraint).
+			Now()
+
+		if err != nil {
+			return nil, err
+		}
+
+		builder.WithReference(reference)
+		amount++
+	}
+
+	if amount != 1 {
+		str := fmt.Sprintf("the element was expected to contain exactly 1 of a rule, a block, a constant or a reference, %d provided", amount)
+		return nil, errors.New(str)
+	}
+
+	return builder.Now()
+}
+
+func toJSONBlock(block blocks.Block) jsonBlock {
+	output := jsonBlock{
+		Name:  block.Name(),
+		Lines: []jsonLine{},
+	}
+
+	for _, oneLine := range block.Lines().List() {
+		line := jsonLine{
+			Tokens: []jsonToken{},
+		}
+
+		for _, oneToken := range oneLine.Tokens().List() {
+			line.Tokens = append(line.Tokens, toJSONToken(oneToken))
+		}
+
+		if oneLine.HasBalance() {
+			for _, oneSelectors := range oneLine.Balance().Lines() {
+				selectorsList := []jsonSelector{}
+				for _, oneSelector := range oneSelectors.List() {
+					selectorsList = append(selectorsList, jsonSelector{
+						Not:   oneSelector.IsNot(),
+						Chain: toJSONChain(oneSelector.Chain()),
+					})
+				}
+
+				line.Balance = append(line.Balance, selectorsList)
+			}
+		}
+
+		output.Lines = append(output.Lines, line)
+	}
+
+	if block.HasSuites() {
+		for _, oneSuite := range block.Suites().List() {
+			suite := jsonSuite{
+				Name:  oneSuite.Name(),
+				Input: oneSuite.Input(),
+				Fail:  oneSuite.IsFail(),
+			}
+
+			if oneSuite.HasExpected() {
+				suite.Expected = oneSuite.Expected()
+			}
+
+			if oneSuite.HasGolden() {
+				suite.Golden = oneSuite.Golden()
+			}
+
+			output.Suites = append(output.Suites, suite)
+		}
+	}
+
+	return output
+}
+
+func toJSONToken(token tokens.Token) jsonToken {
+	cardinality := token.Cardinality()
+	output := jsonToken{
+		Element: toJSONElement(token.Element()),
+		Cardinality: jsonCardinality{
+			Min: cardinality.Min(),
+		},
+	}
+
+	if cardinality.HasMax() {
+		max := *cardinality.Max()
+		output.Cardinality.Max = &max
+	}
+
+	if token.HasReverse() {
+		output.Reverse = &jsonReverse{}
+		reverse := token.Reverse()
+		if reverse.HasEscape() {
+			escape := toJSONElement(reverse.Escape())
+			output.Reverse.Escape = &escape
+		}
+	}
+
+	if token.HasUnique() {
+		unique := token.Unique()
+		mode := uniqueModeMustBe
+		if unique.MustNot() {
+			mode = uniqueModeMustNot
+		}
+
+		output.Unique = &jsonUnique{
+			Mode:    mode,
+			Element: toJSONElement(unique.Element()),
+			Index:   unique.Index(),
+		}
+	}
+
+	return output
+}
+
+func toJSONChain(chain chains.Chain) jsonChain {
+	output := jsonChain{
+		Element: toJSONElement(chain.Element()),
+	}
+
+	if !chain.HasToken() {
+		return output
+	}
+
+	token := chain.Token()
+	output.Token = &jsonChainToken{
+		Index: token.Index(),
+	}
+
+	if !token.HasElement() {
+		return output
+	}
+
+	element := token.Element()
+	output.Token.Element = &jsonChainElement{
+		Index: element.Index(),
+	}
+
+	if element.HasChain() {
+		subChain := toJSONChain(element.Chain())
+		output.Token.Element.Chain = &subChain
+	}
+
+	return output
+}
+
+func toJSONElement(element elements.Element) jsonElement {
+	if element.IsRule() {
+		return jsonElement{
+			Rule: element.Rule(),
+		}
+	}
+
+	if element.IsBlock() {
+		return jsonElement{
+			Block: element.Block(),
+		}
+	}
+
+	if element.IsConstant() {
+		return jsonElement{
+			Constant: element.Constant(),
+		}
+	}
+
+	reference := element.Reference()
+	return jsonElement{
+		Reference: &jsonReference{
+			Path:       reference.Path(),
+			Name:       reference.Name(),
+			Constraint: reference.Constraint().String(),
+		},
+	}
+}
@@ -0,0 +1,137 @@
+package jsons
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+)
+
+func TestAdapter_isReversible_Success(t *testing.T) {
+	input := []byte(`
+		v1.2.3;
+		> .myRoot;
+		# .SPACE .EOL;
+
+		myRoot: #.myRoot[1] .mySecond* ![._myConstant].QUOTE .myThird+ .myFourth? .myFifth[1,] .MY_RULE[2,4] .myValue[/my/path/to/grammar.grammar, ^1]
+					[
+						.myFirst[0][1]->MY_RULE[0][1] :
+							!.myFirst[0][1]->mySecond[0][0]->myThird[0]
+						;
+
+						.myFirst[0];
+					];
+				 | $.myRoot .mySecond[3] !.QUOTE
+				 ---
+					first: "this is some \"value\"";
+					second: !"this is \\ some value";
+					third: "value" => "(myRoot#1 (mySecond \"value\"))";
+					fourth: "value" => @"golden/fourth.ast";
+				 ;
+
+		mySecond: .MY_RULE
+				;
+
+		_myConstant: .MY_RULE .MY_SECOND_RULE[2] ._mySubConstant;
+		_mySubConstant: .MY_RULE;
+
+		MY_RULE: "this \" with escape";
+		MY_SECOND_RULE: "some value";
+		QUOTE: "\"";
+		SPACE: " ";
+		EOL: "
+";
+	`)
+
+	grammarAdapter := grammars.NewAdapter()
+	grammar, _, err := grammarAdapter.ToGrammar(input)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	adapter := NewAdapter()
+	retJSON, err := adapter.ToJSON(grammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retGrammar, err := adapter.ToGrammar(retJSON)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected, err := grammarAdapter.ToBytes(grammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retBytes, err := grammarAdapter.ToBytes(retGrammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !bytes.Equal(expected, retBytes) {
+		t.Errorf("the grammar was expected to be:\n%s\nreturned:\n%s", expected, retBytes)
+		return
+	}
+
+	retReencoded, err := adapter.ToJSON(retGrammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !bytes.Equal(retJSON, retReencoded) {
+		t.Errorf("the JSON was expected to be:\n%s\nreturned:\n%s", retJSON, retReencoded)
+		return
+	}
+
+	if retGrammar.Version().String() != "1.2.3" {
+		t.Errorf("the version was expected to be %s, %s returned", "1.2.3", retGrammar.Version().String())
+		return
+	}
+
+	retBlocks := retGrammar.Blocks().List()
+	if retBlocks[len(retBlocks)-1].Name() != "myRoot" {
+		t.Errorf("the order of the blocks was expected to be preserved")
+		return
+	}
+}
+
+func TestAdapter_withUnsupportedSchema_returnsError(t *testing.T) {
+	_, err := NewAdapter().ToGrammar([]byte(`{"schema": 2, "version": "1"}`))
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestAdapter_withAmbiguousElement_returnsError(t *testing.T) {
+	_, err := NewAdapter().ToGrammar([]byte(`{
+		"schema": 1,
+		"version": "1",
+		"root": {"block": "myRoot", "rule": "MY_RULE"},
+		"blocks": [{"name": "myRoot", "lines": [{"tokens": [{"element": {"rule": "MY_RULE"}, "cardinality": {"min": 1, "max": 1}}]}]}],
+		"rules": [{"name": "MY_RULE", "value": "MA=="}]
+	}`))
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestSchema_isValidJSON_Success(t *testing.T) {
+	decoded := map[string]any{}
+	err := json.Unmarshal(Schema, &decoded)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+}
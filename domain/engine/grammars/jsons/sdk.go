@@ -0,0 +1,108 @@
+package jsons
+
+import (
+	_ "embed"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/balances"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/balances/selectors"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/balances/selectors/chains"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/cardinalities"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements/references"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/reverses"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/uniques"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/suites"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/constants"
+	constant_tokens "github.com/steve-care-software/grammars/domain/engine/grammars/constants/tokens"
+	constant_elements "github.com/steve-care-software/grammars/domain/engine/grammars/constants/tokens/elements"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/rules"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+)
+
+// Schema is the JSON schema of the representation produced by the adapter
+//
+//go:embed schema.json
+var Schema []byte
+
+// SchemaVersion is the version of the JSON schema produced by the adapter
+const SchemaVersion = 1
+
+const uniqueModeMustBe = "must_be"
+const uniqueModeMustNot = "must_not"
+
+// NewAdapter creates a new adapter
+func NewAdapter() Adapter {
+	grammarBuilder := grammars.NewBuilder()
+	constantsBuilder := constants.NewBuilder()
+	constantBuilder := constants.NewConstantBuilder()
+	constantTokensBuilder := constant_tokens.NewBuilder()
+	constantTokenBuilder := constant_tokens.NewTokenBuilder()
+	constantElementBuilder := constant_elements.NewBuilder()
+	blocksBuilder := blocks.NewBuilder()
+	blockBuilder := blocks.NewBlockBuilder()
+	suitesBuilder := suites.NewBuilder()
+	suiteBuilder := suites.NewSuiteBuilder()
+	linesBuilder := lines.NewBuilder()
+	lineBuilder := lines.NewLineBuilder()
+	balanceBuilder := balances.NewBuilder()
+	selectorsBuilder := selectors.NewBuilder()
+	selectorBuilder := selectors.NewSelectorBuilder()
+	chainBuilder := chains.NewBuilder()
+	chainTokenBuilder := chains.NewTokenBuilder()
+	chainElementBuilder := chains.NewElementBuilder()
+	tokensBuilder := tokens.NewBuilder()
+	tokenBuilder := tokens.NewTokenBuilder()
+	cardinalityBuilder := cardinalities.NewBuilder()
+	reverseBuilder := reverses.NewBuilder()
+	uniqueBuilder := uniques.NewBuilder()
+	elementsBuilder := elements.NewBuilder()
+	elementBuilder := elements.NewElementBuilder()
+	referenceBuilder := references.NewBuilder()
+	rulesBuilder := rules.NewBuilder()
+	ruleBuilder := rules.NewRuleBuilder()
+	versionAdapter := versions.NewAdapter()
+	return createAdapter(
+		grammarBuilder,
+		constantsBuilder,
+		constantBuilder,
+		constantTokensBuilder,
+		constantTokenBuilder,
+		constantElementBuilder,
+		blocksBuilder,
+		blockBuilder,
+		suitesBuilder,
+		suiteBuilder,
+		linesBuilder,
+		lineBuilder,
+		balanceBuilder,
+		selectorsBuilder,
+		selectorBuilder,
+		chainBuilder,
+		chainTokenBuilder,
+		chainElementBuilder,
+		tokensBuilder,
+		tokenBuilder,
+		cardinalityBuilder,
+		reverseBuilder,
+		uniqueBuilder,
+		elementsBuilder,
+		elementBuilder,
+		referenceBuilder,
+		rulesBuilder,
+		ruleBuilder,
+		versionAdapter,
+	)
+}
+
+// Adapter converts grammars to and from their JSON representation, described by the schema.json file
+type Adapter interface {
+	// ToJSON converts a grammar to its JSON representation
+	ToJSON(grammar grammars.Grammar) ([]byte, error)
+
+	// ToGrammar converts a JSON representation to a grammar, built using the grammar builders
+	ToGrammar(input []byte) (grammars.Grammar, error)
+}
@@ -0,0 +1,47 @@
+package grammars
+
+import (
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/constants"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/rules"
+)
+
+type renderer struct {
+	adapter *adapter
+}
+
+func createRenderer(
+	adapter *adapter,
+) *renderer {
+	out := renderer{
+		adapter: adapter,
+	}
+
+	return &out
+}
+
+// ElementToBytes renders the element as a reference
+func (app *renderer) ElementToBytes(element elements.Element) []byte {
+	return app.adapter.elementReferenceToBytes(element)
+}
+
+// LineToBytes renders the line
+func (app *renderer) LineToBytes(line lines.Line) []byte {
+	return app.adapter.lineToBytes(line)
+}
+
+// LinesToBytes renders the lines
+func (app *renderer) LinesToBytes(lines lines.Lines) []byte {
+	return app.adapter.linesToBytes(lines)
+}
+
+// ConstantToBytes renders the tokens of the constant
+func (app *renderer) ConstantToBytes(constant constants.Constant) []byte {
+	return app.adapter.constantTokensToBytes(constant)
+}
+
+// RuleToBytes renders the value of the rule
+func (app *renderer) RuleToBytes(rule rules.Rule) []byte {
+	return app.adapter.valueToBytes(rule.Bytes())
+}
@@ -0,0 +1,408 @@
+package grammars
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements/references"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+)
+
+type repositoryFile struct {
+	mutex          sync.RWMutex
+	adapter        Adapter
+	versionAdapter versions.Adapter
+	rootDirectory  string
+	grammars       map[string]map[string]Grammar
+	listings       map[string][]versions.Version
+	fileNames      map[string]map[string]string
+}
+
+func createRepositoryFile(
+	adapter Adapter,
+	versionAdapter versions.Adapter,
+	rootDirectory string,
+) Repository {
+	out := repositoryFile{
+		adapter:        adapter,
+		versionAdapter: versionAdapter,
+		rootDirectory:  rootDirectory,
+		grammars:       map[string]map[string]Grammar{},
+		listings:       map[string][]versions.Version{},
+		fileNames:      map[string]map[string]string{},
+	}
+
+	return &out
+}
+
+// Init initializes the repository
+func (app *repositoryFile) Init() error {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	app.grammars = map[string]map[string]Grammar{}
+	app.listings = map[string][]versions.Version{}
+	app.fileNames = map[string]map[string]string{}
+	return os.MkdirAll(app.rootDirectory, os.ModePerm)
+}
+
+// List lists the grammar paths and their sorted versions
+func (app *repositoryFile) List() (map[string][]versions.Version, error) {
+	app.mutex.RLock()
+	defer app.mutex.RUnlock()
+	output := map[string][]versions.Version{}
+	listed := map[string]bool{}
+	err := filepath.WalkDir(app.rootDirectory, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		version, isGrammar := app.fileNameToVersion(entry.Name())
+		if !isGrammar {
+			return nil
+		}
+
+		relative, err := filepath.Rel(app.rootDirectory, filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+
+		if relative == "." {
+			return nil
+		}
+
+		// a version can be written as v1.grammar and v1.0.0.grammar, so it is only listed once:
+		pathStr := strings.Join(strings.Split(relative, string(filepath.Separator)), referencePathSeparator)
+		keyname := fmt.Sprintf("%s%s%s", pathStr, referencePathSeparator, version.String())
+		if listed[keyname] {
+			return nil
+		}
+
+		listed[keyname] = true
+		output[pathStr] = append(output[pathStr], version)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for path, oneList := range output {
+		output[path] = sortVersions(oneList)
+	}
+
+	return output, nil
+}
+
+// Insert inserts a grammar
+func (app *repositoryFile) Insert(path []string, grammar Grammar) error {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	pathStr, err := app.path(path)
+	if err != nil {
+		return err
+	}
+
+	content, err := app.adapter.ToBytes(grammar)
+	if err != nil {
+		return err
+	}
+
+	version := grammar.Version()
+	directory := app.directory(path)
+	err = os.MkdirAll(directory, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	// replace the file of the version if it already exists, even if its name is not canonical:
+	fileName, err := app.fileName(path, version)
+	if err != nil {
+		return err
+	}
+
+	// write in a temporary file then rename it, so that a grammar file is never partially written:
+	file, err := os.CreateTemp(directory, fmt.Sprintf("%s*", fileName))
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(content)
+	if err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return err
+	}
+
+	err = file.Close()
+	if err != nil {
+		os.Remove(file.Name())
+		return err
+	}
+
+	err = os.Rename(file.Name(), filepath.Join(directory, fileName))
+	if err != nil {
+		os.Remove(file.Name())
+		return err
+	}
+
+	if _, ok := app.grammars[pathStr]; !ok {
+		app.grammars[pathStr] = map[string]Grammar{}
+	}
+
+	app.grammars[pathStr][version.String()] = grammar
+	delete(app.listings, pathStr)
+	delete(app.fileNames, pathStr)
+	return nil
+}
+
+// Retrieve retrieves the grammar whose version is the best match of the reference, parsing its file on first access.
+// The versions of a path are listed once, then cached until a grammar is inserted or deleted at that path
+func (app *repositoryFile) Retrieve(reference references.Reference) (Grammar, error) {
+	path := reference.Path()
+	pathStr, err := app.path(path)
+	if err != nil {
+		return nil, err
+	}
+
+	constraint := reference.Constraint()
+	app.mutex.RLock()
+	cached, isCached := app.cached(pathStr, constraint)
+	app.mutex.RUnlock()
+	if isCached {
+		return cached, nil
+	}
+
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	version, err := app.resolve(path, constraint)
+	if err != nil {
+		return nil, err
+	}
+
+	if versionGrammar, ok := app.grammars[pathStr]; ok {
+		if ins, ok := versionGrammar[version.String()]; ok {
+			return ins, nil
+		}
+	}
+
+	fileName, err := app.fileName(path, version)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(filepath.Join(app.directory(path), fileName))
+	if err != nil {
+		return nil, err
+	}
+
+	grammar, remaining, err := app.adapter.ToGrammar(content)
+	if err != nil {
+		str := fmt.Sprintf("the grammar (path: %s, version: %s) could not be parsed: %s", pathStr, version.String(), err.Error())
+		return nil, errors.New(str)
+	}
+
+	if len(bytes.TrimSpace(remaining)) > 0 {
+		str := fmt.Sprintf("the grammar (path: %s, version: %s) was expected to contain no remaining data after its definition", pathStr, version.String())
+		return nil, errors.New(str)
+	}
+
+	if grammar.Version().Compare(version) != 0 {
+		str := fmt.Sprintf("the grammar (path: %s) was expected to declare the version (%s) of its file name, %s declared", pathStr, version.String(), grammar.Version().String())
+		return nil, errors.New(str)
+	}
+
+	if _, ok := app.grammars[pathStr]; !ok {
+		app.grammars[pathStr] = map[string]Grammar{}
+	}
+
+	app.grammars[pathStr][version.String()] = grammar
+	return grammar, nil
+}
+
+// Delete deletes the grammar whose version is the exact version of the reference
+func (app *repositoryFile) Delete(reference references.Reference) error {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	path := reference.Path()
+	pathStr, err := app.path(path)
+	if err != nil {
+		return err
+	}
+
+	constraint := reference.Constraint()
+	if !constraint.IsExact() {
+		return inexactDeleteError(pathStr, constraint)
+	}
+
+	version, err := app.resolve(path, constraint)
+	if err != nil {
+		str := fmt.Sprintf("there is no grammar at the provided path (%s) for version (%s)", pathStr, constraint.String())
+		return errors.New(str)
+	}
+
+	fileName, err := app.fileName(path, version)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(filepath.Join(app.directory(path), fileName))
+	if err != nil {
+		return err
+	}
+
+	delete(app.listings, pathStr)
+	delete(app.fileNames, pathStr)
+
+	if _, ok := app.grammars[pathStr]; ok {
+		delete(app.grammars[pathStr], version.String())
+		if len(app.grammars[pathStr]) <= 0 {
+			delete(app.grammars, pathStr)
+		}
+	}
+
+	// remove the directories left empty, without ever leaving the root directory:
+	for idx := len(path); idx > 0; idx-- {
+		directory := filepath.Join(append([]string{app.rootDirectory}, path[:idx]...)...)
+		entries, err := os.ReadDir(directory)
+		if err != nil || len(entries) > 0 {
+			break
+		}
+
+		err = os.Remove(directory)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (app *repositoryFile) cached(pathStr string, constraint versions.Constraint) (Grammar, bool) {
+	list, ok := app.listings[pathStr]
+	if !ok {
+		return nil, false
+	}
+
+	version, err := constraint.Resolve(list)
+	if err != nil {
+		return nil, false
+	}
+
+	ins, ok := app.grammars[pathStr][version.String()]
+	return ins, ok
+}
+
+func (app *repositoryFile) resolve(path []string, constraint versions.Constraint) (versions.Version, error) {
+	pathStr := strings.Join(path, referencePathSeparator)
+	list, err := app.listing(path)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := constraint.Resolve(list)
+	if err != nil {
+		str := fmt.Sprintf("the version (%s) of the provided grammar path (%s) could not be found", constraint.String(), pathStr)
+		return nil, errors.New(str)
+	}
+
+	return version, nil
+}
+
+func (app *repositoryFile) listing(path []string) ([]versions.Version, error) {
+	pathStr := strings.Join(path, referencePathSeparator)
+	if list, ok := app.listings[pathStr]; ok {
+		return list, nil
+	}
+
+	entries, err := os.ReadDir(app.directory(path))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			str := fmt.Sprintf("the grammar (path: %s) could not be found", pathStr)
+			return nil, errors.New(str)
+		}
+
+		return nil, err
+	}
+
+	list := []versions.Version{}
+	fileNames := map[string]string{}
+	for _, oneEntry := range entries {
+		if oneEntry.IsDir() {
+			continue
+		}
+
+		name := oneEntry.Name()
+		version, isGrammar := app.fileNameToVersion(name)
+		if !isGrammar {
+			continue
+		}
+
+		// the file name of a version is kept as written, such as v1.grammar, the canonical name being preferred:
+		keyname := version.String()
+		if _, ok := fileNames[keyname]; !ok {
+			list = append(list, version)
+			fileNames[keyname] = name
+		}
+
+		if name == versionToFileName(version) {
+			fileNames[keyname] = name
+		}
+	}
+
+	app.listings[pathStr] = list
+	app.fileNames[pathStr] = fileNames
+	return list, nil
+}
+
+// fileName returns the name of the file that contains the version, or its canonical name if there is no such file
+func (app *repositoryFile) fileName(path []string, version versions.Version) (string, error) {
+	pathStr := strings.Join(path, referencePathSeparator)
+	_, err := app.listing(path)
+	if err != nil {
+		return "", err
+	}
+
+	if name, ok := app.fileNames[pathStr][version.String()]; ok {
+		return name, nil
+	}
+
+	return versionToFileName(version), nil
+}
+
+func (app *repositoryFile) path(path []string) (string, error) {
+	if len(path) <= 0 {
+		return "", errors.New("the path must contain at least 1 segment in order to locate a grammar file")
+	}
+
+	for idx, oneSegment := range path {
+		if oneSegment == "" || oneSegment == "." || oneSegment == ".." || strings.ContainsAny(oneSegment, `/\`) {
+			str := fmt.Sprintf("the segment (index: %d, value: %q) of the path is invalid", idx, oneSegment)
+			return "", errors.New(str)
+		}
+	}
+
+	return strings.Join(path, referencePathSeparator), nil
+}
+
+func (app *repositoryFile) directory(path []string) string {
+	return filepath.Join(append([]string{app.rootDirectory}, path...)...)
+}
+
+func (app *repositoryFile) fileNameToVersion(name string) (versions.Version, bool) {
+	version, err := fileNameToVersion(app.versionAdapter, name)
+	if err != nil {
+		return nil, false
+	}
+
+	return version, true
+}
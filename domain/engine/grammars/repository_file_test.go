@@ -0,0 +1,431 @@
+package grammars
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements/references"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+)
+
+func TestRepositoryFile_Success(t *testing.T) {
+	input := []byte(`
+		v2;
+		> .assignment;
+		# .SPACE;
+
+		assignment: .LL_A .EQUAL .N_ONE[1,3] .SEMICOLON
+					---
+					valid: "a=11;";
+					;
+
+		N_ONE: "1";
+		LL_A: "a";
+		EQUAL: "=";
+		SEMICOLON: ";";
+		SPACE: " ";
+	`)
+
+	grammar, _, err := NewAdapter().ToGrammar(input)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	rootDirectory := t.TempDir()
+	repository := NewRepositoryFile(rootDirectory)
+	err = repository.Init()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	path := []string{"languages", "assignments"}
+	err = repository.Insert(path, grammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	file := filepath.Join(rootDirectory, "languages", "assignments", "v2.0.0.grammar")
+	if _, err := os.Stat(file); err != nil {
+		t.Errorf("the grammar file (%s) was expected to exist: %s", file, err.Error())
+		return
+	}
+
+	list, err := repository.List()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if retVersions, ok := list["languages/assignments"]; !ok || len(retVersions) != 1 || retVersions[0].String() != "2.0.0" {
+		t.Errorf("the list was expected to contain the version 2 of the inserted grammar, %v returned", list)
+		return
+	}
+
+	constraint, err := versions.NewAdapter().ToConstraint([]byte("^2"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	reference, err := references.NewBuilder().Create().
+		WithPath(path).
+		WithName("assignments").
+		WithConstraint(constraint).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// a new repository parses the file lazily:
+	retGrammar, err := NewRepositoryFile(rootDirectory).Retrieve(reference)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if retGrammar.Version().String() != "2.0.0" || retGrammar.Root().Name() != "assignment" {
+		t.Errorf("the retrieved grammar was expected to be the inserted grammar")
+		return
+	}
+
+	// a grammar can only be deleted using its exact version, a bare version being exact:
+	err = repository.Delete(reference)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	exactConstraint, err := versions.NewAdapter().ToConstraint([]byte("2"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	exactReference, err := references.NewBuilder().Create().
+		WithPath(path).
+		WithName("assignments").
+		WithConstraint(exactConstraint).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = repository.Delete(exactReference)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if _, err := os.Stat(filepath.Join(rootDirectory, "languages")); err == nil {
+		t.Errorf("the directories left empty were expected to be removed")
+		return
+	}
+
+	_, err = repository.Retrieve(reference)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	err = repository.Delete(exactReference)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestRepositoryFile_withCachedListing_Success(t *testing.T) {
+	rootDirectory := t.TempDir()
+	repository := NewRepositoryFile(rootDirectory)
+	err := repository.Init()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	insert := func(version string) error {
+		grammar, _, err := NewAdapter().ToGrammar([]byte(fmt.Sprintf(`
+			v%s;
+			> .value;
+			# .SPACE;
+
+			value: .N_ONE+
+				;
+
+			N_ONE: "1";
+			SPACE: " ";
+		`, version)))
+
+		if err != nil {
+			return err
+		}
+
+		return repository.Insert([]string{"values"}, grammar)
+	}
+
+	err = insert("1.0")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	constraint, err := versions.NewAdapter().ToConstraint([]byte("^1"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	reference, err := references.NewBuilder().Create().
+		WithPath([]string{"values"}).
+		WithName("value").
+		WithConstraint(constraint).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = repository.Retrieve(reference)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// the listing is cached, so a file written outside of the repository is not seen:
+	directory := filepath.Join(rootDirectory, "values")
+	err = os.WriteFile(filepath.Join(directory, "v1.5.0.grammar"), []byte("invalid"), 0644)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retGrammar, err := repository.Retrieve(reference)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if retGrammar.Version().String() != "1.0.0" {
+		t.Errorf("the cached listing was expected to resolve to the version 1.0.0, %s returned", retGrammar.Version().String())
+		return
+	}
+
+	err = os.Remove(filepath.Join(directory, "v1.5.0.grammar"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// an insert invalidates the listing:
+	err = insert("1.2")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retGrammar, err = repository.Retrieve(reference)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if retGrammar.Version().String() != "1.2.0" {
+		t.Errorf("the listing was expected to be invalidated by the insert, %s returned", retGrammar.Version().String())
+		return
+	}
+}
+
+func TestRepositoryFile_withShortFileName_Success(t *testing.T) {
+	rootDirectory := t.TempDir()
+	repository := NewRepositoryFile(rootDirectory)
+	err := repository.Init()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// a hand-written file whose name does not contain every part of its version:
+	directory := filepath.Join(rootDirectory, "values")
+	err = os.MkdirAll(directory, os.ModePerm)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = os.WriteFile(filepath.Join(directory, "v1.grammar"), []byte(`
+		v1;
+		> .value;
+		# .SPACE;
+
+		value: .N_ONE+
+			;
+
+		N_ONE: "1";
+		SPACE: " ";
+	`), 0644)
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	list, err := repository.List()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if retVersions, ok := list["values"]; !ok || len(retVersions) != 1 || retVersions[0].String() != "1.0.0" {
+		t.Errorf("the list was expected to contain the version 1.0.0 of the values grammar, %v returned", list)
+		return
+	}
+
+	constraint, err := versions.NewAdapter().ToConstraint([]byte("1"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	reference, err := references.NewBuilder().Create().
+		WithPath([]string{"values"}).
+		WithName("value").
+		WithConstraint(constraint).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retGrammar, err := repository.Retrieve(reference)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// inserting the same version replaces the hand-written file instead of adding another one:
+	err = repository.Insert([]string{"values"}, retGrammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(entries) != 1 || entries[0].Name() != "v1.grammar" {
+		t.Errorf("the directory was expected to only contain the v1.grammar file")
+		return
+	}
+
+	err = repository.Delete(reference)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = os.Stat(directory)
+	if !os.IsNotExist(err) {
+		t.Errorf("the directory was expected to be removed along with its only grammar file")
+		return
+	}
+}
+
+func TestRepositoryFile_withPathOutsideRoot_returnsError(t *testing.T) {
+	constraint, err := versions.NewAdapter().ToConstraint([]byte("1"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	reference, err := references.NewBuilder().Create().
+		WithPath([]string{"..", "outside"}).
+		WithName("outside").
+		WithConstraint(constraint).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = NewRepositoryFile(t.TempDir()).Retrieve(reference)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestRepositoryFile_concurrent_Success(t *testing.T) {
+	grammar, _, err := NewAdapter().ToGrammar([]byte(`
+		v1;
+		> .value;
+		# .SPACE;
+
+		value: .N_ONE+
+			;
+
+		N_ONE: "1";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	repository := NewRepositoryFile(t.TempDir())
+	err = repository.Init()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	testRepositoryConcurrency(t, repository, grammar)
+}
+
+func TestFileName_Success(t *testing.T) {
+	version, err := versions.NewAdapter().ToVersion([]byte("1.2"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	name := FileName(version)
+	if name != "v1.2.0.grammar" || !IsFileName(name) {
+		t.Errorf("the file name was expected to be v1.2.0.grammar, %s returned", name)
+		return
+	}
+
+	retVersion, err := FileNameToVersion(name)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if retVersion.Compare(version) != 0 {
+		t.Errorf("the version was expected to be %s, %s returned", version.String(), retVersion.String())
+		return
+	}
+
+	for _, oneName := range []string{"1.2.0.grammar", "v1.2.0.txt", "vx.grammar"} {
+		_, err := FileNameToVersion(oneName)
+		if err == nil {
+			t.Errorf("the file name (%s) was expected to be invalid", oneName)
+			return
+		}
+	}
+}
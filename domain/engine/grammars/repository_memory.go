@@ -4,13 +4,16 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"path/filepath"
+	"strings"
+	"sync"
 
 	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements/references"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
 )
 
 type repositoryMemory struct {
-	grammars map[string]map[uint]Grammar
+	mutex    sync.RWMutex
+	grammars map[string]map[string]Grammar
 }
 
 func createRepositoryMemory(
@@ -19,7 +22,7 @@ func createRepositoryMemory(
 	out := repositoryMemory{}
 	out.Init()
 	for path, oneGrammar := range grammarsList {
-		err := out.Insert(filepath.SplitList(path), oneGrammar)
+		err := out.Insert(splitPath(path), oneGrammar)
 		if err != nil {
 			log.Printf("there was an error while creating a repositoryMemory: %s", err.Error())
 		}
@@ -30,21 +33,19 @@ func createRepositoryMemory(
 
 // Init initializes the repository
 func (app *repositoryMemory) Init() error {
-	app.grammars = map[string]map[uint]Grammar{}
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	app.grammars = map[string]map[string]Grammar{}
 	return nil
 }
 
-// List lists the grammar paths
-func (app *repositoryMemory) List() (map[string][]uint, error) {
-	output := map[string][]uint{}
+// List lists the grammar paths and their sorted versions
+func (app *repositoryMemory) List() (map[string][]versions.Version, error) {
+	app.mutex.RLock()
+	defer app.mutex.RUnlock()
+	output := map[string][]versions.Version{}
 	for path, oneGrammarVersion := range app.grammars {
-		if _, ok := output[path]; !ok {
-			output[path] = []uint{}
-		}
-
-		for oneVersion, _ := range oneGrammarVersion {
-			output[path] = append(output[path], oneVersion)
-		}
+		output[path] = grammarVersions(oneGrammarVersion)
 	}
 
 	return output, nil
@@ -52,49 +53,61 @@ func (app *repositoryMemory) List() (map[string][]uint, error) {
 
 // Insert inserts a grammar
 func (app *repositoryMemory) Insert(path []string, grammar Grammar) error {
-	pathStr := filepath.Join(path...)
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	pathStr := strings.Join(path, referencePathSeparator)
 	if _, ok := app.grammars[pathStr]; !ok {
-		app.grammars[pathStr] = map[uint]Grammar{}
+		app.grammars[pathStr] = map[string]Grammar{}
 	}
 
-	version := grammar.Version()
+	version := grammar.Version().String()
 	app.grammars[pathStr][version] = grammar
 	return nil
 }
 
-// Retrieve retrieves a memory repository
+// Retrieve retrieves the grammar whose version is the best match of the reference
 func (app *repositoryMemory) Retrieve(reference references.Reference) (Grammar, error) {
-	path := filepath.Join(reference.Path()...)
-	version := reference.Version()
+	app.mutex.RLock()
+	defer app.mutex.RUnlock()
+	path := strings.Join(reference.Path(), referencePathSeparator)
+	constraint := reference.Constraint()
 	if versionGrammar, ok := app.grammars[path]; ok {
-		if ins, ok := versionGrammar[version]; ok {
-			return ins, nil
+		version, err := constraint.Resolve(grammarVersions(versionGrammar))
+		if err != nil {
+			str := fmt.Sprintf("the version (%s) of the provided grammar path (%s) could not be found", constraint.String(), path)
+			return nil, errors.New(str)
 		}
 
-		str := fmt.Sprintf("the version (%d) of the provided grammar path (%s) could not be found", version, path)
-		return nil, errors.New(str)
+		return versionGrammar[version.String()], nil
 	}
 
 	str := fmt.Sprintf("the grammar (path: %s) could not be found", path)
 	return nil, errors.New(str)
 }
 
-// Delete deletes a grammar
+// Delete deletes the grammar whose version is the exact version of the reference
 func (app *repositoryMemory) Delete(reference references.Reference) error {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
 	path := reference.Path()
-	pathStr := filepath.Join(path...)
+	pathStr := strings.Join(path, referencePathSeparator)
+	constraint := reference.Constraint()
+	if !constraint.IsExact() {
+		return inexactDeleteError(pathStr, constraint)
+	}
+
 	if _, ok := app.grammars[pathStr]; !ok {
 		str := fmt.Sprintf("there is no grammar at the provided path (%s)", pathStr)
 		return errors.New(str)
 	}
 
-	version := reference.Version()
-	if _, ok := app.grammars[pathStr][version]; !ok {
-		str := fmt.Sprintf("there is no grammar at the provided path (%s) for version (%d)", pathStr, version)
+	version := constraint.Version()
+	if _, ok := app.grammars[pathStr][version.String()]; !ok {
+		str := fmt.Sprintf("there is no grammar at the provided path (%s) for version (%s)", pathStr, constraint.String())
 		return errors.New(str)
 	}
 
-	delete(app.grammars[pathStr], version)
+	delete(app.grammars[pathStr], version.String())
 	if len(app.grammars[pathStr]) <= 0 {
 		delete(app.grammars, pathStr)
 	}
@@ -0,0 +1,265 @@
+package grammars
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements/references"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+)
+
+func TestRepositoryMemory_withVersions_Success(t *testing.T) {
+	repository := NewRepositoryMemory(map[string]Grammar{})
+	for _, oneVersion := range []string{"2", "1.10", "1.2.3", "1.2"} {
+		grammar, _, err := NewAdapter().ToGrammar([]byte(fmt.Sprintf(`
+			v%s;
+			> .value;
+			# .SPACE;
+
+			value: .N_ONE+
+				;
+
+			N_ONE: "1";
+			SPACE: " ";
+		`, oneVersion)))
+
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		err = repository.Insert([]string{"values"}, grammar)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+	}
+
+	list, err := repository.List()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retVersions := []string{}
+	for _, oneVersion := range list["values"] {
+		retVersions = append(retVersions, oneVersion.String())
+	}
+
+	expected := "1.2.0 1.2.3 1.10.0 2.0.0"
+	if strings.Join(retVersions, " ") != expected {
+		t.Errorf("the versions were expected to be sorted (%s), %v returned", expected, retVersions)
+		return
+	}
+
+	constraints := map[string]string{
+		"latest": "2.0.0",
+		"^1":     "1.10.0",
+		"~1.2":   "1.2.3",
+		"1.2.0":  "1.2.0",
+	}
+
+	for constraint, expected := range constraints {
+		retConstraint, err := versions.NewAdapter().ToConstraint([]byte(constraint))
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		reference, err := references.NewBuilder().Create().
+			WithPath([]string{"values"}).
+			WithName("value").
+			WithConstraint(retConstraint).
+			Now()
+
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retGrammar, err := repository.Retrieve(reference)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if retGrammar.Version().String() != expected {
+			t.Errorf("the constraint (%s) was expected to resolve to the version %s, %s returned", constraint, expected, retGrammar.Version().String())
+			return
+		}
+	}
+}
+
+func TestRepositoryMemory_deleteWithRange_returnsError(t *testing.T) {
+	grammar, _, err := NewAdapter().ToGrammar([]byte(`
+		v1.2.3;
+		> .value;
+		# .SPACE;
+
+		value: .N_ONE+
+			;
+
+		N_ONE: "1";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	repository := NewRepositoryMemory(map[string]Grammar{
+		"values": grammar,
+	})
+
+	for _, oneConstraint := range []string{"^1", "~1.2", "latest", "1.2", "1.2.3"} {
+		constraint, err := versions.NewAdapter().ToConstraint([]byte(oneConstraint))
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		reference, err := references.NewBuilder().Create().
+			WithPath([]string{"values"}).
+			WithName("value").
+			WithConstraint(constraint).
+			Now()
+
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		err = repository.Delete(reference)
+		if oneConstraint == "1.2.3" {
+			if err != nil {
+				t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+				return
+			}
+
+			continue
+		}
+
+		if err == nil {
+			t.Errorf("the constraint (%s) was expected to be refused by the delete", oneConstraint)
+			return
+		}
+	}
+
+	list, err := repository.List()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(list) != 0 {
+		t.Errorf("the grammar was expected to be deleted using its exact version")
+		return
+	}
+}
+
+func TestRepositoryMemory_concurrent_Success(t *testing.T) {
+	grammar, _, err := NewAdapter().ToGrammar([]byte(`
+		v1;
+		> .value;
+		# .SPACE;
+
+		value: .N_ONE+
+			;
+
+		N_ONE: "1";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	repository := NewRepositoryMemory(map[string]Grammar{
+		"shared/value": grammar,
+	})
+
+	testRepositoryConcurrency(t, repository, grammar)
+}
+
+func testRepositoryConcurrency(t *testing.T, repository Repository, grammar Grammar) {
+	amount := 16
+	errs := make(chan error, amount*3)
+	waitGroup := sync.WaitGroup{}
+	for i := 0; i < amount; i++ {
+		waitGroup.Add(1)
+		go func(idx int) {
+			defer waitGroup.Done()
+			path := []string{"concurrent", fmt.Sprintf("value%d", idx)}
+			err := repository.Insert(path, grammar)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			constraint, err := versions.NewConstraintBuilder().Create().
+				WithVersion(grammar.Version()).
+				Now()
+
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			reference, err := references.NewBuilder().Create().
+				WithPath(path).
+				WithName("value").
+				WithConstraint(constraint).
+				Now()
+
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			_, err = repository.Retrieve(reference)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			_, err = repository.List()
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			if idx%2 == 0 {
+				err = repository.Delete(reference)
+				if err != nil {
+					errs <- err
+					return
+				}
+			}
+		}(i)
+	}
+
+	waitGroup.Wait()
+	close(errs)
+	for oneErr := range errs {
+		t.Errorf("the error was expected to be nil, error returned: %s", oneErr.Error())
+		return
+	}
+
+	list, err := repository.List()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	for i := 0; i < amount; i++ {
+		_, ok := list[fmt.Sprintf("concurrent/value%d", i)]
+		if ok == (i%2 == 0) {
+			t.Errorf("the presence of the grammar (index: %d) in the list is invalid", i)
+			return
+		}
+	}
+}
@@ -16,7 +16,9 @@ import (
 	"github.com/steve-care-software/grammars/domain/engine/grammars/constants"
 	constant_tokens "github.com/steve-care-software/grammars/domain/engine/grammars/constants/tokens"
 	constant_elements "github.com/steve-care-software/grammars/domain/engine/grammars/constants/tokens/elements"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/diffs"
 	"github.com/steve-care-software/grammars/domain/engine/grammars/rules"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
 )
 
 // CoreFn represents a core fn
@@ -112,6 +114,8 @@ const failSeparator = "!"
 const suiteLineSuffix = ";"
 const blockSuffix = ";"
 const suiteSeparatorPrefix = "---"
+const suiteExpectationPrefix = "=>"
+const suiteGoldenPrefix = "@"
 const versionPrefix = "v"
 const versionSuffix = ";"
 const rootPrefix = ">"
@@ -134,6 +138,9 @@ const referenceEnd = "]"
 const referencePathSeparator = "/"
 const referenceElementSeparator = ","
 
+const repositoryFileVersionPrefix = "v"
+const repositoryFileExtension = ".grammar"
+
 // NewAdapter creates a new adapter
 func NewAdapter() Adapter {
 	grammarBuilder := NewBuilder()
@@ -164,6 +171,7 @@ func NewAdapter() Adapter {
 	ruleBuilder := rules.NewRuleBuilder()
 	cardinalityBuilder := cardinalities.NewBuilder()
 	referenceBuilder := references.NewBuilder()
+	versionAdapter := versions.NewAdapter()
 	blockNameAfterFirstByteCharacters := createBlockNameCharacters()
 	possibleLowerCaseLetters := createPossibleLowerCaseLetters()
 	possibleUpperCaseLetters := createPossibleUpperCaseLetters()
@@ -198,8 +206,10 @@ func NewAdapter() Adapter {
 		ruleBuilder,
 		cardinalityBuilder,
 		referenceBuilder,
+		versionAdapter,
 		[]byte(filterBytes),
 		[]byte(suiteSeparatorPrefix),
+		[]byte(suiteExpectationPrefix),
 		blockNameAfterFirstByteCharacters,
 		possibleLowerCaseLetters,
 		possibleUpperCaseLetters,
@@ -214,6 +224,7 @@ func NewAdapter() Adapter {
 		[]byte(blockSuffix)[0],
 		[]byte(suiteLineSuffix)[0],
 		[]byte(failSeparator)[0],
+		[]byte(suiteGoldenPrefix)[0],
 		[]byte(blockDefinitionSeparator)[0],
 		[]byte(linesSeparator)[0],
 		[]byte(lineSeparator)[0],
@@ -257,6 +268,17 @@ func NewBuilder() Builder {
 type Adapter interface {
 	// ToGrammar takes the input and converts it to a grammar instance and the remaining data
 	ToGrammar(input []byte) (Grammar, []byte, error)
+
+	// ToBytes takes a grammar and converts it to its text representation
+	ToBytes(grammar Grammar) ([]byte, error)
+
+	// ToCanonical takes a grammar and converts it to its canonical representation, that does not depend on
+	// the formatting of its text nor on the declaration order of its blocks, constants and rules
+	ToCanonical(grammar Grammar) ([]byte, error)
+
+	// ToDiff compares two versions of a grammar and returns their root, omissions, blocks, lines, constants and rules
+	// that are added, removed or changed, using their text representation
+	ToDiff(from Grammar, to Grammar) (diffs.Diff, error)
 }
 
 // NewRepositoryMemory creates a new reposiotry memory
@@ -268,10 +290,38 @@ func NewRepositoryMemory(
 	)
 }
 
+// NewRepositoryFile creates a new repository that stores its grammars in files of the root directory
+func NewRepositoryFile(
+	rootDirectory string,
+) Repository {
+	adapter := NewAdapter()
+	versionAdapter := versions.NewAdapter()
+	return createRepositoryFile(
+		adapter,
+		versionAdapter,
+		rootDirectory,
+	)
+}
+
+// IsFileName returns true if the name follows the layout of the files of the file repository, such as v1.2.3.grammar
+func IsFileName(name string) bool {
+	return isFileName(name)
+}
+
+// FileName returns the name of the file that stores the grammar version in the file repository
+func FileName(version versions.Version) string {
+	return versionToFileName(version)
+}
+
+// FileNameToVersion returns the version stored in a file name of the file repository
+func FileNameToVersion(name string) (versions.Version, error) {
+	return fileNameToVersion(versions.NewAdapter(), name)
+}
+
 // Builder represents the grammar builder
 type Builder interface {
 	Create() Builder
-	WithVersion(version uint) Builder
+	WithVersion(version versions.Version) Builder
 	WithRoot(root elements.Element) Builder
 	WithRules(rules rules.Rules) Builder
 	WithBlocks(blocks blocks.Blocks) Builder
@@ -282,7 +332,7 @@ type Builder interface {
 
 // Grammar represents a grammar
 type Grammar interface {
-	Version() uint
+	Version() versions.Version
 	Root() elements.Element
 	Rules() rules.Rules
 	Blocks() blocks.Blocks
@@ -292,10 +342,10 @@ type Grammar interface {
 	Constants() constants.Constants
 }
 
-// Repository represents a Grammar repository
+// Repository represents a Grammar repository, its implementations are safe for concurrent use
 type Repository interface {
 	Init() error
-	List() (map[string][]uint, error)
+	List() (map[string][]versions.Version, error)
 	Insert(path []string, grammar Grammar) error
 	Retrieve(reference references.Reference) (Grammar, error)
 	Delete(reference references.Reference) error
@@ -0,0 +1,92 @@
+package versions
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type adapter struct {
+	builder           Builder
+	constraintBuilder ConstraintBuilder
+}
+
+func createAdapter(
+	builder Builder,
+	constraintBuilder ConstraintBuilder,
+) Adapter {
+	out := adapter{
+		builder:           builder,
+		constraintBuilder: constraintBuilder,
+	}
+
+	return &out
+}
+
+// ToVersion converts the input, such as 1, 1.2 or 1.2.3, to a version
+func (app *adapter) ToVersion(input []byte) (Version, error) {
+	version, _, err := app.toVersion(string(input))
+	return version, err
+}
+
+// ToConstraint converts the input, such as 1.2, ^1, ~1.2 or latest, to a constraint
+func (app *adapter) ToConstraint(input []byte) (Constraint, error) {
+	value := strings.TrimSpace(string(input))
+	builder := app.constraintBuilder.Create()
+	if value == latestKeyword {
+		return builder.IsLatest().Now()
+	}
+
+	if strings.HasPrefix(value, compatiblePrefix) {
+		builder.IsCompatible()
+		value = strings.TrimPrefix(value, compatiblePrefix)
+	} else if strings.HasPrefix(value, approximatePrefix) {
+		builder.IsApproximate()
+		value = strings.TrimPrefix(value, approximatePrefix)
+	}
+
+	version, precision, err := app.toVersion(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return builder.WithVersion(version).
+		WithPrecision(precision).
+		Now()
+}
+
+func (app *adapter) toVersion(value string) (Version, uint, error) {
+	parts := strings.Split(value, partSeparator)
+	if len(parts) > maxPrecision {
+		str := fmt.Sprintf("the version (%s) cannot contain more than %d parts", value, maxPrecision)
+		return nil, 0, errors.New(str)
+	}
+
+	numbers := []uint{0, 0, 0}
+	for idx, onePart := range parts {
+		if onePart == "" || strings.TrimLeft(onePart, "0123456789") != "" {
+			str := fmt.Sprintf("the version (%s) was expected to only contain positive numbers separated by %q", value, partSeparator)
+			return nil, 0, errors.New(str)
+		}
+
+		number, err := strconv.ParseUint(onePart, 10, 64)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		numbers[idx] = uint(number)
+	}
+
+	version, err := app.builder.Create().
+		WithMajor(numbers[0]).
+		WithMinor(numbers[1]).
+		WithPatch(numbers[2]).
+		Now()
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return version, uint(len(parts)), nil
+}
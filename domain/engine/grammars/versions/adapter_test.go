@@ -0,0 +1,107 @@
+package versions
+
+import (
+	"testing"
+)
+
+func TestAdapter_constraints_Success(t *testing.T) {
+	adapter := NewAdapter()
+	available := []Version{}
+	for _, oneInput := range []string{"0.1.0", "0.1.4", "0.2.0", "1.0.0", "1.2.0", "1.2.9", "1.10.1", "2.0.0"} {
+		version, err := adapter.ToVersion([]byte(oneInput))
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		available = append(available, version)
+	}
+
+	expectations := map[string]string{
+		"latest":  "2.0.0",
+		"1":       "1.0.0",
+		"1.2":     "1.2.0",
+		"1.2.9":   "1.2.9",
+		"0.1":     "0.1.0",
+		"^1":      "1.10.1",
+		"^1.2":    "1.10.1",
+		"~1.2":    "1.2.9",
+		"~1":      "1.10.1",
+		"^0.1":    "0.1.4",
+		"^0":      "0.2.0",
+		"^0.1.0":  "0.1.4",
+		" ^2 ":    "2.0.0",
+		"~0.1.2":  "0.1.4",
+		"^1.2.10": "1.10.1",
+	}
+
+	for oneInput, oneExpected := range expectations {
+		constraint, err := adapter.ToConstraint([]byte(oneInput))
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		retVersion, err := constraint.Resolve(available)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if retVersion.String() != oneExpected {
+			t.Errorf("the constraint (%s) was expected to resolve to %s, %s returned", oneInput, oneExpected, retVersion.String())
+			return
+		}
+	}
+
+	for _, oneInput := range []string{"3", "^3", "~1.3", "1.2.1", "1.10", "0.2.1"} {
+		constraint, err := adapter.ToConstraint([]byte(oneInput))
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		_, err = constraint.Resolve(available)
+		if err == nil {
+			t.Errorf("the constraint (%s) was expected to resolve to no version", oneInput)
+			return
+		}
+	}
+}
+
+func TestAdapter_exactConstraints_Success(t *testing.T) {
+	adapter := NewAdapter()
+	expectations := map[string]bool{
+		"1.2.3":  true,
+		"0.0.0":  true,
+		"1.2":    true,
+		"1":      true,
+		"^1.2.3": false,
+		"~1.2.3": false,
+		"latest": false,
+	}
+
+	for oneInput, oneExpected := range expectations {
+		constraint, err := adapter.ToConstraint([]byte(oneInput))
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if constraint.IsExact() != oneExpected {
+			t.Errorf("the constraint (%s) was expected to be exact: %t", oneInput, oneExpected)
+			return
+		}
+	}
+}
+
+func TestAdapter_withInvalidInputs_returnsError(t *testing.T) {
+	adapter := NewAdapter()
+	for _, oneInput := range []string{"", "a", "1.", "1.2.3.4", "-1", "^latest", "1 .2"} {
+		_, err := adapter.ToConstraint([]byte(oneInput))
+		if err == nil {
+			t.Errorf("the input (%q) was expected to be invalid", oneInput)
+			return
+		}
+	}
+}
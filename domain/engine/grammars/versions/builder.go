@@ -0,0 +1,55 @@
+package versions
+
+import "errors"
+
+type builder struct {
+	pMajor *uint
+	minor  uint
+	patch  uint
+}
+
+func createBuilder() Builder {
+	out := builder{
+		pMajor: nil,
+		minor:  0,
+		patch:  0,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *builder) Create() Builder {
+	return createBuilder()
+}
+
+// WithMajor adds a major to the builder
+func (app *builder) WithMajor(major uint) Builder {
+	app.pMajor = &major
+	return app
+}
+
+// WithMinor adds a minor to the builder
+func (app *builder) WithMinor(minor uint) Builder {
+	app.minor = minor
+	return app
+}
+
+// WithPatch adds a patch to the builder
+func (app *builder) WithPatch(patch uint) Builder {
+	app.patch = patch
+	return app
+}
+
+// Now builds a new Version instance
+func (app *builder) Now() (Version, error) {
+	if app.pMajor == nil {
+		return nil, errors.New("the major is mandatory in order to build a Version instance")
+	}
+
+	return createVersion(
+		*app.pMajor,
+		app.minor,
+		app.patch,
+	), nil
+}
@@ -0,0 +1,171 @@
+package versions
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type constraint struct {
+	isLatest      bool
+	version       Version
+	precision     uint
+	isCompatible  bool
+	isApproximate bool
+}
+
+func createConstraintWithLatest() Constraint {
+	return createConstraintInternally(true, nil, 0, false, false)
+}
+
+func createConstraintWithVersion(
+	version Version,
+	precision uint,
+	isCompatible bool,
+	isApproximate bool,
+) Constraint {
+	return createConstraintInternally(false, version, precision, isCompatible, isApproximate)
+}
+
+func createConstraintInternally(
+	isLatest bool,
+	version Version,
+	precision uint,
+	isCompatible bool,
+	isApproximate bool,
+) Constraint {
+	out := constraint{
+		isLatest:      isLatest,
+		version:       version,
+		precision:     precision,
+		isCompatible:  isCompatible,
+		isApproximate: isApproximate,
+	}
+
+	return &out
+}
+
+// IsLatest returns true if the constraint matches the latest version, false otherwise
+func (obj *constraint) IsLatest() bool {
+	return obj.isLatest
+}
+
+// HasVersion returns true if there is a version, false otherwise
+func (obj *constraint) HasVersion() bool {
+	return obj.version != nil
+}
+
+// Version returns the version, if any
+func (obj *constraint) Version() Version {
+	return obj.version
+}
+
+// Precision returns the amount of declared version parts, if any
+func (obj *constraint) Precision() uint {
+	return obj.precision
+}
+
+// IsCompatible returns true if the constraint is compatible, false otherwise
+func (obj *constraint) IsCompatible() bool {
+	return obj.isCompatible
+}
+
+// IsApproximate returns true if the constraint is approximate, false otherwise
+func (obj *constraint) IsApproximate() bool {
+	return obj.isApproximate
+}
+
+// IsExact returns true if the constraint only matches its version, false otherwise
+func (obj *constraint) IsExact() bool {
+	return !obj.isLatest && !obj.isCompatible && !obj.isApproximate
+}
+
+// Matches returns true if the version matches the constraint, false otherwise
+func (obj *constraint) Matches(version Version) bool {
+	if obj.isLatest {
+		return true
+	}
+
+	// a version without prefix is exact, its missing parts being 0:
+	if obj.IsExact() {
+		return version.Compare(obj.version) == 0
+	}
+
+	if version.Compare(obj.version) < 0 {
+		return false
+	}
+
+	// the amount of left-most parts that must be equal:
+	fixed := obj.fixedParts()
+	expected := []uint{obj.version.Major(), obj.version.Minor(), obj.version.Patch()}
+	current := []uint{version.Major(), version.Minor(), version.Patch()}
+	for idx := uint(0); idx < fixed; idx++ {
+		if expected[idx] != current[idx] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Resolve returns the highest version of the list that matches the constraint
+func (obj *constraint) Resolve(list []Version) (Version, error) {
+	var output Version
+	for _, oneVersion := range list {
+		if !obj.Matches(oneVersion) {
+			continue
+		}
+
+		if output == nil || oneVersion.Compare(output) > 0 {
+			output = oneVersion
+		}
+	}
+
+	if output == nil {
+		str := fmt.Sprintf("no version matches the constraint (%s)", obj.String())
+		return nil, errors.New(str)
+	}
+
+	return output, nil
+}
+
+// String returns the constraint as a string
+func (obj *constraint) String() string {
+	if obj.isLatest {
+		return latestKeyword
+	}
+
+	parts := []string{}
+	values := []uint{obj.version.Major(), obj.version.Minor(), obj.version.Patch()}
+	for idx := uint(0); idx < obj.precision; idx++ {
+		parts = append(parts, fmt.Sprintf("%d", values[idx]))
+	}
+
+	prefix := ""
+	if obj.isCompatible {
+		prefix = compatiblePrefix
+	}
+
+	if obj.isApproximate {
+		prefix = approximatePrefix
+	}
+
+	return fmt.Sprintf("%s%s", prefix, strings.Join(parts, partSeparator))
+}
+
+func (obj *constraint) fixedParts() uint {
+	if obj.isApproximate {
+		return min(obj.precision, 2)
+	}
+
+	// a compatible constraint fixes every part up to its left-most non-zero part:
+	if obj.precision == 1 || obj.version.Major() > 0 {
+		return 1
+	}
+
+	if obj.precision == 2 || obj.version.Minor() > 0 {
+		return 2
+	}
+
+	return 3
+}
@@ -0,0 +1,96 @@
+package versions
+
+import (
+	"errors"
+	"fmt"
+)
+
+type constraintBuilder struct {
+	version       Version
+	precision     uint
+	isCompatible  bool
+	isApproximate bool
+	isLatest      bool
+}
+
+func createConstraintBuilder() ConstraintBuilder {
+	out := constraintBuilder{
+		version:       nil,
+		precision:     0,
+		isCompatible:  false,
+		isApproximate: false,
+		isLatest:      false,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *constraintBuilder) Create() ConstraintBuilder {
+	return createConstraintBuilder()
+}
+
+// WithVersion adds a version to the builder
+func (app *constraintBuilder) WithVersion(version Version) ConstraintBuilder {
+	app.version = version
+	return app
+}
+
+// WithPrecision adds a precision to the builder
+func (app *constraintBuilder) WithPrecision(precision uint) ConstraintBuilder {
+	app.precision = precision
+	return app
+}
+
+// IsCompatible flags the builder as compatible
+func (app *constraintBuilder) IsCompatible() ConstraintBuilder {
+	app.isCompatible = true
+	return app
+}
+
+// IsApproximate flags the builder as approximate
+func (app *constraintBuilder) IsApproximate() ConstraintBuilder {
+	app.isApproximate = true
+	return app
+}
+
+// IsLatest flags the builder as latest
+func (app *constraintBuilder) IsLatest() ConstraintBuilder {
+	app.isLatest = true
+	return app
+}
+
+// Now builds a new Constraint instance
+func (app *constraintBuilder) Now() (Constraint, error) {
+	if app.isLatest {
+		if app.version != nil || app.isCompatible || app.isApproximate {
+			return nil, errors.New("the latest Constraint cannot contain a version")
+		}
+
+		return createConstraintWithLatest(), nil
+	}
+
+	if app.version == nil {
+		return nil, errors.New("the version is mandatory in order to build a Constraint instance")
+	}
+
+	if app.isCompatible && app.isApproximate {
+		return nil, errors.New("the Constraint cannot be both compatible and approximate")
+	}
+
+	if app.precision == 0 {
+		app.precision = maxPrecision
+	}
+
+	if app.precision > maxPrecision {
+		str := fmt.Sprintf("the precision (%d) of the Constraint cannot be greater than %d", app.precision, maxPrecision)
+		return nil, errors.New(str)
+	}
+
+	return createConstraintWithVersion(
+		app.version,
+		app.precision,
+		app.isCompatible,
+		app.isApproximate,
+	), nil
+}
@@ -0,0 +1,83 @@
+package versions
+
+const partSeparator = "."
+const latestKeyword = "latest"
+const compatiblePrefix = "^"
+const approximatePrefix = "~"
+const maxPrecision = 3
+
+// NewAdapter creates a new adapter
+func NewAdapter() Adapter {
+	builder := NewBuilder()
+	constraintBuilder := NewConstraintBuilder()
+	return createAdapter(
+		builder,
+		constraintBuilder,
+	)
+}
+
+// NewBuilder creates a new version builder
+func NewBuilder() Builder {
+	return createBuilder()
+}
+
+// NewConstraintBuilder creates a new constraint builder
+func NewConstraintBuilder() ConstraintBuilder {
+	return createConstraintBuilder()
+}
+
+// Adapter represents the versions adapter
+type Adapter interface {
+	// ToVersion converts the input, such as 1, 1.2 or 1.2.3, to a version
+	ToVersion(input []byte) (Version, error)
+
+	// ToConstraint converts the input, such as 1.2, ^1, ~1.2 or latest, to a constraint
+	ToConstraint(input []byte) (Constraint, error)
+}
+
+// Builder represents the version builder
+type Builder interface {
+	Create() Builder
+	WithMajor(major uint) Builder
+	WithMinor(minor uint) Builder
+	WithPatch(patch uint) Builder
+	Now() (Version, error)
+}
+
+// Version represents a semantic version
+type Version interface {
+	Major() uint
+	Minor() uint
+	Patch() uint
+	Compare(version Version) int
+	String() string
+}
+
+// ConstraintBuilder represents the constraint builder
+type ConstraintBuilder interface {
+	Create() ConstraintBuilder
+	WithVersion(version Version) ConstraintBuilder
+	WithPrecision(precision uint) ConstraintBuilder
+	IsCompatible() ConstraintBuilder
+	IsApproximate() ConstraintBuilder
+	IsLatest() ConstraintBuilder
+	Now() (Constraint, error)
+}
+
+// Constraint represents a version constraint:
+//   - a version without prefix is exact, its missing parts being 0, so 1 only matches 1.0.0
+//   - a compatible version, prefixed by ^, matches the versions that do not change its left-most non-zero part
+//   - an approximate version, prefixed by ~, matches the versions that do not change its major and minor parts
+//   - latest matches every version
+type Constraint interface {
+	IsLatest() bool
+	HasVersion() bool
+	Version() Version
+	Precision() uint
+	IsCompatible() bool
+	IsApproximate() bool
+	IsExact() bool
+	Matches(version Version) bool
+	Resolve(list []Version) (Version, error)
+	String() string
+}
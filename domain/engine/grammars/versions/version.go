@@ -0,0 +1,60 @@
+package versions
+
+import "fmt"
+
+type version struct {
+	major uint
+	minor uint
+	patch uint
+}
+
+func createVersion(
+	major uint,
+	minor uint,
+	patch uint,
+) Version {
+	out := version{
+		major: major,
+		minor: minor,
+		patch: patch,
+	}
+
+	return &out
+}
+
+// Major returns the major
+func (obj *version) Major() uint {
+	return obj.major
+}
+
+// Minor returns the minor
+func (obj *version) Minor() uint {
+	return obj.minor
+}
+
+// Patch returns the patch
+func (obj *version) Patch() uint {
+	return obj.patch
+}
+
+// Compare returns -1 when the version is lower than the provided version, 1 when it is higher and 0 when they are equal
+func (obj *version) Compare(version Version) int {
+	current := []uint{obj.major, obj.minor, obj.patch}
+	other := []uint{version.Major(), version.Minor(), version.Patch()}
+	for idx := range current {
+		if current[idx] < other[idx] {
+			return -1
+		}
+
+		if current[idx] > other[idx] {
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// String returns the version as a string
+func (obj *version) String() string {
+	return fmt.Sprintf("%d%s%d%s%d", obj.major, partSeparator, obj.minor, partSeparator, obj.patch)
+}
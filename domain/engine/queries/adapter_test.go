@@ -2,6 +2,10 @@ package queries
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/steve-care-software/grammars/domain/engine/grammars"
@@ -44,3 +48,44 @@ func TestAdapter_Success(t *testing.T) {
 		return
 	}
 }
+
+func TestAdapter_concurrent_Success(t *testing.T) {
+	adapter, err := NewAdapterFactory(
+		grammars.NewRepositoryMemory(map[string]grammars.Grammar{}),
+	).Create()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	amount := 16
+	errs := make(chan error, amount)
+	waitGroup := sync.WaitGroup{}
+	for i := 0; i < amount; i++ {
+		waitGroup.Add(1)
+		go func(idx int) {
+			defer waitGroup.Done()
+			name := fmt.Sprintf("mySelector%s", strings.Repeat("a", idx))
+			retQuery, _, err := adapter.ToQuery([]byte(fmt.Sprintf(`
+				v1;
+				name: %s;
+				myChain[0][%d]->RULE;`, name, idx)))
+
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			if retQuery.Name() != name {
+				errs <- errors.New(fmt.Sprintf("the name was expected to be '%s', '%s' returned", name, retQuery.Name()))
+			}
+		}(i)
+	}
+
+	waitGroup.Wait()
+	close(errs)
+	for oneErr := range errs {
+		t.Errorf("the error was expected to be nil, error returned: %s", oneErr.Error())
+		return
+	}
+}
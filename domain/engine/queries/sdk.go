@@ -43,7 +43,7 @@ type AdapterFactory interface {
 	Create() (Adapter, error)
 }
 
-// Adapter represents an adapter
+// Adapter represents an adapter, it is safe for concurrent use
 type Adapter interface {
 	ToQuery(input []byte) (Query, []byte, error)
 }
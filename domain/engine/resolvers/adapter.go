@@ -0,0 +1,158 @@
+package resolvers
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+)
+
+type jsonLock struct {
+	Requirements []jsonRequirement `json:"requirements"`
+	Dependencies []jsonDependency  `json:"dependencies"`
+}
+
+type jsonDependency struct {
+	Path         string            `json:"path"`
+	Version      string            `json:"version"`
+	Hash         string            `json:"hash"`
+	Requirements []jsonRequirement `json:"requirements,omitempty"`
+}
+
+type jsonRequirement struct {
+	Path       string `json:"path"`
+	Constraint string `json:"constraint"`
+	Version    string `json:"version"`
+}
+
+type adapter struct {
+	versionAdapter versions.Adapter
+}
+
+func createAdapter(
+	versionAdapter versions.Adapter,
+) Adapter {
+	out := adapter{
+		versionAdapter: versionAdapter,
+	}
+
+	return &out
+}
+
+// ToBytes converts a lock to its lockfile
+func (app *adapter) ToBytes(lock Lock) ([]byte, error) {
+	output := jsonLock{
+		Requirements: toJSONRequirements(lock.Requirements()),
+		Dependencies: []jsonDependency{},
+	}
+
+	for _, oneDependency := range lock.Dependencies() {
+		dependency := jsonDependency{
+			Path:    joinPath(oneDependency.Path()),
+			Version: oneDependency.Version().String(),
+			Hash:    hex.EncodeToString(oneDependency.Hash()),
+		}
+
+		if oneDependency.HasRequirements() {
+			dependency.Requirements = toJSONRequirements(oneDependency.Requirements())
+		}
+
+		output.Dependencies = append(output.Dependencies, dependency)
+	}
+
+	return json.MarshalIndent(output, "", "\t")
+}
+
+// ToLock converts a lockfile to a lock
+func (app *adapter) ToLock(input []byte) (Lock, error) {
+	decoded := jsonLock{}
+	err := json.Unmarshal(input, &decoded)
+	if err != nil {
+		return nil, err
+	}
+
+	requirements, err := app.toRequirements(decoded.Requirements)
+	if err != nil {
+		return nil, err
+	}
+
+	dependencies := []Dependency{}
+	for idx, oneDependency := range decoded.Dependencies {
+		version, err := app.versionAdapter.ToVersion([]byte(oneDependency.Version))
+		if err != nil {
+			str := fmt.Sprintf("the dependency (index: %d) contains an invalid version: %s", idx, err.Error())
+			return nil, errors.New(str)
+		}
+
+		hash, err := hex.DecodeString(oneDependency.Hash)
+		if err != nil {
+			str := fmt.Sprintf("the dependency (index: %d) contains an invalid hash: %s", idx, err.Error())
+			return nil, errors.New(str)
+		}
+
+		path := splitPath(oneDependency.Path)
+		if len(oneDependency.Requirements) <= 0 {
+			dependencies = append(dependencies, createDependency(path, version, hash))
+			continue
+		}
+
+		dependencyRequirements, err := app.toRequirements(oneDependency.Requirements)
+		if err != nil {
+			return nil, err
+		}
+
+		dependencies = append(dependencies, createDependencyWithRequirements(path, version, hash, dependencyRequirements))
+	}
+
+	return createLock(requirements, dependencies), nil
+}
+
+func (app *adapter) toRequirements(list []jsonRequirement) ([]Requirement, error) {
+	output := []Requirement{}
+	for idx, oneRequirement := range list {
+		constraint, err := app.versionAdapter.ToConstraint([]byte(oneRequirement.Constraint))
+		if err != nil {
+			str := fmt.Sprintf("the requirement (index: %d) contains an invalid constraint: %s", idx, err.Error())
+			return nil, errors.New(str)
+		}
+
+		version, err := app.versionAdapter.ToVersion([]byte(oneRequirement.Version))
+		if err != nil {
+			str := fmt.Sprintf("the requirement (index: %d) contains an invalid version: %s", idx, err.Error())
+			return nil, errors.New(str)
+		}
+
+		output = append(output, createRequirement(splitPath(oneRequirement.Path), constraint, version))
+	}
+
+	return output, nil
+}
+
+func toJSONRequirements(list []Requirement) []jsonRequirement {
+	output := []jsonRequirement{}
+	for _, oneRequirement := range list {
+		output = append(output, jsonRequirement{
+			Path:       joinPath(oneRequirement.Path()),
+			Constraint: oneRequirement.Constraint().String(),
+			Version:    oneRequirement.Version().String(),
+		})
+	}
+
+	return output
+}
+
+func splitPath(path string) []string {
+	output := []string{}
+	for _, oneSegment := range strings.Split(path, pathSeparator) {
+		if oneSegment == "" {
+			continue
+		}
+
+		output = append(output, oneSegment)
+	}
+
+	return output
+}
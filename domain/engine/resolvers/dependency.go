@@ -0,0 +1,68 @@
+package resolvers
+
+import "github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+
+type dependency struct {
+	path         []string
+	version      versions.Version
+	hash         []byte
+	requirements []Requirement
+}
+
+func createDependency(
+	path []string,
+	version versions.Version,
+	hash []byte,
+) Dependency {
+	return createDependencyInternally(path, version, hash, nil)
+}
+
+func createDependencyWithRequirements(
+	path []string,
+	version versions.Version,
+	hash []byte,
+	requirements []Requirement,
+) Dependency {
+	return createDependencyInternally(path, version, hash, requirements)
+}
+
+func createDependencyInternally(
+	path []string,
+	version versions.Version,
+	hash []byte,
+	requirements []Requirement,
+) Dependency {
+	out := dependency{
+		path:         path,
+		version:      version,
+		hash:         hash,
+		requirements: requirements,
+	}
+
+	return &out
+}
+
+// Path returns the path
+func (obj *dependency) Path() []string {
+	return obj.path
+}
+
+// Version returns the version
+func (obj *dependency) Version() versions.Version {
+	return obj.version
+}
+
+// Hash returns the sha256 hash of the canonical representation of the grammar
+func (obj *dependency) Hash() []byte {
+	return obj.hash
+}
+
+// HasRequirements returns true if there is requirements, false otherwise
+func (obj *dependency) HasRequirements() bool {
+	return obj.requirements != nil
+}
+
+// Requirements returns the requirements, if any
+func (obj *dependency) Requirements() []Requirement {
+	return obj.requirements
+}
@@ -0,0 +1,66 @@
+package resolvers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+)
+
+type lock struct {
+	requirements []Requirement
+	dependencies []Dependency
+}
+
+func createLock(
+	requirements []Requirement,
+	dependencies []Dependency,
+) Lock {
+	out := lock{
+		requirements: requirements,
+		dependencies: dependencies,
+	}
+
+	return &out
+}
+
+// Requirements returns the requirements of the locked grammar
+func (obj *lock) Requirements() []Requirement {
+	return obj.requirements
+}
+
+// Dependencies returns the dependencies, sorted by path then version
+func (obj *lock) Dependencies() []Dependency {
+	return obj.dependencies
+}
+
+// Fetch fetches the dependency a requirement of the graph resolved the path and constraint to
+func (obj *lock) Fetch(path []string, constraint versions.Constraint) (Dependency, error) {
+	pathStr := joinPath(path)
+	requirements := append([]Requirement{}, obj.requirements...)
+	for _, oneDependency := range obj.dependencies {
+		if oneDependency.HasRequirements() {
+			requirements = append(requirements, oneDependency.Requirements()...)
+		}
+	}
+
+	for _, oneRequirement := range requirements {
+		if joinPath(oneRequirement.Path()) != pathStr || oneRequirement.Constraint().String() != constraint.String() {
+			continue
+		}
+
+		for _, oneDependency := range obj.dependencies {
+			if joinPath(oneDependency.Path()) == pathStr && oneDependency.Version().Compare(oneRequirement.Version()) == 0 {
+				return oneDependency, nil
+			}
+		}
+	}
+
+	str := fmt.Sprintf("the grammar (path: %s, constraint: %s) is not pinned by the lock", pathStr, constraint.String())
+	return nil, errors.New(str)
+}
+
+func joinPath(path []string) string {
+	return fmt.Sprintf("%s%s", pathSeparator, strings.Join(path, pathSeparator))
+}
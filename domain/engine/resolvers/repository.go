@@ -0,0 +1,115 @@
+package resolvers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements/references"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+)
+
+type repository struct {
+	grammarAdapter    grammars.Adapter
+	referenceBuilder  references.Builder
+	constraintBuilder versions.ConstraintBuilder
+	lock              Lock
+	repository        grammars.Repository
+}
+
+func createRepository(
+	grammarAdapter grammars.Adapter,
+	referenceBuilder references.Builder,
+	constraintBuilder versions.ConstraintBuilder,
+	lock Lock,
+	repositoryIns grammars.Repository,
+) grammars.Repository {
+	out := repository{
+		grammarAdapter:    grammarAdapter,
+		referenceBuilder:  referenceBuilder,
+		constraintBuilder: constraintBuilder,
+		lock:              lock,
+		repository:        repositoryIns,
+	}
+
+	return &out
+}
+
+// Init initializes the underlying repository
+func (app *repository) Init() error {
+	return app.repository.Init()
+}
+
+// List lists the grammar paths and their sorted versions of the underlying repository
+func (app *repository) List() (map[string][]versions.Version, error) {
+	return app.repository.List()
+}
+
+// Insert inserts a grammar in the underlying repository
+func (app *repository) Insert(path []string, grammar grammars.Grammar) error {
+	return app.repository.Insert(path, grammar)
+}
+
+// Retrieve retrieves the grammar version pinned by the lock and verifies its hash
+func (app *repository) Retrieve(reference references.Reference) (grammars.Grammar, error) {
+	pinned, err := app.pin(reference)
+	if err != nil {
+		return nil, err
+	}
+
+	grammar, err := app.repository.Retrieve(pinned)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := app.grammarAdapter.ToCanonical(grammar)
+	if err != nil {
+		return nil, err
+	}
+
+	dependency, err := app.lock.Fetch(reference.Path(), reference.Constraint())
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(content)
+	if !bytes.Equal(hash[:], dependency.Hash()) {
+		str := fmt.Sprintf("the grammar (path: %s, version: %s) does not match the hash of the lock", joinPath(reference.Path()), dependency.Version().String())
+		return nil, errors.New(str)
+	}
+
+	return grammar, nil
+}
+
+// Delete deletes the grammar version pinned by the lock from the underlying repository
+func (app *repository) Delete(reference references.Reference) error {
+	pinned, err := app.pin(reference)
+	if err != nil {
+		return err
+	}
+
+	return app.repository.Delete(pinned)
+}
+
+func (app *repository) pin(reference references.Reference) (references.Reference, error) {
+	dependency, err := app.lock.Fetch(reference.Path(), reference.Constraint())
+	if err != nil {
+		return nil, err
+	}
+
+	constraint, err := app.constraintBuilder.Create().
+		WithVersion(dependency.Version()).
+		Now()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return app.referenceBuilder.Create().
+		WithPath(reference.Path()).
+		WithName(reference.Name()).
+		WithConstraint(constraint).
+		Now()
+}
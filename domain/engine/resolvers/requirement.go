@@ -0,0 +1,38 @@
+package resolvers
+
+import "github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+
+type requirement struct {
+	path       []string
+	constraint versions.Constraint
+	version    versions.Version
+}
+
+func createRequirement(
+	path []string,
+	constraint versions.Constraint,
+	version versions.Version,
+) Requirement {
+	out := requirement{
+		path:       path,
+		constraint: constraint,
+		version:    version,
+	}
+
+	return &out
+}
+
+// Path returns the path
+func (obj *requirement) Path() []string {
+	return obj.path
+}
+
+// Constraint returns the constraint
+func (obj *requirement) Constraint() versions.Constraint {
+	return obj.constraint
+}
+
+// Version returns the resolved version
+func (obj *requirement) Version() versions.Version {
+	return obj.version
+}
@@ -0,0 +1,165 @@
+package resolvers
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements/references"
+)
+
+const chainSeparator = " -> "
+
+type resolution struct {
+	dependencies map[string]Dependency
+	chain        []string
+}
+
+type resolver struct {
+	grammarAdapter grammars.Adapter
+	repository     grammars.Repository
+}
+
+func createResolver(
+	grammarAdapter grammars.Adapter,
+	repository grammars.Repository,
+) Resolver {
+	out := resolver{
+		grammarAdapter: grammarAdapter,
+		repository:     repository,
+	}
+
+	return &out
+}
+
+// Resolve walks the references of the grammar recursively and returns its lock
+func (app *resolver) Resolve(grammar grammars.Grammar) (Lock, error) {
+	session := resolution{
+		dependencies: map[string]Dependency{},
+		chain:        []string{},
+	}
+
+	requirements, err := app.resolve(&session, grammar)
+	if err != nil {
+		return nil, err
+	}
+
+	dependencies := []Dependency{}
+	for _, oneDependency := range session.dependencies {
+		dependencies = append(dependencies, oneDependency)
+	}
+
+	sort.Slice(dependencies, func(i, j int) bool {
+		first := joinPath(dependencies[i].Path())
+		second := joinPath(dependencies[j].Path())
+		if first != second {
+			return first < second
+		}
+
+		return dependencies[i].Version().Compare(dependencies[j].Version()) < 0
+	})
+
+	return createLock(requirements, dependencies), nil
+}
+
+func (app *resolver) resolve(session *resolution, grammar grammars.Grammar) ([]Requirement, error) {
+	output := []Requirement{}
+	for _, oneReference := range grammarReferences(grammar) {
+		path := oneReference.Path()
+		constraint := oneReference.Constraint()
+		retGrammar, err := app.repository.Retrieve(oneReference)
+		if err != nil {
+			str := fmt.Sprintf("the grammar (path: %s, constraint: %s) could not be resolved: %s", joinPath(path), constraint.String(), err.Error())
+			if len(session.chain) > 0 {
+				str = fmt.Sprintf("%s, required by: %s", str, strings.Join(session.chain, chainSeparator))
+			}
+
+			return nil, errors.New(str)
+		}
+
+		version := retGrammar.Version()
+		output = append(output, createRequirement(path, constraint, version))
+
+		keyname := fmt.Sprintf("%s@%s", joinPath(path), version.String())
+		for idx, oneKeyname := range session.chain {
+			if oneKeyname != keyname {
+				continue
+			}
+
+			cycle := append(append([]string{}, session.chain[idx:]...), keyname)
+			str := fmt.Sprintf("the references contain a cycle: %s", strings.Join(cycle, chainSeparator))
+			return nil, errors.New(str)
+		}
+
+		if _, ok := session.dependencies[keyname]; ok {
+			continue
+		}
+
+		session.chain = append(session.chain, keyname)
+		requirements, err := app.resolve(session, retGrammar)
+		session.chain = session.chain[:len(session.chain)-1]
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := app.grammarAdapter.ToCanonical(retGrammar)
+		if err != nil {
+			return nil, err
+		}
+
+		hash := sha256.Sum256(content)
+		if len(requirements) > 0 {
+			session.dependencies[keyname] = createDependencyWithRequirements(path, version, hash[:], requirements)
+			continue
+		}
+
+		session.dependencies[keyname] = createDependency(path, version, hash[:])
+	}
+
+	return output, nil
+}
+
+func grammarReferences(grammar grammars.Grammar) []references.Reference {
+	elementsList := []elements.Element{
+		grammar.Root(),
+	}
+
+	if grammar.HasOmissions() {
+		elementsList = append(elementsList, grammar.Omissions().List()...)
+	}
+
+	for _, oneBlock := range grammar.Blocks().List() {
+		for _, oneLine := range oneBlock.Lines().List() {
+			for _, oneToken := range oneLine.Tokens().List() {
+				elementsList = append(elementsList, oneToken.Element())
+				if oneToken.HasReverse() && oneToken.Reverse().HasEscape() {
+					elementsList = append(elementsList, oneToken.Reverse().Escape())
+				}
+			}
+		}
+	}
+
+	// the same reference is only resolved once per grammar:
+	output := []references.Reference{}
+	keynames := map[string]bool{}
+	for _, oneElement := range elementsList {
+		if !oneElement.IsReference() {
+			continue
+		}
+
+		reference := oneElement.Reference()
+		keyname := fmt.Sprintf("%s@%s", joinPath(reference.Path()), reference.Constraint().String())
+		if keynames[keyname] {
+			continue
+		}
+
+		keynames[keyname] = true
+		output = append(output, reference)
+	}
+
+	return output
+}
@@ -0,0 +1,284 @@
+package resolvers
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+)
+
+func TestResolver_Success(t *testing.T) {
+	repository := grammars.NewRepositoryMemory(map[string]grammars.Grammar{})
+	err := insert(repository, "/lang/digit", `
+		v1;
+		> .digit;
+		# .SPACE;
+
+		digit: .N_ZERO;
+
+		N_ZERO: "0";
+		SPACE: " ";
+	`)
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	for _, oneVersion := range []string{"1", "1.2"} {
+		err = insert(repository, "/lang/value", fmt.Sprintf(`
+			v%s;
+			> .value;
+			# .SPACE;
+
+			value: .digit[/lang/digit, ^1];
+
+			SPACE: " ";
+		`, oneVersion))
+
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+	}
+
+	grammar, _, err := grammars.NewAdapter().ToGrammar([]byte(`
+		v1;
+		> .assignment;
+		# .SPACE;
+
+		assignment: .VARIABLE .EQUAL .value[/lang/value, ^1];
+
+		VARIABLE: "myVariable";
+		EQUAL: "=";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	lock, err := NewResolver(repository).Resolve(grammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	requirements := lock.Requirements()
+	if len(requirements) != 1 || requirements[0].Version().String() != "1.2.0" {
+		t.Errorf("the lock was expected to resolve the requirement to the version 1.2.0")
+		return
+	}
+
+	dependencies := lock.Dependencies()
+	if len(dependencies) != 2 {
+		t.Errorf("the lock was expected to contain %d dependencies, %d returned", 2, len(dependencies))
+		return
+	}
+
+	if joinPath(dependencies[0].Path()) != "/lang/digit" || dependencies[0].HasRequirements() {
+		t.Errorf("the first dependency was expected to be the digit grammar, without requirements")
+		return
+	}
+
+	if joinPath(dependencies[1].Path()) != "/lang/value" || !dependencies[1].HasRequirements() {
+		t.Errorf("the second dependency was expected to be the value grammar, with requirements")
+		return
+	}
+
+	adapter := NewAdapter()
+	lockfile, err := adapter.ToBytes(lock)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retLock, err := adapter.ToLock(lockfile)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retLockfile, err := adapter.ToBytes(retLock)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !bytes.Equal(lockfile, retLockfile) {
+		t.Errorf("the lockfile was expected to be:\n%s\n\n%s returned", lockfile, retLockfile)
+		return
+	}
+
+	// a newer compatible version does not change what the pinned repository retrieves:
+	err = insert(repository, "/lang/value", `
+		v1.5;
+		> .value;
+		# .SPACE;
+
+		value: .digit[/lang/digit, ^1];
+
+		SPACE: " ";
+	`)
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	parserAdapter := asts.NewAdapter(NewRepository(retLock, repository))
+	retAST, _, err := parserAdapter.ToAST(grammar, []byte("myVariable = 0"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retToken, err := retAST.Root().Instruction().Tokens().Fetch("value", 0)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retElement, err := retToken.Elements().Fetch(0)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !retElement.IsAST() || retElement.AST().Version().String() != "1.2.0" {
+		t.Errorf("the pinned repository was expected to retrieve the version 1.2.0")
+		return
+	}
+
+	// a grammar whose content changed no longer matches the hash of the lock:
+	err = insert(repository, "/lang/digit", `
+		v1;
+		> .digit;
+		# .SPACE;
+
+		digit: .N_ONE;
+
+		N_ONE: "1";
+		SPACE: " ";
+	`)
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, _, err = parserAdapter.ToAST(grammar, []byte("myVariable = 1"))
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestResolver_withMissingVersion_returnsError(t *testing.T) {
+	repository := grammars.NewRepositoryMemory(map[string]grammars.Grammar{})
+	err := insert(repository, "/lang/value", `
+		v1;
+		> .value;
+		# .SPACE;
+
+		value: .N_ZERO;
+
+		N_ZERO: "0";
+		SPACE: " ";
+	`)
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	grammar, _, err := grammars.NewAdapter().ToGrammar([]byte(`
+		v1;
+		> .assignment;
+		# .SPACE;
+
+		assignment: .VARIABLE .value[/lang/value, ^2];
+
+		VARIABLE: "myVariable";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = NewResolver(repository).Resolve(grammar)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestResolver_withCycle_returnsError(t *testing.T) {
+	repository := grammars.NewRepositoryMemory(map[string]grammars.Grammar{})
+	inputs := map[string]string{
+		"/lang/first":  "second",
+		"/lang/second": "first",
+	}
+
+	for path, referenced := range inputs {
+		err := insert(repository, path, fmt.Sprintf(`
+			v1;
+			> .value;
+			# .SPACE;
+
+			value: .N_ZERO .%s[/lang/%s, 1]
+				 | .N_ZERO
+				 ;
+
+			N_ZERO: "0";
+			SPACE: " ";
+		`, referenced, referenced))
+
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+	}
+
+	grammar, _, err := grammars.NewAdapter().ToGrammar([]byte(`
+		v1;
+		> .assignment;
+		# .SPACE;
+
+		assignment: .VARIABLE .first[/lang/first, latest];
+
+		VARIABLE: "myVariable";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = NewResolver(repository).Resolve(grammar)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	if !strings.Contains(err.Error(), "/lang/first@1.0.0 -> /lang/second@1.0.0 -> /lang/first@1.0.0") {
+		t.Errorf("the error was expected to describe the cycle, %s returned", err.Error())
+		return
+	}
+}
+
+func insert(repository grammars.Repository, path string, input string) error {
+	grammar, _, err := grammars.NewAdapter().ToGrammar([]byte(input))
+	if err != nil {
+		return err
+	}
+
+	return repository.Insert(splitPath(path), grammar)
+}
@@ -0,0 +1,84 @@
+package resolvers
+
+import (
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements/references"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+)
+
+const pathSeparator = "/"
+
+// NewResolver creates a new resolver that retrieves the referenced grammars from the repository
+func NewResolver(
+	repository grammars.Repository,
+) Resolver {
+	grammarAdapter := grammars.NewAdapter()
+	return createResolver(
+		grammarAdapter,
+		repository,
+	)
+}
+
+// NewAdapter creates a new lockfile adapter
+func NewAdapter() Adapter {
+	versionAdapter := versions.NewAdapter()
+	return createAdapter(
+		versionAdapter,
+	)
+}
+
+// NewRepository creates a new repository that only retrieves the grammars pinned by the lock
+func NewRepository(
+	lock Lock,
+	repository grammars.Repository,
+) grammars.Repository {
+	grammarAdapter := grammars.NewAdapter()
+	referenceBuilder := references.NewBuilder()
+	constraintBuilder := versions.NewConstraintBuilder()
+	return createRepository(
+		grammarAdapter,
+		referenceBuilder,
+		constraintBuilder,
+		lock,
+		repository,
+	)
+}
+
+// Adapter represents the lockfile adapter
+type Adapter interface {
+	// ToBytes converts a lock to its lockfile
+	ToBytes(lock Lock) ([]byte, error)
+
+	// ToLock converts a lockfile to a lock
+	ToLock(input []byte) (Lock, error)
+}
+
+// Resolver resolves the grammars referenced by a grammar
+type Resolver interface {
+	// Resolve walks the references of the grammar recursively and returns its lock, it returns an error
+	// when a referenced version is missing or when the references contain a cycle
+	Resolve(grammar grammars.Grammar) (Lock, error)
+}
+
+// Lock represents the resolved dependency graph of a grammar
+type Lock interface {
+	Requirements() []Requirement
+	Dependencies() []Dependency
+	Fetch(path []string, constraint versions.Constraint) (Dependency, error)
+}
+
+// Dependency represents a resolved grammar
+type Dependency interface {
+	Path() []string
+	Version() versions.Version
+	Hash() []byte
+	HasRequirements() bool
+	Requirements() []Requirement
+}
+
+// Requirement represents a reference, resolved to a version
+type Requirement interface {
+	Path() []string
+	Constraint() versions.Constraint
+	Version() versions.Version
+}
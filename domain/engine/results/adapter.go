@@ -0,0 +1,182 @@
+package results
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Tests    uint             `xml:"tests,attr"`
+	Failures uint             `xml:"failures,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    uint            `xml:"tests,attr"`
+	Failures uint            `xml:"failures,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Content string `xml:",chardata"`
+}
+
+type adapter struct {
+}
+
+func createAdapter() Adapter {
+	out := adapter{}
+	return &out
+}
+
+// ToJUnit renders the results as a JUnit XML document
+func (app *adapter) ToJUnit(results Results) ([]byte, error) {
+	suites := []junitTestSuite{}
+	suitesIndex := map[string]int{}
+	totalFailures := uint(0)
+	list := results.List()
+	for _, oneResult := range list {
+		block := oneResult.Block()
+		if _, ok := suitesIndex[block]; !ok {
+			suitesIndex[block] = len(suites)
+			suites = append(suites, junitTestSuite{
+				Name:  block,
+				Cases: []junitTestCase{},
+			})
+		}
+
+		testCase := junitTestCase{
+			Name:      oneResult.Name(),
+			ClassName: block,
+			Time:      seconds(oneResult.Duration()),
+		}
+
+		if oneResult.HasRemaining() {
+			testCase.SystemOut = fmt.Sprintf("remaining: %s", oneResult.Remaining())
+		}
+
+		idx := suitesIndex[block]
+		if !oneResult.IsSuccess() {
+			message := failureMessage(oneResult)
+			content := message
+			if oneResult.HasDiff() {
+				content = fmt.Sprintf("%s\n%s", message, oneResult.Diff())
+			}
+
+			testCase.Failure = &junitFailure{
+				Message: message,
+				Content: content,
+			}
+
+			suites[idx].Failures++
+			totalFailures++
+		}
+
+		suites[idx].Tests++
+		suites[idx].Cases = append(suites[idx].Cases, testCase)
+	}
+
+	for idx, oneSuite := range suites {
+		duration := time.Duration(0)
+		for _, oneResult := range list {
+			if oneResult.Block() == oneSuite.Name {
+				duration += oneResult.Duration()
+			}
+		}
+
+		suites[idx].Time = seconds(duration)
+	}
+
+	output, err := xml.MarshalIndent(junitTestSuites{
+		Tests:    uint(len(list)),
+		Failures: totalFailures,
+		Time:     seconds(results.Duration()),
+		Suites:   suites,
+	}, "", "\t")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), output...), nil
+}
+
+// ToTAP renders the results using the Test Anything Protocol
+func (app *adapter) ToTAP(results Results) ([]byte, error) {
+	buffer := bytes.Buffer{}
+	list := results.List()
+	buffer.WriteString("TAP version 13\n")
+	buffer.WriteString(fmt.Sprintf("1..%d\n", len(list)))
+	for idx, oneResult := range list {
+		status := "ok"
+		if !oneResult.IsSuccess() {
+			status = "not ok"
+		}
+
+		buffer.WriteString(fmt.Sprintf("%s %d - %s/%s\n", status, idx+1, oneResult.Block(), oneResult.Name()))
+		if oneResult.IsSuccess() {
+			continue
+		}
+
+		buffer.WriteString("  ---\n")
+		buffer.WriteString(fmt.Sprintf("  message: %q\n", failureMessage(oneResult)))
+		buffer.WriteString(fmt.Sprintf("  expected: %s\n", outcome(oneResult.IsFail())))
+		buffer.WriteString(fmt.Sprintf("  actual: %s\n", outcome(oneResult.HasFailed())))
+		if oneResult.HasRemaining() {
+			buffer.WriteString(fmt.Sprintf("  remaining: %q\n", oneResult.Remaining()))
+		}
+
+		if oneResult.HasDiff() {
+			buffer.WriteString("  diff: |\n")
+			for _, oneLine := range strings.Split(strings.TrimSuffix(string(oneResult.Diff()), "\n"), "\n") {
+				buffer.WriteString(fmt.Sprintf("    %s\n", oneLine))
+			}
+		}
+
+		buffer.WriteString(fmt.Sprintf("  duration_ms: %.3f\n", float64(oneResult.Duration())/float64(time.Millisecond)))
+		buffer.WriteString("  ...\n")
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func failureMessage(result Result) string {
+	if result.IsFail() {
+		return "the suite was expected to FAIL but succeeded"
+	}
+
+	message := "the suite was expected to SUCCEED but failed"
+	if result.HasError() {
+		message = fmt.Sprintf("%s --- error: %s", message, result.Error().Error())
+	}
+
+	return message
+}
+
+func outcome(hasFailed bool) string {
+	if hasFailed {
+		return "fail"
+	}
+
+	return "success"
+}
+
+func seconds(duration time.Duration) string {
+	return fmt.Sprintf("%.6f", duration.Seconds())
+}
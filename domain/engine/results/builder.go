@@ -0,0 +1,35 @@
+package results
+
+import "errors"
+
+type builder struct {
+	list []Result
+}
+
+func createBuilder() Builder {
+	out := builder{
+		list: nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *builder) Create() Builder {
+	return createBuilder()
+}
+
+// WithList adds a list to the builder
+func (app *builder) WithList(list []Result) Builder {
+	app.list = list
+	return app
+}
+
+// Now builds a new Results instance
+func (app *builder) Now() (Results, error) {
+	if app.list == nil {
+		return nil, errors.New("the list is mandatory in order to build a Results instance")
+	}
+
+	return createResults(app.list), nil
+}
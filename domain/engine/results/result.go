@@ -0,0 +1,103 @@
+package results
+
+import "time"
+
+type result struct {
+	block     string
+	index     uint
+	name      string
+	remaining []byte
+	err       error
+	diff      []byte
+	duration  time.Duration
+	isFail    bool
+}
+
+func createResult(
+	block string,
+	index uint,
+	name string,
+	remaining []byte,
+	err error,
+	diff []byte,
+	duration time.Duration,
+	isFail bool,
+) Result {
+	out := result{
+		block:     block,
+		index:     index,
+		name:      name,
+		remaining: remaining,
+		err:       err,
+		diff:      diff,
+		duration:  duration,
+		isFail:    isFail,
+	}
+
+	return &out
+}
+
+// Block returns the block
+func (obj *result) Block() string {
+	return obj.block
+}
+
+// Index returns the index of the suite in its block
+func (obj *result) Index() uint {
+	return obj.index
+}
+
+// Name returns the name
+func (obj *result) Name() string {
+	return obj.name
+}
+
+// IsFail returns true if the suite was expected to fail, false otherwise
+func (obj *result) IsFail() bool {
+	return obj.isFail
+}
+
+// HasFailed returns true if the suite failed, false otherwise
+func (obj *result) HasFailed() bool {
+	return obj.err != nil
+}
+
+// IsSuccess returns true if the actual outcome matches the expected outcome, false otherwise
+func (obj *result) IsSuccess() bool {
+	return obj.isFail == obj.HasFailed()
+}
+
+// HasRemaining returns true if there is remaining bytes, false otherwise
+func (obj *result) HasRemaining() bool {
+	return obj.remaining != nil
+}
+
+// Remaining returns the remaining bytes, if any
+func (obj *result) Remaining() []byte {
+	return obj.remaining
+}
+
+// HasError returns true if there is an error, false otherwise
+func (obj *result) HasError() bool {
+	return obj.err != nil
+}
+
+// Error returns the error, if any
+func (obj *result) Error() error {
+	return obj.err
+}
+
+// HasDiff returns true if there is an expected AST snapshot diff, false otherwise
+func (obj *result) HasDiff() bool {
+	return obj.diff != nil
+}
+
+// Diff returns the expected AST snapshot diff, if any
+func (obj *result) Diff() []byte {
+	return obj.diff
+}
+
+// Duration returns the duration
+func (obj *result) Duration() time.Duration {
+	return obj.duration
+}
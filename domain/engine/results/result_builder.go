@@ -0,0 +1,123 @@
+package results
+
+import (
+	"errors"
+	"time"
+)
+
+type resultBuilder struct {
+	block     string
+	pIndex    *uint
+	name      string
+	remaining []byte
+	err       error
+	diff      []byte
+	duration  time.Duration
+	isFail    bool
+}
+
+func createResultBuilder() ResultBuilder {
+	out := resultBuilder{
+		block:     "",
+		pIndex:    nil,
+		name:      "",
+		remaining: nil,
+		err:       nil,
+		diff:      nil,
+		duration:  0,
+		isFail:    false,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *resultBuilder) Create() ResultBuilder {
+	return createResultBuilder()
+}
+
+// WithBlock adds a block to the builder
+func (app *resultBuilder) WithBlock(block string) ResultBuilder {
+	app.block = block
+	return app
+}
+
+// WithIndex adds an index to the builder
+func (app *resultBuilder) WithIndex(index uint) ResultBuilder {
+	app.pIndex = &index
+	return app
+}
+
+// WithName adds a name to the builder
+func (app *resultBuilder) WithName(name string) ResultBuilder {
+	app.name = name
+	return app
+}
+
+// WithRemaining adds remaining bytes to the builder
+func (app *resultBuilder) WithRemaining(remaining []byte) ResultBuilder {
+	app.remaining = remaining
+	return app
+}
+
+// WithError adds an error to the builder
+func (app *resultBuilder) WithError(err error) ResultBuilder {
+	app.err = err
+	return app
+}
+
+// WithDiff adds an expected AST snapshot diff to the builder
+func (app *resultBuilder) WithDiff(diff []byte) ResultBuilder {
+	app.diff = diff
+	return app
+}
+
+// WithDuration adds a duration to the builder
+func (app *resultBuilder) WithDuration(duration time.Duration) ResultBuilder {
+	app.duration = duration
+	return app
+}
+
+// IsFail flags the builder as expected to fail
+func (app *resultBuilder) IsFail() ResultBuilder {
+	app.isFail = true
+	return app
+}
+
+// Now builds a new Result instance
+func (app *resultBuilder) Now() (Result, error) {
+	if app.block == "" {
+		return nil, errors.New("the block is mandatory in order to build a Result instance")
+	}
+
+	if app.pIndex == nil {
+		return nil, errors.New("the index is mandatory in order to build a Result instance")
+	}
+
+	if app.name == "" {
+		return nil, errors.New("the name is mandatory in order to build a Result instance")
+	}
+
+	if app.remaining != nil && len(app.remaining) <= 0 {
+		app.remaining = nil
+	}
+
+	if app.diff != nil && len(app.diff) <= 0 {
+		app.diff = nil
+	}
+
+	if app.diff != nil && app.err == nil {
+		return nil, errors.New("the error is mandatory in order to build a Result instance that contains a diff")
+	}
+
+	return createResult(
+		app.block,
+		*app.pIndex,
+		app.name,
+		app.remaining,
+		app.err,
+		app.diff,
+		app.duration,
+		app.isFail,
+	), nil
+}
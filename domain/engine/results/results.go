@@ -0,0 +1,51 @@
+package results
+
+import "time"
+
+type results struct {
+	list []Result
+}
+
+func createResults(
+	list []Result,
+) Results {
+	out := results{
+		list: list,
+	}
+
+	return &out
+}
+
+// List returns the list of result
+func (obj *results) List() []Result {
+	return obj.list
+}
+
+// IsSuccess returns true if every result is a success, false otherwise
+func (obj *results) IsSuccess() bool {
+	return len(obj.Failures()) <= 0
+}
+
+// Failures returns the results that did not match their expected outcome
+func (obj *results) Failures() []Result {
+	output := []Result{}
+	for _, oneResult := range obj.list {
+		if oneResult.IsSuccess() {
+			continue
+		}
+
+		output = append(output, oneResult)
+	}
+
+	return output
+}
+
+// Duration returns the total duration of the results
+func (obj *results) Duration() time.Duration {
+	output := time.Duration(0)
+	for _, oneResult := range obj.list {
+		output += oneResult.Duration()
+	}
+
+	return output
+}
@@ -0,0 +1,73 @@
+package results
+
+import "time"
+
+// NewBuilder creates a new builder
+func NewBuilder() Builder {
+	return createBuilder()
+}
+
+// NewResultBuilder creates a new result builder
+func NewResultBuilder() ResultBuilder {
+	return createResultBuilder()
+}
+
+// NewAdapter creates a new adapter
+func NewAdapter() Adapter {
+	return createAdapter()
+}
+
+// Adapter represents the results adapter
+type Adapter interface {
+	// ToJUnit renders the results as a JUnit XML document
+	ToJUnit(results Results) ([]byte, error)
+
+	// ToTAP renders the results using the Test Anything Protocol
+	ToTAP(results Results) ([]byte, error)
+}
+
+// Builder represents the results builder
+type Builder interface {
+	Create() Builder
+	WithList(list []Result) Builder
+	Now() (Results, error)
+}
+
+// Results represents the results of a suites execution
+type Results interface {
+	List() []Result
+	IsSuccess() bool
+	Failures() []Result
+	Duration() time.Duration
+}
+
+// ResultBuilder represents the result builder
+type ResultBuilder interface {
+	Create() ResultBuilder
+	WithBlock(block string) ResultBuilder
+	WithIndex(index uint) ResultBuilder
+	WithName(name string) ResultBuilder
+	WithRemaining(remaining []byte) ResultBuilder
+	WithError(err error) ResultBuilder
+	WithDiff(diff []byte) ResultBuilder
+	WithDuration(duration time.Duration) ResultBuilder
+	IsFail() ResultBuilder
+	Now() (Result, error)
+}
+
+// Result represents the result of a suite execution
+type Result interface {
+	Block() string
+	Index() uint
+	Name() string
+	IsFail() bool
+	HasFailed() bool
+	IsSuccess() bool
+	HasRemaining() bool
+	Remaining() []byte
+	HasError() bool
+	Error() error
+	HasDiff() bool
+	Diff() []byte
+	Duration() time.Duration
+}
@@ -0,0 +1,66 @@
+package signatures
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+type jsonSignature struct {
+	Hash      string `json:"hash"`
+	PublicKey string `json:"public_key"`
+	Value     string `json:"signature"`
+}
+
+type adapter struct {
+}
+
+func createAdapter() Adapter {
+	out := adapter{}
+	return &out
+}
+
+// ToBytes converts a signature to bytes
+func (app *adapter) ToBytes(signature Signature) ([]byte, error) {
+	return json.MarshalIndent(jsonSignature{
+		Hash:      hex.EncodeToString(signature.Hash()),
+		PublicKey: hex.EncodeToString(signature.PublicKey()),
+		Value:     hex.EncodeToString(signature.Value()),
+	}, "", "\t")
+}
+
+// ToSignature converts bytes to a signature
+func (app *adapter) ToSignature(input []byte) (Signature, error) {
+	decoded := jsonSignature{}
+	err := json.Unmarshal(input, &decoded)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := hex.DecodeString(decoded.Hash)
+	if err != nil {
+		str := fmt.Sprintf("the hash of the signature is invalid: %s", err.Error())
+		return nil, errors.New(str)
+	}
+
+	publicKey, err := hex.DecodeString(decoded.PublicKey)
+	if err != nil {
+		str := fmt.Sprintf("the public key of the signature is invalid: %s", err.Error())
+		return nil, errors.New(str)
+	}
+
+	if len(publicKey) != ed25519.PublicKeySize {
+		str := fmt.Sprintf("the public key of the signature was expected to contain %d bytes, %d provided", ed25519.PublicKeySize, len(publicKey))
+		return nil, errors.New(str)
+	}
+
+	value, err := hex.DecodeString(decoded.Value)
+	if err != nil {
+		str := fmt.Sprintf("the value of the signature is invalid: %s", err.Error())
+		return nil, errors.New(str)
+	}
+
+	return createSignature(hash, publicKey, value), nil
+}
@@ -0,0 +1,32 @@
+package signatures
+
+import (
+	"crypto/sha256"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+)
+
+type hasher struct {
+	grammarAdapter grammars.Adapter
+}
+
+func createHasher(
+	grammarAdapter grammars.Adapter,
+) Hasher {
+	out := hasher{
+		grammarAdapter: grammarAdapter,
+	}
+
+	return &out
+}
+
+// Hash returns the sha256 hash of the canonical representation of the grammar
+func (app *hasher) Hash(grammar grammars.Grammar) ([]byte, error) {
+	content, err := app.grammarAdapter.ToCanonical(grammar)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(content)
+	return hash[:], nil
+}
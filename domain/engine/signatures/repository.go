@@ -0,0 +1,123 @@
+package signatures
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements/references"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+)
+
+type repository struct {
+	mutex      sync.RWMutex
+	hasher     Hasher
+	verifier   Verifier
+	repository grammars.Repository
+	signatures map[string]Signature
+}
+
+func createRepository(
+	hasher Hasher,
+	verifier Verifier,
+	repositoryIns grammars.Repository,
+) Repository {
+	out := repository{
+		hasher:     hasher,
+		verifier:   verifier,
+		repository: repositoryIns,
+		signatures: map[string]Signature{},
+	}
+
+	return &out
+}
+
+// Register verifies then registers a signature
+func (app *repository) Register(signature Signature) error {
+	err := app.verifier.Verify(signature)
+	if err != nil {
+		return err
+	}
+
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	app.signatures[hex.EncodeToString(signature.Hash())] = signature
+	return nil
+}
+
+// IsRegistered returns true if a signature is registered for the hash of the signature, false otherwise
+func (app *repository) IsRegistered(signature Signature) bool {
+	app.mutex.RLock()
+	defer app.mutex.RUnlock()
+	_, ok := app.signatures[hex.EncodeToString(signature.Hash())]
+	return ok
+}
+
+// Unregister removes the signature registered for the hash of the signature
+func (app *repository) Unregister(signature Signature) {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	delete(app.signatures, hex.EncodeToString(signature.Hash()))
+}
+
+// Init initializes the underlying repository
+func (app *repository) Init() error {
+	return app.repository.Init()
+}
+
+// List lists the grammar paths and their sorted versions of the underlying repository
+func (app *repository) List() (map[string][]versions.Version, error) {
+	return app.repository.List()
+}
+
+// Insert inserts the grammar in the underlying repository if it matches a registered signature
+func (app *repository) Insert(path []string, grammar grammars.Grammar) error {
+	err := app.verify(grammar)
+	if err != nil {
+		str := fmt.Sprintf("the grammar (path: %s, version: %s) could not be inserted: %s", strings.Join(path, "/"), grammar.Version().String(), err.Error())
+		return errors.New(str)
+	}
+
+	return app.repository.Insert(path, grammar)
+}
+
+// Retrieve retrieves the grammar from the underlying repository if it matches a registered signature
+func (app *repository) Retrieve(reference references.Reference) (grammars.Grammar, error) {
+	grammar, err := app.repository.Retrieve(reference)
+	if err != nil {
+		return nil, err
+	}
+
+	err = app.verify(grammar)
+	if err != nil {
+		str := fmt.Sprintf("the grammar (path: %s, version: %s) could not be retrieved: %s", strings.Join(reference.Path(), "/"), grammar.Version().String(), err.Error())
+		return nil, errors.New(str)
+	}
+
+	return grammar, nil
+}
+
+// Delete deletes the grammar from the underlying repository
+func (app *repository) Delete(reference references.Reference) error {
+	return app.repository.Delete(reference)
+}
+
+func (app *repository) verify(grammar grammars.Grammar) error {
+	hash, err := app.hasher.Hash(grammar)
+	if err != nil {
+		return err
+	}
+
+	app.mutex.RLock()
+	signature, ok := app.signatures[hex.EncodeToString(hash)]
+	app.mutex.RUnlock()
+	if !ok {
+		str := fmt.Sprintf("the grammar's hash (%s) is not signed", hex.EncodeToString(hash))
+		return errors.New(str)
+	}
+
+	return app.verifier.VerifyGrammar(grammar, signature)
+}
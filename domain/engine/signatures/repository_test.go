@@ -0,0 +1,232 @@
+package signatures
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements/references"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+)
+
+const grammarInput = `
+	v1;
+	> .assignment;
+	# .SPACE;
+
+	assignment: .VARIABLE .EQUAL .value;
+	value: .N_ZERO
+		 | .N_ONE
+		 ;
+
+	VARIABLE: "myVariable";
+	EQUAL: "=";
+	N_ZERO: "0";
+	N_ONE: "1";
+	SPACE: " ";
+`
+
+func TestHasher_isIndependentOfFormatting_Success(t *testing.T) {
+	reformatted := `v1;> .assignment;# .SPACE;
+value: .N_ZERO | .N_ONE;
+assignment: .VARIABLE    .EQUAL .value;
+SPACE: " ";N_ONE: "1";N_ZERO: "0";EQUAL: "=";VARIABLE: "myVariable";`
+
+	changed := bytes.Replace([]byte(grammarInput), []byte(`"1"`), []byte(`"2"`), 1)
+	hashes := [][]byte{}
+	for _, oneInput := range [][]byte{[]byte(grammarInput), []byte(reformatted), changed} {
+		grammar, _, err := grammars.NewAdapter().ToGrammar(oneInput)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		hash, err := NewHasher().Hash(grammar)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		hashes = append(hashes, hash)
+	}
+
+	if !bytes.Equal(hashes[0], hashes[1]) {
+		t.Errorf("the hash was expected to be independent of the formatting and of the declaration order")
+		return
+	}
+
+	if bytes.Equal(hashes[0], hashes[2]) {
+		t.Errorf("the hash was expected to change when the content changes")
+		return
+	}
+}
+
+func TestRepository_Success(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	grammar, _, err := grammars.NewAdapter().ToGrammar([]byte(grammarInput))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	signature, err := NewSigner(privateKey).Sign(grammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	adapter := NewAdapter()
+	content, err := adapter.ToBytes(signature)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retSignature, err := adapter.ToSignature(content)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	verifier := NewVerifier([]ed25519.PublicKey{publicKey})
+	err = verifier.VerifyGrammar(grammar, retSignature)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	memory := grammars.NewRepositoryMemory(map[string]grammars.Grammar{})
+	repository := NewRepository(verifier, memory)
+	path := []string{"languages", "assignments"}
+	err = repository.Insert(path, grammar)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	err = repository.Register(retSignature)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = repository.Insert(path, grammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	constraint, err := versions.NewAdapter().ToConstraint([]byte("1"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	reference, err := references.NewBuilder().Create().
+		WithPath(path).
+		WithName("assignment").
+		WithConstraint(constraint).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = repository.Retrieve(reference)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// a grammar replaced behind the repository no longer matches a signature:
+	tampered, _, err := grammars.NewAdapter().ToGrammar(bytes.Replace([]byte(grammarInput), []byte(`"1"`), []byte(`"2"`), 1))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = memory.Insert(path, tampered)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = repository.Retrieve(reference)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestRepository_register_withUntrustedKey_returnsError(t *testing.T) {
+	trustedKey, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	grammar, _, err := grammars.NewAdapter().ToGrammar([]byte(grammarInput))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	signature, err := NewSigner(privateKey).Sign(grammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	repository := NewRepository(
+		NewVerifier([]ed25519.PublicKey{trustedKey}),
+		grammars.NewRepositoryMemory(map[string]grammars.Grammar{}),
+	)
+
+	err = repository.Register(signature)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestVerifier_withForgedSignature_returnsError(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	grammar, _, err := grammars.NewAdapter().ToGrammar([]byte(grammarInput))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	signature, err := NewSigner(privateKey).Sign(grammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	value := append([]byte{}, signature.Value()...)
+	value[0] ^= 0xff
+	forged := createSignature(signature.Hash(), signature.PublicKey(), value)
+	err = NewVerifier([]ed25519.PublicKey{publicKey}).Verify(forged)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
@@ -0,0 +1,105 @@
+package signatures
+
+import (
+	"crypto/ed25519"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+)
+
+// NewHasher creates a new hasher
+func NewHasher() Hasher {
+	grammarAdapter := grammars.NewAdapter()
+	return createHasher(
+		grammarAdapter,
+	)
+}
+
+// NewSigner creates a new signer using the private key
+func NewSigner(
+	privateKey ed25519.PrivateKey,
+) Signer {
+	hasher := NewHasher()
+	return createSigner(
+		hasher,
+		privateKey,
+	)
+}
+
+// NewVerifier creates a new verifier that only trusts the signatures of the public keys
+func NewVerifier(
+	trustedKeys []ed25519.PublicKey,
+) Verifier {
+	hasher := NewHasher()
+	return createVerifier(
+		hasher,
+		trustedKeys,
+	)
+}
+
+// NewAdapter creates a new signature adapter
+func NewAdapter() Adapter {
+	return createAdapter()
+}
+
+// NewRepository creates a new repository that rejects the grammars that do not match a registered signature
+func NewRepository(
+	verifier Verifier,
+	repository grammars.Repository,
+) Repository {
+	hasher := NewHasher()
+	return createRepository(
+		hasher,
+		verifier,
+		repository,
+	)
+}
+
+// Hasher computes the content hash of grammars
+type Hasher interface {
+	// Hash returns the sha256 hash of the canonical representation of the grammar
+	Hash(grammar grammars.Grammar) ([]byte, error)
+}
+
+// Signer signs grammars
+type Signer interface {
+	Sign(grammar grammars.Grammar) (Signature, error)
+}
+
+// Verifier verifies signatures
+type Verifier interface {
+	// Verify verifies that the signature is valid and made by a trusted key
+	Verify(signature Signature) error
+
+	// VerifyGrammar verifies the signature and that it was made for the grammar
+	VerifyGrammar(grammar grammars.Grammar, signature Signature) error
+}
+
+// Adapter represents the signature adapter
+type Adapter interface {
+	// ToBytes converts a signature to bytes
+	ToBytes(signature Signature) ([]byte, error)
+
+	// ToSignature converts bytes to a signature
+	ToSignature(input []byte) (Signature, error)
+}
+
+// Signature represents the ed25519 signature of a grammar's hash
+type Signature interface {
+	Hash() []byte
+	PublicKey() ed25519.PublicKey
+	Value() []byte
+}
+
+// Repository represents a grammar repository that only accepts and returns signed grammars, it is safe for concurrent use
+type Repository interface {
+	grammars.Repository
+
+	// Register verifies then registers a signature
+	Register(signature Signature) error
+
+	// IsRegistered returns true if a signature is registered for the hash of the signature, false otherwise
+	IsRegistered(signature Signature) bool
+
+	// Unregister removes the signature registered for the hash of the signature
+	Unregister(signature Signature)
+}
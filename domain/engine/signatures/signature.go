@@ -0,0 +1,38 @@
+package signatures
+
+import "crypto/ed25519"
+
+type signature struct {
+	hash      []byte
+	publicKey ed25519.PublicKey
+	value     []byte
+}
+
+func createSignature(
+	hash []byte,
+	publicKey ed25519.PublicKey,
+	value []byte,
+) Signature {
+	out := signature{
+		hash:      hash,
+		publicKey: publicKey,
+		value:     value,
+	}
+
+	return &out
+}
+
+// Hash returns the signed hash
+func (obj *signature) Hash() []byte {
+	return obj.hash
+}
+
+// PublicKey returns the public key of the signer
+func (obj *signature) PublicKey() ed25519.PublicKey {
+	return obj.publicKey
+}
+
+// Value returns the signature value
+func (obj *signature) Value() []byte {
+	return obj.value
+}
@@ -0,0 +1,46 @@
+package signatures
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+)
+
+type signer struct {
+	hasher     Hasher
+	privateKey ed25519.PrivateKey
+}
+
+func createSigner(
+	hasher Hasher,
+	privateKey ed25519.PrivateKey,
+) Signer {
+	out := signer{
+		hasher:     hasher,
+		privateKey: privateKey,
+	}
+
+	return &out
+}
+
+// Sign signs the hash of the grammar
+func (app *signer) Sign(grammar grammars.Grammar) (Signature, error) {
+	if len(app.privateKey) != ed25519.PrivateKeySize {
+		str := fmt.Sprintf("the private key was expected to contain %d bytes, %d provided", ed25519.PrivateKeySize, len(app.privateKey))
+		return nil, errors.New(str)
+	}
+
+	hash, err := app.hasher.Hash(grammar)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey := app.privateKey.Public().(ed25519.PublicKey)
+	return createSignature(
+		hash,
+		publicKey,
+		ed25519.Sign(app.privateKey, hash),
+	), nil
+}
@@ -0,0 +1,67 @@
+package signatures
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+)
+
+type verifier struct {
+	hasher      Hasher
+	trustedKeys []ed25519.PublicKey
+}
+
+func createVerifier(
+	hasher Hasher,
+	trustedKeys []ed25519.PublicKey,
+) Verifier {
+	out := verifier{
+		hasher:      hasher,
+		trustedKeys: trustedKeys,
+	}
+
+	return &out
+}
+
+// Verify verifies that the signature is valid and made by a trusted key
+func (app *verifier) Verify(signature Signature) error {
+	publicKey := signature.PublicKey()
+	isTrusted := false
+	for _, oneKey := range app.trustedKeys {
+		if oneKey.Equal(publicKey) {
+			isTrusted = true
+			break
+		}
+	}
+
+	if !isTrusted {
+		str := fmt.Sprintf("the public key (%s) of the signature is not trusted", hex.EncodeToString(publicKey))
+		return errors.New(str)
+	}
+
+	if len(publicKey) != ed25519.PublicKeySize || !ed25519.Verify(publicKey, signature.Hash(), signature.Value()) {
+		str := fmt.Sprintf("the signature of the hash (%s) is invalid", hex.EncodeToString(signature.Hash()))
+		return errors.New(str)
+	}
+
+	return nil
+}
+
+// VerifyGrammar verifies the signature and that it was made for the grammar
+func (app *verifier) VerifyGrammar(grammar grammars.Grammar, signature Signature) error {
+	hash, err := app.hasher.Hash(grammar)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(hash, signature.Hash()) {
+		str := fmt.Sprintf("the hash (%s) of the grammar does not match the signed hash (%s)", hex.EncodeToString(hash), hex.EncodeToString(signature.Hash()))
+		return errors.New(str)
+	}
+
+	return app.Verify(signature)
+}
@@ -0,0 +1,124 @@
+package snapshots
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+)
+
+const astKeyname = "ast"
+
+type adapter struct {
+}
+
+func createAdapter() Adapter {
+	out := adapter{}
+	return &out
+}
+
+// ToSnapshot renders the AST as a canonical s-expression snapshot
+func (app *adapter) ToSnapshot(ast asts.AST) ([]byte, error) {
+	node, err := app.astToNode(ast)
+	if err != nil {
+		return nil, err
+	}
+
+	return node.render(), nil
+}
+
+// Normalize parses an s-expression snapshot and returns its canonical form
+func (app *adapter) Normalize(snapshot []byte) ([]byte, error) {
+	node, err := parse(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return node.render(), nil
+}
+
+// Compare compares the expected snapshot to the AST and returns a readable diff, or nil if they match
+func (app *adapter) Compare(expected []byte, ast asts.AST) ([]byte, error) {
+	normalized, err := app.Normalize(expected)
+	if err != nil {
+		return nil, err
+	}
+
+	actual, err := app.ToSnapshot(ast)
+	if err != nil {
+		return nil, err
+	}
+
+	return diff(normalized, actual), nil
+}
+
+func (app *adapter) astToNode(ast asts.AST) (*node, error) {
+	return app.elementToNode(ast.Root(), "")
+}
+
+func (app *adapter) instructionToNode(instruction asts.Instruction) (*node, error) {
+	head := fmt.Sprintf("%s#%d", instruction.Block(), instruction.Line())
+	children := []*node{}
+	for _, oneToken := range instruction.Tokens().List() {
+		child, err := app.tokenToNode(oneToken)
+		if err != nil {
+			return nil, err
+		}
+
+		children = append(children, child)
+	}
+
+	return createNode(head, children), nil
+}
+
+func (app *adapter) tokenToNode(token asts.Token) (*node, error) {
+	name := token.Name()
+	children := []*node{}
+	for _, oneElement := range token.Elements().List() {
+		child, err := app.elementToNode(oneElement, name)
+		if err != nil {
+			return nil, err
+		}
+
+		children = append(children, child)
+	}
+
+	return createNode(name, children), nil
+}
+
+func (app *adapter) elementToNode(element asts.Element, tokenName string) (*node, error) {
+	if element.IsConstant() {
+		constant := element.Constant()
+		value := createString(constant.Value())
+		if constant.Name() == tokenName {
+			return value, nil
+		}
+
+		return createNode(constant.Name(), []*node{
+			value,
+		}), nil
+	}
+
+	if element.IsInstruction() {
+		return app.instructionToNode(element.Instruction())
+	}
+
+	if element.IsAST() {
+		child, err := app.astToNode(element.AST())
+		if err != nil {
+			return nil, err
+		}
+
+		return createNode(astKeyname, []*node{
+			child,
+		}), nil
+	}
+
+	str := fmt.Sprintf("the element (name: %s) is neither a constant, an instruction or an AST", element.Name())
+	return nil, errors.New(str)
+}
+
+func createString(value []byte) *node {
+	return createAtom(strconv.Quote(string(value)))
+}
@@ -0,0 +1,81 @@
+package snapshots
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+)
+
+func TestAdapter_Success(t *testing.T) {
+	grammar, _, err := grammars.NewAdapter().ToGrammar([]byte(`
+		v1;
+		> .program;
+		# .SPACE;
+
+		program: .name .SEMICOLON
+				;
+
+		name: .LL_A
+			| .LL_B
+			;
+
+		SEMICOLON: ";";
+		LL_A: "a";
+		LL_B: "b";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	ast, _, err := asts.NewAdapter(grammars.NewRepositoryMemory(map[string]grammars.Grammar{})).ToAST(grammar, []byte("b;"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	adapter := NewAdapter()
+	retSnapshot, err := adapter.ToSnapshot(ast)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := "(program#0\n\t(name\n\t\t(name#1\n\t\t\t(LL_B \"b\")))\n\t(SEMICOLON \";\"))\n"
+	if string(retSnapshot) != expected {
+		t.Errorf("the snapshot was expected to be:\n%s\nreturned:\n%s", expected, retSnapshot)
+		return
+	}
+
+	retDiff, err := adapter.Compare([]byte(`(program#0 (name (name#1 (LL_B "b"))) (SEMICOLON ";"))`), ast)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if retDiff != nil {
+		t.Errorf("the diff was expected to be nil, returned:\n%s", retDiff)
+		return
+	}
+
+	retDiff, err = adapter.Compare([]byte(`(program#0 (name (name#0 (LL_A "a"))) (SEMICOLON ";"))`), ast)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !strings.Contains(string(retDiff), "-\t\t(name#0\n-\t\t\t(LL_A \"a\")))\n+\t\t(name#1\n+\t\t\t(LL_B \"b\")))\n") {
+		t.Errorf("the diff was expected to contain the changed name, returned:\n%s", retDiff)
+		return
+	}
+
+	_, err = adapter.Compare([]byte(`(program#0`), ast)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
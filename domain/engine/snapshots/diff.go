@@ -0,0 +1,60 @@
+package snapshots
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// diff returns a line-based unified diff between the expected and actual snapshots, or nil if they are equal
+func diff(expected []byte, actual []byte) []byte {
+	if bytes.Equal(expected, actual) {
+		return nil
+	}
+
+	expectedLines := strings.Split(strings.TrimSuffix(string(expected), "\n"), "\n")
+	actualLines := strings.Split(strings.TrimSuffix(string(actual), "\n"), "\n")
+
+	// longest common subsequence table, built from the end of both lists:
+	lengths := make([][]int, len(expectedLines)+1)
+	for idx := range lengths {
+		lengths[idx] = make([]int, len(actualLines)+1)
+	}
+
+	for i := len(expectedLines) - 1; i >= 0; i-- {
+		for j := len(actualLines) - 1; j >= 0; j-- {
+			if expectedLines[i] == actualLines[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+				continue
+			}
+
+			lengths[i][j] = lengths[i+1][j]
+			if lengths[i][j+1] > lengths[i][j] {
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	buffer := bytes.Buffer{}
+	buffer.WriteString("--- expected\n+++ actual\n")
+	i, j := 0, 0
+	for i < len(expectedLines) || j < len(actualLines) {
+		if i < len(expectedLines) && j < len(actualLines) && expectedLines[i] == actualLines[j] {
+			buffer.WriteString(fmt.Sprintf(" %s\n", expectedLines[i]))
+			i++
+			j++
+			continue
+		}
+
+		if i < len(expectedLines) && (j >= len(actualLines) || lengths[i+1][j] >= lengths[i][j+1]) {
+			buffer.WriteString(fmt.Sprintf("-%s\n", expectedLines[i]))
+			i++
+			continue
+		}
+
+		buffer.WriteString(fmt.Sprintf("+%s\n", actualLines[j]))
+		j++
+	}
+
+	return buffer.Bytes()
+}
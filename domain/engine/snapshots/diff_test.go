@@ -0,0 +1,38 @@
+package snapshots
+
+import (
+	"testing"
+)
+
+func TestDiff_withEqualSnapshots_returnsNil(t *testing.T) {
+	snapshot := []byte("(program#0\n\t(LL_A \"a\"))\n")
+	retDiff := diff(snapshot, snapshot)
+	if retDiff != nil {
+		t.Errorf("the diff was expected to be nil, returned:\n%s", retDiff)
+		return
+	}
+}
+
+func TestDiff_Success(t *testing.T) {
+	expected := []byte("(program#0\n\t(name\n\t\t(name#0 \"a\"))\n\t(SEMICOLON \";\"))\n")
+	actual := []byte("(program#0\n\t(name\n\t\t(name#1 \"b\"))\n\t(SEMICOLON \";\"))\n")
+	retDiff := diff(expected, actual)
+
+	output := "--- expected\n+++ actual\n (program#0\n \t(name\n-\t\t(name#0 \"a\"))\n+\t\t(name#1 \"b\"))\n \t(SEMICOLON \";\"))\n"
+	if string(retDiff) != output {
+		t.Errorf("the diff was expected to be:\n%s\nreturned:\n%s", output, retDiff)
+		return
+	}
+}
+
+func TestDiff_withInsertedAndDeletedLines_Success(t *testing.T) {
+	expected := []byte("a\nb\nc\n")
+	actual := []byte("b\nc\nd\n")
+	retDiff := diff(expected, actual)
+
+	output := "--- expected\n+++ actual\n-a\n b\n c\n+d\n"
+	if string(retDiff) != output {
+		t.Errorf("the diff was expected to be:\n%s\nreturned:\n%s", output, retDiff)
+		return
+	}
+}
@@ -0,0 +1,209 @@
+package snapshots
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const listOpen = '('
+const listClose = ')'
+const stringDelimiter = '"'
+const stringEscape = '\\'
+const indentation = "\t"
+
+type node struct {
+	value    string
+	isList   bool
+	children []*node
+}
+
+func createAtom(value string) *node {
+	out := node{
+		value:    value,
+		isList:   false,
+		children: nil,
+	}
+
+	return &out
+}
+
+func createNode(head string, children []*node) *node {
+	out := node{
+		value:    head,
+		isList:   true,
+		children: children,
+	}
+
+	return &out
+}
+
+func (obj *node) render() []byte {
+	lines := obj.lines(0)
+	return []byte(fmt.Sprintf("%s\n", strings.Join(lines, "\n")))
+}
+
+func (obj *node) lines(depth int) []string {
+	indent := strings.Repeat(indentation, depth)
+	if !obj.isList {
+		return []string{
+			fmt.Sprintf("%s%s", indent, obj.value),
+		}
+	}
+
+	if obj.isFlat() {
+		parts := []string{
+			obj.value,
+		}
+
+		for _, oneChild := range obj.children {
+			parts = append(parts, oneChild.value)
+		}
+
+		return []string{
+			fmt.Sprintf("%s%c%s%c", indent, listOpen, strings.Join(parts, " "), listClose),
+		}
+	}
+
+	output := []string{
+		fmt.Sprintf("%s%c%s", indent, listOpen, obj.value),
+	}
+
+	for _, oneChild := range obj.children {
+		output = append(output, oneChild.lines(depth+1)...)
+	}
+
+	output[len(output)-1] = fmt.Sprintf("%s%c", output[len(output)-1], listClose)
+	return output
+}
+
+func (obj *node) isFlat() bool {
+	for _, oneChild := range obj.children {
+		if oneChild.isList {
+			return false
+		}
+	}
+
+	return true
+}
+
+func parse(input []byte) (*node, error) {
+	retNode, retRemaining, err := parseNode(skipSpaces(input))
+	if err != nil {
+		return nil, err
+	}
+
+	if !retNode.isList {
+		return nil, errors.New("the snapshot was expected to begin with a list")
+	}
+
+	retRemaining = skipSpaces(retRemaining)
+	if len(retRemaining) > 0 {
+		str := fmt.Sprintf("the snapshot was expected to contain a single list, %d bytes remaining after it", len(retRemaining))
+		return nil, errors.New(str)
+	}
+
+	return retNode, nil
+}
+
+func parseNode(input []byte) (*node, []byte, error) {
+	if len(input) <= 0 {
+		return nil, nil, errors.New("the snapshot ended while a node was expected")
+	}
+
+	if input[0] == stringDelimiter {
+		return parseString(input)
+	}
+
+	if input[0] != listOpen {
+		return parseSymbol(input)
+	}
+
+	retHead, retRemaining, err := parseSymbol(skipSpaces(input[1:]))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	children := []*node{}
+	remaining := skipSpaces(retRemaining)
+	for {
+		if len(remaining) <= 0 {
+			str := fmt.Sprintf("the list (%s) was never closed", retHead.value)
+			return nil, nil, errors.New(str)
+		}
+
+		if remaining[0] == listClose {
+			return createNode(retHead.value, children), remaining[1:], nil
+		}
+
+		retChild, retChildRemaining, err := parseNode(remaining)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		children = append(children, retChild)
+		remaining = skipSpaces(retChildRemaining)
+	}
+}
+
+func parseSymbol(input []byte) (*node, []byte, error) {
+	length := 0
+	for _, oneByte := range input {
+		if isSpace(oneByte) || oneByte == listOpen || oneByte == listClose || oneByte == stringDelimiter {
+			break
+		}
+
+		length++
+	}
+
+	if length <= 0 {
+		return nil, nil, errors.New("the snapshot was expected to contain a symbol")
+	}
+
+	return createAtom(string(input[:length])), input[length:], nil
+}
+
+func parseString(input []byte) (*node, []byte, error) {
+	isEscaped := false
+	for idx, oneByte := range input[1:] {
+		if isEscaped {
+			isEscaped = false
+			continue
+		}
+
+		if oneByte == stringEscape {
+			isEscaped = true
+			continue
+		}
+
+		if oneByte != stringDelimiter {
+			continue
+		}
+
+		end := idx + 2
+		value, err := strconv.Unquote(string(input[:end]))
+		if err != nil {
+			str := fmt.Sprintf("the snapshot string (%s) is invalid: %s", input[:end], err.Error())
+			return nil, nil, errors.New(str)
+		}
+
+		return createString([]byte(value)), input[end:], nil
+	}
+
+	return nil, nil, errors.New("the snapshot string was never closed")
+}
+
+func skipSpaces(input []byte) []byte {
+	for idx, oneByte := range input {
+		if !isSpace(oneByte) {
+			return input[idx:]
+		}
+	}
+
+	return []byte{}
+}
+
+func isSpace(value byte) bool {
+	return value == ' ' || value == '\t' || value == '\n' || value == '\r'
+}
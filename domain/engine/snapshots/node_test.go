@@ -0,0 +1,77 @@
+package snapshots
+
+import (
+	"testing"
+)
+
+func TestParse_Success(t *testing.T) {
+	input := []byte(`
+		(program#0 (name
+			(name#1 "b")) (SEMICOLON   ";"))
+	`)
+
+	retNode, err := parse(input)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := "(program#0\n\t(name\n\t\t(name#1 \"b\"))\n\t(SEMICOLON \";\"))\n"
+	if string(retNode.render()) != expected {
+		t.Errorf("the rendered snapshot was expected to be:\n%s\nreturned:\n%s", expected, retNode.render())
+		return
+	}
+}
+
+func TestParse_withEscapedStrings_Success(t *testing.T) {
+	input := []byte(`(value "a\"b" "c\\d" "\n\t" "\x00")`)
+	retNode, err := parse(input)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(retNode.children) != 4 {
+		t.Errorf("the node was expected to contain %d children, %d returned", 4, len(retNode.children))
+		return
+	}
+
+	expected := []string{`"a\"b"`, `"c\\d"`, `"\n\t"`, `"\x00"`}
+	for idx, oneChild := range retNode.children {
+		if oneChild.value != expected[idx] {
+			t.Errorf("the child at index %d was expected to be (%s), (%s) returned", idx, expected[idx], oneChild.value)
+			return
+		}
+	}
+
+	retSecond, err := parse(retNode.render())
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if string(retSecond.render()) != string(retNode.render()) {
+		t.Errorf("the rendered snapshot was expected to be stable, returned:\n%s", retSecond.render())
+		return
+	}
+}
+
+func TestParse_withInvalidInput_returnsError(t *testing.T) {
+	inputs := map[string]string{
+		"empty":          ``,
+		"atom":           `"value"`,
+		"unclosed list":  `(program (name "a")`,
+		"unclosed text":  `(program "a)`,
+		"remaining":      `(program "a") (other)`,
+		"missing head":   `(("a"))`,
+		"invalid escape": `(program "\q")`,
+	}
+
+	for name, oneInput := range inputs {
+		_, err := parse([]byte(oneInput))
+		if err == nil {
+			t.Errorf("the error was expected to be valid for the input (%s), nil returned", name)
+			return
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package snapshots
+
+import (
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+)
+
+// NewAdapter creates a new adapter
+func NewAdapter() Adapter {
+	return createAdapter()
+}
+
+// Adapter represents the AST snapshot adapter
+type Adapter interface {
+	// ToSnapshot renders the AST as a canonical s-expression snapshot
+	ToSnapshot(ast asts.AST) ([]byte, error)
+
+	// Normalize parses an s-expression snapshot and returns its canonical form
+	Normalize(snapshot []byte) ([]byte, error)
+
+	// Compare compares the expected snapshot to the AST and returns a readable diff, or nil if they match
+	Compare(expected []byte, ast asts.AST) ([]byte, error)
+}
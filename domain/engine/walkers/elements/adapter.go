@@ -85,6 +85,13 @@ func (app *adapter) selectedTokenList(name string, ins SelectedTokenList) (walke
 		WithName(name)
 
 	if ins.Node != nil {
+		selected := selectedKind(chain)
+		parsed := nodeKind(*ins.Node)
+		if selected != parsed {
+			str := fmt.Sprintf("the selector script of the token list (%s) selects a (%s) but its node contains a parser of (%s)", name, selected, parsed)
+			return nil, errors.New(str)
+		}
+
 		retNode, err := app.node(*ins.Node)
 		if err != nil {
 			return nil, err
@@ -70,3 +70,40 @@ func TestAdapter_Success(t *testing.T) {
 
 	fmt.Printf("\n%v\n", retWalker)
 }
+
+func TestAdapter_withNodeThatDoesNotMatchTheSelection_returnsError(t *testing.T) {
+	element := Element{
+		TokenList: &TokenList{
+			MapFn: func(elementName string, mp map[string][]any) (any, error) {
+				return mp, nil
+			},
+			List: map[string]SelectedTokenList{
+				"variableName": {
+					SelectorScript: []byte(`
+						v1;
+						name: mySelector;
+						variableName[0];
+					`),
+
+					Node: &Node{
+						Element: &Element{
+							ElementFn: func(input any) (any, error) {
+								return input, nil
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	adapter := NewAdapter(
+		grammars.NewRepositoryMemory(map[string]grammars.Grammar{}),
+	)
+
+	_, err := adapter.ToWalker(element)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
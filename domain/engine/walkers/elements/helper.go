@@ -0,0 +1,39 @@
+package elements
+
+import "github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/balances/selectors/chains"
+
+// selectedKind returns what the chain selects: a list of tokens, a token or an element
+func selectedKind(chain chains.Chain) string {
+	if !chain.HasToken() {
+		return kindTokenList
+	}
+
+	token := chain.Token()
+	if !token.HasElement() {
+		return kindToken
+	}
+
+	element := token.Element()
+	if !element.HasChain() {
+		return kindElement
+	}
+
+	return selectedKind(element.Chain())
+}
+
+// nodeKind returns what the node parses, using the priority of the node builder
+func nodeKind(ins Node) string {
+	if ins.Token != nil {
+		return kindToken
+	}
+
+	if ins.TokenList != nil {
+		return kindTokenList
+	}
+
+	if ins.Element != nil {
+		return kindElement
+	}
+
+	return kindNone
+}
@@ -6,6 +6,11 @@ import (
 	"github.com/steve-care-software/grammars/domain/engine/walkers"
 )
 
+const kindTokenList = "TokenList"
+const kindToken = "Token"
+const kindElement = "Element"
+const kindNone = "nil"
+
 // NewAdapter creates a new adapter instance
 func NewAdapter(
 	grammarRepository grammars.Repository,
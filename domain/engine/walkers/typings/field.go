@@ -0,0 +1,84 @@
+package typings
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/steve-care-software/grammars/domain/engine/walkers/elements"
+)
+
+type field[V any] struct {
+	name   string
+	script []byte
+	node   Node[V]
+}
+
+func createField[V any](
+	name string,
+	script []byte,
+	node Node[V],
+) Field[V] {
+	out := field[V]{
+		name:   name,
+		script: script,
+		node:   node,
+	}
+
+	return &out
+}
+
+// Name returns the name of the selected token
+func (obj *field[V]) Name() string {
+	return obj.name
+}
+
+// Selection returns the untyped selection
+func (obj *field[V]) Selection() (elements.SelectedTokenList, error) {
+	retNode, err := obj.node.Node()
+	if err != nil {
+		return elements.SelectedTokenList{}, err
+	}
+
+	return elements.SelectedTokenList{
+		SelectorScript: obj.script,
+		Node:           retNode,
+	}, nil
+}
+
+// Values returns the values of the field
+func (obj *field[V]) Values(values Values) ([]V, error) {
+	list, err := values.Fetch(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	output := []V{}
+	for _, oneValue := range list {
+		retValue, err := obj.node.Cast(oneValue)
+		if err != nil {
+			str := fmt.Sprintf("the field (%s) of the element (%s) contains an invalid value: %s", obj.name, values.Element(), err.Error())
+			return nil, errors.New(str)
+		}
+
+		output = append(output, retValue)
+	}
+
+	return output, nil
+}
+
+// One returns the value of the field when it contains exactly one value
+func (obj *field[V]) One(values Values) (V, error) {
+	list, err := obj.Values(values)
+	if err != nil {
+		var zero V
+		return zero, err
+	}
+
+	if len(list) != 1 {
+		var zero V
+		str := fmt.Sprintf("the field (%s) of the element (%s) was expected to contain 1 value, %d found", obj.name, values.Element(), len(list))
+		return zero, errors.New(str)
+	}
+
+	return list[0], nil
+}
@@ -0,0 +1,51 @@
+package typings
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/steve-care-software/grammars/domain/engine/walkers/elements"
+)
+
+func createTokenList(selections []Selection, fn func(values Values) (any, error)) (*elements.TokenList, error) {
+	declared := map[string]Selection{}
+	list := map[string]elements.SelectedTokenList{}
+	for _, oneSelection := range selections {
+		name := oneSelection.Name()
+		if _, ok := declared[name]; ok {
+			str := fmt.Sprintf("the token (%s) is selected more than once", name)
+			return nil, errors.New(str)
+		}
+
+		retSelection, err := oneSelection.Selection()
+		if err != nil {
+			return nil, err
+		}
+
+		declared[name] = oneSelection
+		list[name] = retSelection
+	}
+
+	return &elements.TokenList{
+		List: list,
+		MapFn: func(elementName string, mp map[string][]any) (any, error) {
+			return fn(createValues(elementName, mp, declared))
+		},
+	}, nil
+}
+
+func cast[T any](value any) (T, error) {
+	var zero T
+	if value == nil {
+		return zero, nil
+	}
+
+	output, ok := value.(T)
+	if !ok {
+		str := fmt.Sprintf("the value was expected to be of type (%s), (%T) provided", reflect.TypeOf((*T)(nil)).Elem().String(), value)
+		return zero, errors.New(str)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,93 @@
+package typings
+
+import (
+	"github.com/steve-care-software/grammars/domain/engine/walkers/elements"
+)
+
+type node[V any] struct {
+	node *elements.Node
+	err  error
+}
+
+func createElementNode[V any](walker Walker[V]) Node[V] {
+	element, err := walker.Element()
+	if err != nil {
+		return createNode[V](nil, err)
+	}
+
+	return createNode[V](&elements.Node{
+		Element: &element,
+	}, nil)
+}
+
+func createTokenNode[E any, V any](next Walker[E], fn func(list []E) (V, error)) Node[V] {
+	element, err := next.Element()
+	if err != nil {
+		return createNode[V](nil, err)
+	}
+
+	token := elements.Token{
+		ListFn: func(list []any) (any, error) {
+			output := []E{}
+			for _, oneValue := range list {
+				retValue, err := next.Cast(oneValue)
+				if err != nil {
+					return nil, err
+				}
+
+				output = append(output, retValue)
+			}
+
+			return fn(output)
+		},
+		Next: &element,
+	}
+
+	return createNode[V](&elements.Node{
+		Token: &token,
+	}, nil)
+}
+
+func createTokensNode[V any](fn func(values Values) (V, error), selections []Selection) Node[V] {
+	tokenList, err := createTokenList(selections, func(values Values) (any, error) {
+		return fn(values)
+	})
+
+	if err != nil {
+		return createNode[V](nil, err)
+	}
+
+	return createNode[V](&elements.Node{
+		TokenList: tokenList,
+	}, nil)
+}
+
+func createRawNode() Node[[]byte] {
+	return createNode[[]byte](nil, nil)
+}
+
+func createNode[V any](
+	untyped *elements.Node,
+	err error,
+) Node[V] {
+	out := node[V]{
+		node: untyped,
+		err:  err,
+	}
+
+	return &out
+}
+
+// Node returns the untyped node
+func (obj *node[V]) Node() (*elements.Node, error) {
+	if obj.err != nil {
+		return nil, obj.err
+	}
+
+	return obj.node, nil
+}
+
+// Cast converts an output of the untyped node to V
+func (obj *node[V]) Cast(value any) (V, error) {
+	return cast[V](value)
+}
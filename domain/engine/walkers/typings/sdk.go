@@ -0,0 +1,97 @@
+package typings
+
+import (
+	"github.com/steve-care-software/grammars/domain/engine/walkers/elements"
+)
+
+// Value creates a walker that converts the value of an element to T
+func Value[T any](fn func(input []byte) (T, error)) Walker[T] {
+	return createValueWalker(fn)
+}
+
+// Bytes creates a walker that returns the value of an element
+func Bytes() Walker[[]byte] {
+	return Value(func(input []byte) ([]byte, error) {
+		return input, nil
+	})
+}
+
+// Map creates a walker that converts the values selected in the tokens of an element to T
+func Map[T any](fn func(values Values) (T, error), selections ...Selection) Walker[T] {
+	return createMapWalker(fn, selections)
+}
+
+// Select creates a field that selects the token of the provided name using the selector script, and parses the selection using the node
+func Select[V any](name string, script []byte, node Node[V]) Field[V] {
+	return createField(name, script, node)
+}
+
+// Element creates a node that parses a selected element using the walker
+func Element[V any](walker Walker[V]) Node[V] {
+	return createElementNode(walker)
+}
+
+// Token creates a node that parses every element of a selected token using the walker, then converts the list to V
+func Token[E any, V any](next Walker[E], fn func(list []E) (V, error)) Node[V] {
+	return createTokenNode(next, fn)
+}
+
+// Tokens creates a node that converts the values selected in a selected list of tokens to V
+func Tokens[V any](fn func(values Values) (V, error), selections ...Selection) Node[V] {
+	return createTokensNode(fn, selections)
+}
+
+// Raw creates a node that returns the value of the selection
+func Raw() Node[[]byte] {
+	return createRawNode()
+}
+
+// Walker represents a walker whose output is of type T
+type Walker[T any] interface {
+	// Element returns the untyped element of the walker, or the error found while creating it
+	Element() (elements.Element, error)
+
+	// Cast converts an output of the untyped element to T
+	Cast(value any) (T, error)
+}
+
+// Node represents the parser of a selection whose output is of type V
+type Node[V any] interface {
+	// Node returns the untyped node, nil when the selection is not parsed, or the error found while creating it
+	Node() (*elements.Node, error)
+
+	// Cast converts an output of the untyped node to V
+	Cast(value any) (V, error)
+}
+
+// Selection represents a selection of an element
+type Selection interface {
+	// Name returns the name of the selected token
+	Name() string
+
+	// Selection returns the untyped selection, or the error found while creating it
+	Selection() (elements.SelectedTokenList, error)
+}
+
+// Field represents a selection whose values are of type V
+type Field[V any] interface {
+	Selection
+
+	// Values returns the values of the field
+	Values(values Values) ([]V, error)
+
+	// One returns the value of the field when it contains exactly one value
+	One(values Values) (V, error)
+}
+
+// Values represents the values selected in the tokens of an element
+type Values interface {
+	// Element returns the name of the element
+	Element() string
+
+	// Has returns true if the field contains values, false otherwise
+	Has(selection Selection) bool
+
+	// Fetch returns the untyped values of the field
+	Fetch(selection Selection) ([]any, error)
+}
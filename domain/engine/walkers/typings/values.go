@@ -0,0 +1,48 @@
+package typings
+
+import (
+	"errors"
+	"fmt"
+)
+
+type values struct {
+	element  string
+	mp       map[string][]any
+	declared map[string]Selection
+}
+
+func createValues(
+	element string,
+	mp map[string][]any,
+	declared map[string]Selection,
+) Values {
+	out := values{
+		element:  element,
+		mp:       mp,
+		declared: declared,
+	}
+
+	return &out
+}
+
+// Element returns the name of the element
+func (obj *values) Element() string {
+	return obj.element
+}
+
+// Has returns true if the field contains values, false otherwise
+func (obj *values) Has(selection Selection) bool {
+	list, err := obj.Fetch(selection)
+	return err == nil && len(list) > 0
+}
+
+// Fetch returns the untyped values of the field
+func (obj *values) Fetch(selection Selection) ([]any, error) {
+	name := selection.Name()
+	if declared, ok := obj.declared[name]; !ok || declared != selection {
+		str := fmt.Sprintf("the field (%s) is not selected by the walker of the element (%s)", name, obj.element)
+		return nil, errors.New(str)
+	}
+
+	return obj.mp[name], nil
+}
@@ -0,0 +1,70 @@
+package typings
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/steve-care-software/grammars/domain/engine/walkers/elements"
+)
+
+type walker[T any] struct {
+	element elements.Element
+	err     error
+}
+
+func createValueWalker[T any](fn func(input []byte) (T, error)) Walker[T] {
+	element := elements.Element{
+		ElementFn: func(input any) (any, error) {
+			value, ok := input.([]byte)
+			if !ok {
+				str := fmt.Sprintf("the value walker expected the value of an element, (%T) provided", input)
+				return nil, errors.New(str)
+			}
+
+			return fn(value)
+		},
+	}
+
+	return createWalker[T](element, nil)
+}
+
+func createMapWalker[T any](fn func(values Values) (T, error), selections []Selection) Walker[T] {
+	tokenList, err := createTokenList(selections, func(values Values) (any, error) {
+		return fn(values)
+	})
+
+	element := elements.Element{
+		ElementFn: func(input any) (any, error) {
+			return cast[T](input)
+		},
+		TokenList: tokenList,
+	}
+
+	return createWalker[T](element, err)
+}
+
+func createWalker[T any](
+	element elements.Element,
+	err error,
+) Walker[T] {
+	out := walker[T]{
+		element: element,
+		err:     err,
+	}
+
+	return &out
+}
+
+// Element returns the untyped element
+func (obj *walker[T]) Element() (elements.Element, error) {
+	if obj.err != nil {
+		return elements.Element{}, obj.err
+	}
+
+	return obj.element, nil
+}
+
+// Cast converts an output of the untyped element to T
+func (obj *walker[T]) Cast(value any) (T, error) {
+	return cast[T](value)
+}
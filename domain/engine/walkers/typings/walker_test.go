@@ -0,0 +1,92 @@
+package typings
+
+import (
+	"testing"
+)
+
+func TestMap_Success(t *testing.T) {
+	nameField := Select("name", []byte("name[0][0]"), Element(Bytes()))
+	walker := Map(func(values Values) (string, error) {
+		name, err := nameField.One(values)
+		if err != nil {
+			return "", err
+		}
+
+		return string(name), nil
+	}, nameField)
+
+	retElement, err := walker.Element()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retValue, err := retElement.TokenList.MapFn("variable", map[string][]any{
+		"name": {[]byte("myName")},
+	})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retOutput, err := walker.Cast(retValue)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if retOutput != "myName" {
+		t.Errorf("the output was expected to be (%s), (%s) returned", "myName", retOutput)
+		return
+	}
+}
+
+func TestMap_withDuplicatedSelection_returnsError(t *testing.T) {
+	nameField := Select("name", []byte("name[0][0]"), Raw())
+	otherField := Select("name", []byte("name[0][1]"), Raw())
+	walker := Map(func(values Values) ([]byte, error) {
+		return nameField.One(values)
+	}, nameField, otherField)
+
+	_, err := walker.Element()
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestField_withUndeclaredSelection_returnsError(t *testing.T) {
+	nameField := Select("name", []byte("name[0][0]"), Raw())
+	undeclaredField := Select("other", []byte("other[0][0]"), Raw())
+	walker := Map(func(values Values) ([]byte, error) {
+		return undeclaredField.One(values)
+	}, nameField)
+
+	retElement, err := walker.Element()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = retElement.TokenList.MapFn("variable", map[string][]any{
+		"name": {[]byte("myName")},
+	})
+
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestWalker_cast_withInvalidType_returnsError(t *testing.T) {
+	walker := Value(func(input []byte) (int, error) {
+		return len(input), nil
+	})
+
+	_, err := walker.Cast("not an int")
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
@@ -2,8 +2,9 @@ module github.com/steve-care-software/grammars
 
 go 1.24.1
 
+require github.com/dgraph-io/dgo/v210 v210.0.0-20230328113526-b66f8ae53a2d
+
 require (
-	github.com/dgraph-io/dgo/v210 v210.0.0-20230328113526-b66f8ae53a2d // indirect
 	github.com/gogo/protobuf v1.3.2 // indirect
 	github.com/golang/protobuf v1.5.4 // indirect
 	github.com/pkg/errors v0.8.1 // indirect
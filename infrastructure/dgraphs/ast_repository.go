@@ -0,0 +1,515 @@
+package dgraphs
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+)
+
+const documentsListQuery = `{
+	documents(func: has(document.name)) {
+		document.name
+	}
+}`
+
+const documentRetrieveQuery = `query document($name: string) {
+	documents(func: eq(document.name, $name)) @recurse(loop: false) {
+		uid
+		document.name
+		document.version
+		document.root
+		element.index
+		element.version
+		element.ast
+		instruction.block
+		instruction.line
+		instruction.tokens
+		token.name
+		token.index
+		token.elements
+		constant.name
+		constant.value
+		constant.encoding
+	}
+}`
+
+const documentUIDsQuery = `query document($name: string) {
+	documents(func: eq(document.name, $name)) @recurse(loop: false) {
+		uid
+		document.root
+		element.ast
+		instruction.tokens
+		token.elements
+	}
+}`
+
+type documentNode struct {
+	UID     string       `json:"uid,omitempty"`
+	Types   []string     `json:"dgraph.type,omitempty"`
+	Name    string       `json:"document.name,omitempty"`
+	Version string       `json:"document.version,omitempty"`
+	Root    *elementNode `json:"document.root,omitempty"`
+}
+
+type elementNode struct {
+	UID              string       `json:"uid,omitempty"`
+	Types            []string     `json:"dgraph.type,omitempty"`
+	Index            uint         `json:"element.index"`
+	Version          string       `json:"element.version,omitempty"`
+	AST              *elementNode `json:"element.ast,omitempty"`
+	Block            string       `json:"instruction.block,omitempty"`
+	Line             *uint        `json:"instruction.line,omitempty"`
+	Tokens           []tokenNode  `json:"instruction.tokens,omitempty"`
+	ConstantName     string       `json:"constant.name,omitempty"`
+	ConstantValue    string       `json:"constant.value,omitempty"`
+	ConstantEncoding string       `json:"constant.encoding,omitempty"`
+}
+
+type tokenNode struct {
+	UID      string        `json:"uid,omitempty"`
+	Types    []string      `json:"dgraph.type,omitempty"`
+	Name     string        `json:"token.name,omitempty"`
+	Index    uint          `json:"token.index"`
+	Elements []elementNode `json:"token.elements,omitempty"`
+}
+
+type documentResponse struct {
+	Documents []documentNode `json:"documents"`
+}
+
+type astRepository struct {
+	mutex              sync.Mutex
+	client             Client
+	builder            asts.Builder
+	instructionBuilder asts.InstructionBuilder
+	tokensBuilder      asts.TokensBuilder
+	tokenBuilder       asts.TokenBuilder
+	elementsBuilder    asts.ElementsBuilder
+	elementBuilder     asts.ElementBuilder
+	constantBuilder    asts.ConstantBuilder
+	versionAdapter     versions.Adapter
+}
+
+func createASTRepository(
+	client Client,
+	builder asts.Builder,
+	instructionBuilder asts.InstructionBuilder,
+	tokensBuilder asts.TokensBuilder,
+	tokenBuilder asts.TokenBuilder,
+	elementsBuilder asts.ElementsBuilder,
+	elementBuilder asts.ElementBuilder,
+	constantBuilder asts.ConstantBuilder,
+	versionAdapter versions.Adapter,
+) ASTRepository {
+	out := astRepository{
+		client:             client,
+		builder:            builder,
+		instructionBuilder: instructionBuilder,
+		tokensBuilder:      tokensBuilder,
+		tokenBuilder:       tokenBuilder,
+		elementsBuilder:    elementsBuilder,
+		elementBuilder:     elementBuilder,
+		constantBuilder:    constantBuilder,
+		versionAdapter:     versionAdapter,
+	}
+
+	return &out
+}
+
+// Init initializes the repository and its schema
+func (app *astRepository) Init() error {
+	return app.client.Alter(context.Background(), Schema)
+}
+
+// List lists the document names
+func (app *astRepository) List() ([]string, error) {
+	ctx := context.Background()
+	txn := app.client.NewTxn()
+	defer txn.Discard(ctx)
+
+	response, err := app.query(ctx, txn, documentsListQuery, "")
+	if err != nil {
+		return nil, err
+	}
+
+	output := []string{}
+	for _, oneDocument := range response.Documents {
+		output = append(output, oneDocument.Name)
+	}
+
+	return output, nil
+}
+
+// Insert inserts the AST of a document, replacing the document stored with the same name
+func (app *astRepository) Insert(name string, ast asts.AST) error {
+	// the document is replaced by a delete then a set, so the writes are serialized:
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	if name == "" {
+		return errors.New("the name is mandatory in order to insert a document")
+	}
+
+	ctx := context.Background()
+	txn := app.client.NewTxn()
+	defer txn.Discard(ctx)
+
+	err := app.delete(ctx, txn, name)
+	if err != nil {
+		return err
+	}
+
+	root := app.fromElement(ast.Root(), 0)
+	setJSON, err := json.Marshal(documentNode{
+		UID:     fmt.Sprintf("%s%s", blankNodePrefix, "document"),
+		Types:   []string{documentType},
+		Name:    name,
+		Version: versionToString(ast),
+		Root:    &root,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	_, err = txn.Mutate(ctx, setJSON, nil)
+	if err != nil {
+		return err
+	}
+
+	return txn.Commit(ctx)
+}
+
+// Retrieve retrieves the AST of a document
+func (app *astRepository) Retrieve(name string) (asts.AST, error) {
+	ctx := context.Background()
+	txn := app.client.NewTxn()
+	defer txn.Discard(ctx)
+
+	response, err := app.query(ctx, txn, documentRetrieveQuery, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(response.Documents) <= 0 || response.Documents[0].Root == nil {
+		str := fmt.Sprintf("the document (name: %s) could not be found", name)
+		return nil, errors.New(str)
+	}
+
+	document := response.Documents[0]
+	root, err := app.toElement(*document.Root)
+	if err != nil {
+		str := fmt.Sprintf("the document (name: %s) could not be converted to an AST: %s", name, err.Error())
+		return nil, errors.New(str)
+	}
+
+	ast, err := app.toAST(root, document.Version)
+	if err != nil {
+		str := fmt.Sprintf("the document (name: %s) could not be converted to an AST: %s", name, err.Error())
+		return nil, errors.New(str)
+	}
+
+	return ast, nil
+}
+
+// Delete deletes a document and all of its nodes
+func (app *astRepository) Delete(name string) error {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	ctx := context.Background()
+	txn := app.client.NewTxn()
+	defer txn.Discard(ctx)
+
+	response, err := app.query(ctx, txn, documentUIDsQuery, name)
+	if err != nil {
+		return err
+	}
+
+	if len(response.Documents) <= 0 {
+		str := fmt.Sprintf("the document (name: %s) could not be found", name)
+		return errors.New(str)
+	}
+
+	err = app.delete(ctx, txn, name)
+	if err != nil {
+		return err
+	}
+
+	return txn.Commit(ctx)
+}
+
+func (app *astRepository) delete(ctx context.Context, txn Txn, name string) error {
+	response, err := app.query(ctx, txn, documentUIDsQuery, name)
+	if err != nil {
+		return err
+	}
+
+	uids := []map[string]string{}
+	for _, oneDocument := range response.Documents {
+		uids = append(uids, map[string]string{
+			"uid": oneDocument.UID,
+		})
+
+		if oneDocument.Root != nil {
+			uids = append(uids, elementUIDs(*oneDocument.Root)...)
+		}
+	}
+
+	if len(uids) <= 0 {
+		return nil
+	}
+
+	deleteJSON, err := json.Marshal(uids)
+	if err != nil {
+		return err
+	}
+
+	_, err = txn.Mutate(ctx, nil, deleteJSON)
+	return err
+}
+
+func (app *astRepository) query(ctx context.Context, txn Txn, query string, name string) (*documentResponse, error) {
+	vars := map[string]string{}
+	if name != "" {
+		vars["$name"] = name
+	}
+
+	data, err := txn.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	response := documentResponse{}
+	err = json.Unmarshal(data, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (app *astRepository) fromElement(element asts.Element, index uint) elementNode {
+	output := elementNode{
+		Types: []string{elementType},
+		Index: index,
+	}
+
+	if element.IsAST() {
+		ast := element.AST()
+		root := app.fromElement(ast.Root(), 0)
+		output.AST = &root
+		output.Version = versionToString(ast)
+		return output
+	}
+
+	if element.IsConstant() {
+		constant := element.Constant()
+		output.ConstantName = constant.Name()
+		output.ConstantValue, output.ConstantEncoding = encodeValue(constant.Value())
+		return output
+	}
+
+	instruction := element.Instruction()
+	line := instruction.Line()
+	output.Block = instruction.Block()
+	output.Line = &line
+	output.Tokens = []tokenNode{}
+	for tokenIdx, oneToken := range instruction.Tokens().List() {
+		token := tokenNode{
+			Types:    []string{tokenType},
+			Name:     oneToken.Name(),
+			Index:    uint(tokenIdx),
+			Elements: []elementNode{},
+		}
+
+		for elementIdx, oneElement := range oneToken.Elements().List() {
+			token.Elements = append(token.Elements, app.fromElement(oneElement, uint(elementIdx)))
+		}
+
+		output.Tokens = append(output.Tokens, token)
+	}
+
+	return output
+}
+
+func (app *astRepository) toElement(node elementNode) (asts.Element, error) {
+	builder := app.elementBuilder.Create()
+	if node.AST != nil {
+		root, err := app.toElement(*node.AST)
+		if err != nil {
+			return nil, err
+		}
+
+		ast, err := app.toAST(root, node.Version)
+		if err != nil {
+			return nil, err
+		}
+
+		return builder.WithAST(ast).Now()
+	}
+
+	if node.ConstantName != "" {
+		value, err := decodeValue(node.ConstantValue, node.ConstantEncoding)
+		if err != nil {
+			return nil, err
+		}
+
+		constant, err := app.constantBuilder.Create().
+			WithName(node.ConstantName).
+			WithValue(value).
+			Now()
+
+		if err != nil {
+			return nil, err
+		}
+
+		return builder.WithConstant(constant).Now()
+	}
+
+	if node.Line == nil {
+		str := fmt.Sprintf("the element (uid: %s) is neither an instruction, a constant nor an AST", node.UID)
+		return nil, errors.New(str)
+	}
+
+	tokensList, err := app.toTokens(node.Tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	instruction, err := app.instructionBuilder.Create().
+		WithBlock(node.Block).
+		WithLine(*node.Line).
+		WithTokens(tokensList).
+		Now()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return builder.WithInstruction(instruction).Now()
+}
+
+func (app *astRepository) toAST(root asts.Element, version string) (asts.AST, error) {
+	builder := app.builder.Create().
+		WithRoot(root)
+
+	if version != "" {
+		retVersion, err := app.versionAdapter.ToVersion([]byte(version))
+		if err != nil {
+			return nil, err
+		}
+
+		builder.WithVersion(retVersion)
+	}
+
+	return builder.Now()
+}
+
+func (app *astRepository) toTokens(nodes []tokenNode) (asts.Tokens, error) {
+	// the edges of a node are not ordered, so their order is restored using their index:
+	sorted := append([]tokenNode{}, nodes...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Index < sorted[j].Index
+	})
+
+	list := []asts.Token{}
+	for _, oneToken := range sorted {
+		elementNodes := append([]elementNode{}, oneToken.Elements...)
+		sort.Slice(elementNodes, func(i, j int) bool {
+			return elementNodes[i].Index < elementNodes[j].Index
+		})
+
+		elementsList := []asts.Element{}
+		for _, oneElement := range elementNodes {
+			element, err := app.toElement(oneElement)
+			if err != nil {
+				return nil, err
+			}
+
+			elementsList = append(elementsList, element)
+		}
+
+		elements, err := app.elementsBuilder.Create().
+			WithList(elementsList).
+			Now()
+
+		if err != nil {
+			return nil, err
+		}
+
+		token, err := app.tokenBuilder.Create().
+			WithName(oneToken.Name).
+			WithElements(elements).
+			Now()
+
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, token)
+	}
+
+	return app.tokensBuilder.Create().
+		WithList(list).
+		Now()
+}
+
+// encodeValue stores the value as a string so it can be indexed, the values that are not valid UTF-8 are encoded in base64
+func encodeValue(value []byte) (string, string) {
+	if utf8.Valid(value) {
+		return string(value), ""
+	}
+
+	return base64.StdEncoding.EncodeToString(value), constantEncodingBase64
+}
+
+func decodeValue(value string, encoding string) ([]byte, error) {
+	switch encoding {
+	case "":
+		return []byte(value), nil
+	case constantEncodingBase64:
+		return base64.StdEncoding.DecodeString(value)
+	}
+
+	str := fmt.Sprintf("the constant value encoding (%s) is not supported", encoding)
+	return nil, errors.New(str)
+}
+
+func versionToString(ast asts.AST) string {
+	if !ast.HasVersion() {
+		return ""
+	}
+
+	return ast.Version().String()
+}
+
+func elementUIDs(node elementNode) []map[string]string {
+	output := []map[string]string{
+		{
+			"uid": node.UID,
+		},
+	}
+
+	if node.AST != nil {
+		output = append(output, elementUIDs(*node.AST)...)
+	}
+
+	for _, oneToken := range node.Tokens {
+		output = append(output, map[string]string{
+			"uid": oneToken.UID,
+		})
+
+		for _, oneElement := range oneToken.Elements {
+			output = append(output, elementUIDs(oneElement)...)
+		}
+	}
+
+	return output
+}
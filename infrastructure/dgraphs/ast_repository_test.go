@@ -0,0 +1,222 @@
+package dgraphs
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/snapshots"
+)
+
+func TestASTRepository_Success(t *testing.T) {
+	grammarAdapter := grammars.NewAdapter()
+	valueGrammar, _, err := grammarAdapter.ToGrammar([]byte(`
+		v1;
+		> .value;
+		# .SPACE;
+
+		value: ._digits
+			| .N_ONE
+			;
+
+		_digits: .N_ONE .N_TWO[2];
+
+		N_ONE: "1";
+		N_TWO: "2";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	grammar, _, err := grammarAdapter.ToGrammar([]byte(`
+		v1;
+		> .program;
+		# .SPACE;
+
+		program: .assignment+
+				;
+
+		assignment: .name .EQUAL .value[my/value, 1] .SEMICOLON
+					;
+
+		name: .LL_A
+			| .LL_B
+			;
+
+		LL_A: "a";
+		LL_B: "b";
+		EQUAL: "=";
+		SEMICOLON: ";";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	ast, _, err := asts.NewAdapter(
+		grammars.NewRepositoryMemory(map[string]grammars.Grammar{
+			"my/value": valueGrammar,
+		}),
+	).ToAST(grammar, []byte("a = 122; b = 1;"))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	client := NewClientMemory()
+	repository := NewASTRepository(client)
+	err = repository.Init()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	for i := 0; i < 2; i++ {
+		err = repository.Insert("main", ast)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+	}
+
+	names, err := repository.List()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(names) != 1 || names[0] != "main" {
+		t.Errorf("the list was expected to only contain the inserted document, %v returned", names)
+		return
+	}
+
+	retAST, err := repository.Retrieve("main")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	snapshotAdapter := snapshots.NewAdapter()
+	expected, err := snapshotAdapter.ToSnapshot(ast)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retSnapshot, err := snapshotAdapter.ToSnapshot(retAST)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !bytes.Equal(expected, retSnapshot) {
+		t.Errorf("the retrieved AST was expected to be:\n%s\n\n%s returned", expected, retSnapshot)
+		return
+	}
+
+	if !retAST.HasVersion() || retAST.Version().Compare(ast.Version()) != 0 {
+		t.Errorf("the retrieved AST was expected to contain the version of its grammar")
+		return
+	}
+
+	// the constant values are stored as indexed strings, so the documents can be queried by value:
+	txn := client.NewTxn()
+	defer txn.Discard(context.Background())
+	data, err := txn.Query(context.Background(), `{
+		constants(func: eq(constant.value, "b")) {
+			constant.name
+		}
+	}`, map[string]string{})
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expectedJSON := `{"constants":[{"constant.name":"LL_B"}]}`
+	if string(data) != expectedJSON {
+		t.Errorf("the query was expected to return %s, %s returned", expectedJSON, data)
+		return
+	}
+
+	err = repository.Delete("main")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = repository.Retrieve("main")
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	if amount := len(client.(*clientMemory).nodes); amount != 0 {
+		t.Errorf("all the nodes of the document were expected to be deleted, %d remaining", amount)
+		return
+	}
+}
+
+func TestASTRepository_withBinaryConstant_Success(t *testing.T) {
+	value := []byte{0xff, 0x00, 0xfe}
+	constant, err := asts.NewConstantBuilder().Create().
+		WithName("BINARY").
+		WithValue(value).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	root, err := asts.NewElementBuilder().Create().
+		WithConstant(constant).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	ast, err := asts.NewBuilder().Create().
+		WithRoot(root).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	repository := NewASTRepository(NewClientMemory())
+	err = repository.Init()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = repository.Insert("binary", ast)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retAST, err := repository.Retrieve("binary")
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retRoot := retAST.Root()
+	if !retRoot.IsConstant() || !bytes.Equal(retRoot.Constant().Value(), value) {
+		t.Errorf("the binary constant value was expected to be restored")
+		return
+	}
+}
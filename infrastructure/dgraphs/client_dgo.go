@@ -0,0 +1,95 @@
+//go:build dgraph
+
+package dgraphs
+
+import (
+	"context"
+
+	"github.com/dgraph-io/dgo/v210"
+	"github.com/dgraph-io/dgo/v210/protos/api"
+)
+
+// NewClientDgo creates a new client on top of a dgo client
+func NewClientDgo(
+	dgraph *dgo.Dgraph,
+) Client {
+	return createClientDgo(
+		dgraph,
+	)
+}
+
+type clientDgo struct {
+	dgraph *dgo.Dgraph
+}
+
+func createClientDgo(
+	dgraph *dgo.Dgraph,
+) Client {
+	out := clientDgo{
+		dgraph: dgraph,
+	}
+
+	return &out
+}
+
+// Alter alters the schema of the database
+func (app *clientDgo) Alter(ctx context.Context, schema string) error {
+	return app.dgraph.Alter(ctx, &api.Operation{
+		Schema: schema,
+	})
+}
+
+// NewTxn creates a new transaction
+func (app *clientDgo) NewTxn() Txn {
+	return createTxnDgo(
+		app.dgraph.NewTxn(),
+	)
+}
+
+type txnDgo struct {
+	txn *dgo.Txn
+}
+
+func createTxnDgo(
+	txn *dgo.Txn,
+) Txn {
+	out := txnDgo{
+		txn: txn,
+	}
+
+	return &out
+}
+
+// Query executes the DQL query using the variables and returns its JSON response
+func (app *txnDgo) Query(ctx context.Context, query string, vars map[string]string) ([]byte, error) {
+	response, err := app.txn.QueryWithVars(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Json, nil
+}
+
+// Mutate sets and deletes the JSON nodes and returns the uids assigned to the blank nodes
+func (app *txnDgo) Mutate(ctx context.Context, setJSON []byte, deleteJSON []byte) (map[string]string, error) {
+	response, err := app.txn.Mutate(ctx, &api.Mutation{
+		SetJson:    setJSON,
+		DeleteJson: deleteJSON,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Uids, nil
+}
+
+// Commit commits the transaction
+func (app *txnDgo) Commit(ctx context.Context) error {
+	return app.txn.Commit(ctx)
+}
+
+// Discard discards the transaction
+func (app *txnDgo) Discard(ctx context.Context) error {
+	return app.txn.Discard(ctx)
+}
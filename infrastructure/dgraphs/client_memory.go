@@ -0,0 +1,432 @@
+package dgraphs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+type memoryEdge struct {
+	isList bool
+	uids   []string
+}
+
+type memoryOperation struct {
+	uid      string
+	isDelete bool
+	// the predicate is empty when the whole node is deleted:
+	predicate string
+	value     any
+}
+
+type clientMemory struct {
+	mutex  sync.RWMutex
+	schema string
+	nodes  map[string]map[string]any
+	order  []string
+	next   uint64
+}
+
+func createClientMemory() Client {
+	out := clientMemory{
+		schema: "",
+		nodes:  map[string]map[string]any{},
+		order:  []string{},
+		next:   0,
+	}
+
+	return &out
+}
+
+// Alter alters the schema of the database
+func (app *clientMemory) Alter(ctx context.Context, schema string) error {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	if strings.TrimSpace(schema) == "" {
+		return errors.New("the schema is mandatory in order to alter the database")
+	}
+
+	app.schema = schema
+	return nil
+}
+
+// NewTxn creates a new transaction
+func (app *clientMemory) NewTxn() Txn {
+	return createTxnMemory(app)
+}
+
+func (app *clientMemory) allocate() string {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	app.next++
+	return fmt.Sprintf("%s%x", uidPrefix, app.next)
+}
+
+func (app *clientMemory) apply(operations []memoryOperation) {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	for _, oneOperation := range operations {
+		node, ok := app.nodes[oneOperation.uid]
+		if oneOperation.isDelete {
+			if !ok {
+				continue
+			}
+
+			if oneOperation.predicate == "" {
+				delete(app.nodes, oneOperation.uid)
+				continue
+			}
+
+			delete(node, oneOperation.predicate)
+			continue
+		}
+
+		if !ok {
+			node = map[string]any{}
+			app.nodes[oneOperation.uid] = node
+			app.order = append(app.order, oneOperation.uid)
+		}
+
+		edge, isEdge := oneOperation.value.(memoryEdge)
+		if !isEdge || !edge.isList {
+			node[oneOperation.predicate] = oneOperation.value
+			continue
+		}
+
+		current, ok := node[oneOperation.predicate].(memoryEdge)
+		if !ok {
+			current = memoryEdge{
+				isList: true,
+				uids:   []string{},
+			}
+		}
+
+		for _, oneUID := range edge.uids {
+			if !contains(current.uids, oneUID) {
+				current.uids = append(current.uids, oneUID)
+			}
+		}
+
+		node[oneOperation.predicate] = current
+	}
+
+	// drop the uids of the deleted nodes from the creation order:
+	order := []string{}
+	for _, oneUID := range app.order {
+		if _, ok := app.nodes[oneUID]; ok {
+			order = append(order, oneUID)
+		}
+	}
+
+	app.order = order
+}
+
+type txnMemory struct {
+	client     *clientMemory
+	operations []memoryOperation
+	isDone     bool
+}
+
+func createTxnMemory(
+	client *clientMemory,
+) Txn {
+	out := txnMemory{
+		client:     client,
+		operations: []memoryOperation{},
+		isDone:     false,
+	}
+
+	return &out
+}
+
+// Query executes the DQL query using the variables and returns its JSON response
+func (app *txnMemory) Query(ctx context.Context, query string, vars map[string]string) ([]byte, error) {
+	if app.isDone {
+		return nil, errors.New("the transaction has already been committed or discarded")
+	}
+
+	parsed, err := parseQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	app.client.mutex.RLock()
+	defer app.client.mutex.RUnlock()
+	output, err := parsed.execute(app.client, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(output)
+}
+
+// Mutate sets and deletes the JSON nodes and returns the uids assigned to the blank nodes
+func (app *txnMemory) Mutate(ctx context.Context, setJSON []byte, deleteJSON []byte) (map[string]string, error) {
+	if app.isDone {
+		return nil, errors.New("the transaction has already been committed or discarded")
+	}
+
+	if len(setJSON) <= 0 && len(deleteJSON) <= 0 {
+		return nil, errors.New("the mutation must contain a set or delete JSON")
+	}
+
+	blanks := map[string]string{}
+	operations := []memoryOperation{}
+	if len(deleteJSON) > 0 {
+		objects, err := decodeObjects(deleteJSON)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, oneObject := range objects {
+			retOperations, err := app.delete(oneObject)
+			if err != nil {
+				return nil, err
+			}
+
+			operations = append(operations, retOperations...)
+		}
+	}
+
+	if len(setJSON) > 0 {
+		objects, err := decodeObjects(setJSON)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, oneObject := range objects {
+			_, retOperations, err := app.set(oneObject, blanks)
+			if err != nil {
+				return nil, err
+			}
+
+			operations = append(operations, retOperations...)
+		}
+	}
+
+	app.operations = append(app.operations, operations...)
+	return blanks, nil
+}
+
+// Commit commits the transaction
+func (app *txnMemory) Commit(ctx context.Context) error {
+	if app.isDone {
+		return errors.New("the transaction has already been committed or discarded")
+	}
+
+	app.client.apply(app.operations)
+	app.isDone = true
+	return nil
+}
+
+// Discard discards the transaction
+func (app *txnMemory) Discard(ctx context.Context) error {
+	app.operations = []memoryOperation{}
+	app.isDone = true
+	return nil
+}
+
+func (app *txnMemory) delete(object map[string]any) ([]memoryOperation, error) {
+	uid, ok := object["uid"].(string)
+	if !ok || !strings.HasPrefix(uid, uidPrefix) {
+		return nil, errors.New("the deleted nodes must contain their uid")
+	}
+
+	if len(object) == 1 {
+		return []memoryOperation{
+			{
+				uid:      uid,
+				isDelete: true,
+			},
+		}, nil
+	}
+
+	operations := []memoryOperation{}
+	for predicate, oneValue := range object {
+		if predicate == "uid" {
+			continue
+		}
+
+		if oneValue != nil {
+			str := fmt.Sprintf("the predicate (%s) of the deleted node (uid: %s) was expected to be null", predicate, uid)
+			return nil, errors.New(str)
+		}
+
+		operations = append(operations, memoryOperation{
+			uid:       uid,
+			isDelete:  true,
+			predicate: predicate,
+		})
+	}
+
+	return operations, nil
+}
+
+func (app *txnMemory) set(object map[string]any, blanks map[string]string) (string, []memoryOperation, error) {
+	uid, err := app.uid(object, blanks)
+	if err != nil {
+		return "", nil, err
+	}
+
+	operations := []memoryOperation{}
+	for predicate, oneValue := range object {
+		if predicate == "uid" {
+			continue
+		}
+
+		switch casted := oneValue.(type) {
+		case map[string]any:
+			childUID, childOperations, err := app.set(casted, blanks)
+			if err != nil {
+				return "", nil, err
+			}
+
+			operations = append(operations, childOperations...)
+			operations = append(operations, memoryOperation{
+				uid:       uid,
+				predicate: predicate,
+				value: memoryEdge{
+					isList: false,
+					uids:   []string{childUID},
+				},
+			})
+		case []any:
+			if !containsObjects(casted) {
+				operations = append(operations, memoryOperation{
+					uid:       uid,
+					predicate: predicate,
+					value:     casted,
+				})
+
+				continue
+			}
+
+			edge := memoryEdge{
+				isList: true,
+				uids:   []string{},
+			}
+
+			for _, oneElement := range casted {
+				child, ok := oneElement.(map[string]any)
+				if !ok {
+					str := fmt.Sprintf("the list of the predicate (%s) mixes nodes and values", predicate)
+					return "", nil, errors.New(str)
+				}
+
+				childUID, childOperations, err := app.set(child, blanks)
+				if err != nil {
+					return "", nil, err
+				}
+
+				operations = append(operations, childOperations...)
+				edge.uids = append(edge.uids, childUID)
+			}
+
+			operations = append(operations, memoryOperation{
+				uid:       uid,
+				predicate: predicate,
+				value:     edge,
+			})
+		case nil:
+			continue
+		default:
+			operations = append(operations, memoryOperation{
+				uid:       uid,
+				predicate: predicate,
+				value:     casted,
+			})
+		}
+	}
+
+	// make sure a node without any predicate still exists once committed:
+	if len(operations) <= 0 {
+		operations = append(operations, memoryOperation{
+			uid:       uid,
+			predicate: "uid",
+			value:     uid,
+		})
+	}
+
+	return uid, operations, nil
+}
+
+func (app *txnMemory) uid(object map[string]any, blanks map[string]string) (string, error) {
+	value, ok := object["uid"]
+	if !ok {
+		return app.client.allocate(), nil
+	}
+
+	uid, ok := value.(string)
+	if !ok {
+		return "", errors.New("the uid of a node was expected to be a string")
+	}
+
+	if strings.HasPrefix(uid, blankNodePrefix) {
+		name := strings.TrimPrefix(uid, blankNodePrefix)
+		if assigned, ok := blanks[name]; ok {
+			return assigned, nil
+		}
+
+		blanks[name] = app.client.allocate()
+		return blanks[name], nil
+	}
+
+	if !strings.HasPrefix(uid, uidPrefix) {
+		str := fmt.Sprintf("the uid (%s) is invalid", uid)
+		return "", errors.New(str)
+	}
+
+	return uid, nil
+}
+
+func decodeObjects(data []byte) ([]map[string]any, error) {
+	var decoded any
+	err := json.Unmarshal(data, &decoded)
+	if err != nil {
+		return nil, err
+	}
+
+	switch casted := decoded.(type) {
+	case map[string]any:
+		return []map[string]any{
+			casted,
+		}, nil
+	case []any:
+		output := []map[string]any{}
+		for _, oneElement := range casted {
+			object, ok := oneElement.(map[string]any)
+			if !ok {
+				return nil, errors.New("the mutation was expected to only contain nodes")
+			}
+
+			output = append(output, object)
+		}
+
+		return output, nil
+	}
+
+	return nil, errors.New("the mutation was expected to contain a node or a list of nodes")
+}
+
+func containsObjects(list []any) bool {
+	for _, oneElement := range list {
+		if _, ok := oneElement.(map[string]any); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func contains(list []string, value string) bool {
+	for _, oneElement := range list {
+		if oneElement == value {
+			return true
+		}
+	}
+
+	return false
+}
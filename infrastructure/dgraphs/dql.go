@@ -0,0 +1,551 @@
+package dgraphs
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const dqlVariablePrefix = "$"
+
+type dqlToken struct {
+	isString bool
+	value    string
+}
+
+type dqlFunction struct {
+	name      string
+	arguments []dqlToken
+}
+
+type dqlField struct {
+	name      string
+	selection []dqlField
+}
+
+type dqlBlock struct {
+	name      string
+	function  dqlFunction
+	filters   []dqlFunction
+	isRecurse bool
+	selection []dqlField
+}
+
+type dqlQuery struct {
+	blocks []dqlBlock
+}
+
+// dqlParser parses the subset of DQL used by the repositories:
+//   - an optional query header declaring its variables
+//   - blocks whose root function is eq, has or uid
+//   - an optional @filter made of functions joined by "and"
+//   - an optional @recurse, that applies the selection of the block at every depth
+type dqlParser struct {
+	tokens []dqlToken
+	index  int
+}
+
+func parseQuery(query string) (*dqlQuery, error) {
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := dqlParser{
+		tokens: tokens,
+		index:  0,
+	}
+
+	return parser.query()
+}
+
+func tokenizeQuery(query string) ([]dqlToken, error) {
+	output := []dqlToken{}
+	runes := []rune(query)
+	for idx := 0; idx < len(runes); idx++ {
+		current := runes[idx]
+		switch {
+		case current == ' ' || current == '\t' || current == '\n' || current == '\r':
+			continue
+		case strings.ContainsRune("{}():,@", current):
+			output = append(output, dqlToken{
+				value: string(current),
+			})
+		case current == '"':
+			builder := strings.Builder{}
+			idx++
+			for ; idx < len(runes) && runes[idx] != '"'; idx++ {
+				if runes[idx] == '\\' && idx+1 < len(runes) {
+					idx++
+				}
+
+				builder.WriteRune(runes[idx])
+			}
+
+			if idx >= len(runes) {
+				return nil, errors.New("the query contains an unterminated string")
+			}
+
+			output = append(output, dqlToken{
+				isString: true,
+				value:    builder.String(),
+			})
+		case isIdentifierRune(current):
+			begin := idx
+			for idx+1 < len(runes) && isIdentifierRune(runes[idx+1]) {
+				idx++
+			}
+
+			output = append(output, dqlToken{
+				value: string(runes[begin : idx+1]),
+			})
+		default:
+			str := fmt.Sprintf("the query contains an unexpected character (%q)", current)
+			return nil, errors.New(str)
+		}
+	}
+
+	return output, nil
+}
+
+func isIdentifierRune(value rune) bool {
+	return value == '_' || value == '.' || value == '$' || value == '~' ||
+		(value >= 'a' && value <= 'z') ||
+		(value >= 'A' && value <= 'Z') ||
+		(value >= '0' && value <= '9')
+}
+
+func (app *dqlParser) query() (*dqlQuery, error) {
+	if app.peek("query") {
+		app.index++
+		if !app.peek("{") && !app.peek("(") {
+			app.index++
+		}
+
+		if app.peek("(") {
+			err := app.skipParenthesis()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	err := app.expect("{")
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := []dqlBlock{}
+	for !app.peek("}") {
+		block, err := app.block()
+		if err != nil {
+			return nil, err
+		}
+
+		blocks = append(blocks, *block)
+	}
+
+	err = app.expect("}")
+	if err != nil {
+		return nil, err
+	}
+
+	if app.index != len(app.tokens) {
+		return nil, errors.New("the query contains data after its last block")
+	}
+
+	return &dqlQuery{
+		blocks: blocks,
+	}, nil
+}
+
+func (app *dqlParser) block() (*dqlBlock, error) {
+	name, err := app.next()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, oneExpected := range []string{"(", "func", ":"} {
+		err := app.expect(oneExpected)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	function, err := app.function()
+	if err != nil {
+		return nil, err
+	}
+
+	err = app.expect(")")
+	if err != nil {
+		return nil, err
+	}
+
+	block := dqlBlock{
+		name:     name.value,
+		function: *function,
+		filters:  []dqlFunction{},
+	}
+
+	for app.peek("@") {
+		app.index++
+		directive, err := app.next()
+		if err != nil {
+			return nil, err
+		}
+
+		switch directive.value {
+		case "filter":
+			filters, err := app.filter()
+			if err != nil {
+				return nil, err
+			}
+
+			block.filters = filters
+		case "recurse":
+			block.isRecurse = true
+			if app.peek("(") {
+				err := app.skipParenthesis()
+				if err != nil {
+					return nil, err
+				}
+			}
+		default:
+			str := fmt.Sprintf("the directive (@%s) is not supported", directive.value)
+			return nil, errors.New(str)
+		}
+	}
+
+	selection, err := app.selection()
+	if err != nil {
+		return nil, err
+	}
+
+	block.selection = selection
+	return &block, nil
+}
+
+func (app *dqlParser) filter() ([]dqlFunction, error) {
+	err := app.expect("(")
+	if err != nil {
+		return nil, err
+	}
+
+	output := []dqlFunction{}
+	for {
+		function, err := app.function()
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, *function)
+		if !app.peek("and") {
+			break
+		}
+
+		app.index++
+	}
+
+	err = app.expect(")")
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (app *dqlParser) function() (*dqlFunction, error) {
+	name, err := app.next()
+	if err != nil {
+		return nil, err
+	}
+
+	if name.value != "eq" && name.value != "has" && name.value != "uid" {
+		str := fmt.Sprintf("the function (%s) is not supported", name.value)
+		return nil, errors.New(str)
+	}
+
+	err = app.expect("(")
+	if err != nil {
+		return nil, err
+	}
+
+	arguments := []dqlToken{}
+	for !app.peek(")") {
+		argument, err := app.next()
+		if err != nil {
+			return nil, err
+		}
+
+		arguments = append(arguments, argument)
+		if app.peek(",") {
+			app.index++
+		}
+	}
+
+	app.index++
+	return &dqlFunction{
+		name:      name.value,
+		arguments: arguments,
+	}, nil
+}
+
+func (app *dqlParser) selection() ([]dqlField, error) {
+	err := app.expect("{")
+	if err != nil {
+		return nil, err
+	}
+
+	output := []dqlField{}
+	for !app.peek("}") {
+		name, err := app.next()
+		if err != nil {
+			return nil, err
+		}
+
+		field := dqlField{
+			name: name.value,
+		}
+
+		if app.peek("{") {
+			selection, err := app.selection()
+			if err != nil {
+				return nil, err
+			}
+
+			field.selection = selection
+		}
+
+		output = append(output, field)
+	}
+
+	app.index++
+	return output, nil
+}
+
+func (app *dqlParser) skipParenthesis() error {
+	depth := 0
+	for ; app.index < len(app.tokens); app.index++ {
+		token := app.tokens[app.index]
+		if token.isString {
+			continue
+		}
+
+		if token.value == "(" {
+			depth++
+		}
+
+		if token.value == ")" {
+			depth--
+			if depth == 0 {
+				app.index++
+				return nil
+			}
+		}
+	}
+
+	return errors.New("the query contains an unclosed parenthesis")
+}
+
+func (app *dqlParser) peek(value string) bool {
+	if app.index >= len(app.tokens) {
+		return false
+	}
+
+	token := app.tokens[app.index]
+	return !token.isString && token.value == value
+}
+
+func (app *dqlParser) expect(value string) error {
+	if !app.peek(value) {
+		str := fmt.Sprintf("the query was expected to contain %q at token (index: %d)", value, app.index)
+		return errors.New(str)
+	}
+
+	app.index++
+	return nil
+}
+
+func (app *dqlParser) next() (dqlToken, error) {
+	if app.index >= len(app.tokens) {
+		return dqlToken{}, errors.New("the query ended unexpectedly")
+	}
+
+	token := app.tokens[app.index]
+	app.index++
+	return token, nil
+}
+
+func (app *dqlQuery) execute(client *clientMemory, vars map[string]string) (map[string]any, error) {
+	output := map[string]any{}
+	for _, oneBlock := range app.blocks {
+		list := []any{}
+		for _, oneUID := range client.order {
+			isMatch, err := oneBlock.function.matches(client, oneUID, vars)
+			if err != nil {
+				return nil, err
+			}
+
+			if !isMatch {
+				continue
+			}
+
+			for _, oneFilter := range oneBlock.filters {
+				isMatch, err = oneFilter.matches(client, oneUID, vars)
+				if err != nil {
+					return nil, err
+				}
+
+				if !isMatch {
+					break
+				}
+			}
+
+			if !isMatch {
+				continue
+			}
+
+			list = append(list, oneBlock.render(client, oneUID, oneBlock.selection, map[string]bool{}))
+		}
+
+		output[oneBlock.name] = list
+	}
+
+	return output, nil
+}
+
+func (app *dqlBlock) render(client *clientMemory, uid string, selection []dqlField, visited map[string]bool) map[string]any {
+	node := client.nodes[uid]
+	output := map[string]any{}
+	visited[uid] = true
+	defer delete(visited, uid)
+	for _, oneField := range selection {
+		if oneField.name == "uid" {
+			output["uid"] = uid
+			continue
+		}
+
+		value, ok := node[oneField.name]
+		if !ok {
+			continue
+		}
+
+		edge, isEdge := value.(memoryEdge)
+		if !isEdge {
+			output[oneField.name] = value
+			continue
+		}
+
+		childSelection := oneField.selection
+		if app.isRecurse {
+			childSelection = app.selection
+		}
+
+		if len(childSelection) <= 0 {
+			continue
+		}
+
+		children := []any{}
+		for _, oneChild := range edge.uids {
+			if _, ok := client.nodes[oneChild]; !ok || visited[oneChild] {
+				continue
+			}
+
+			children = append(children, app.render(client, oneChild, childSelection, visited))
+		}
+
+		if len(children) <= 0 {
+			continue
+		}
+
+		if !edge.isList {
+			output[oneField.name] = children[0]
+			continue
+		}
+
+		output[oneField.name] = children
+	}
+
+	return output
+}
+
+func (app *dqlFunction) matches(client *clientMemory, uid string, vars map[string]string) (bool, error) {
+	node := client.nodes[uid]
+	switch app.name {
+	case "has":
+		if len(app.arguments) != 1 {
+			return false, errors.New("the has function expects 1 predicate")
+		}
+
+		_, ok := node[app.arguments[0].value]
+		return ok, nil
+	case "uid":
+		for _, oneArgument := range app.arguments {
+			value, err := resolveArgument(oneArgument, vars)
+			if err != nil {
+				return false, err
+			}
+
+			if value == uid {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+
+	if len(app.arguments) != 2 {
+		return false, errors.New("the eq function expects a predicate and a value")
+	}
+
+	expected, err := resolveArgument(app.arguments[1], vars)
+	if err != nil {
+		return false, err
+	}
+
+	value, ok := node[app.arguments[0].value]
+	if !ok {
+		return false, nil
+	}
+
+	if list, ok := value.([]any); ok {
+		for _, oneElement := range list {
+			if formatValue(oneElement) == expected {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+
+	return formatValue(value) == expected, nil
+}
+
+func resolveArgument(argument dqlToken, vars map[string]string) (string, error) {
+	if argument.isString || !strings.HasPrefix(argument.value, dqlVariablePrefix) {
+		return argument.value, nil
+	}
+
+	value, ok := vars[argument.value]
+	if !ok {
+		str := fmt.Sprintf("the variable (%s) is not declared", argument.value)
+		return "", errors.New(str)
+	}
+
+	return value, nil
+}
+
+func formatValue(value any) string {
+	switch casted := value.(type) {
+	case string:
+		return casted
+	case float64:
+		return strconv.FormatFloat(casted, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(casted)
+	}
+
+	return fmt.Sprintf("%v", value)
+}
@@ -0,0 +1,351 @@
+package dgraphs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements/references"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+)
+
+const grammarPathSeparator = "/"
+
+const grammarsListQuery = `{
+	grammars(func: has(grammar.path)) {
+		grammar.path
+		grammar.version
+	}
+}`
+
+const grammarRetrieveQuery = `query grammar($path: string) {
+	grammars(func: eq(grammar.path, $path)) {
+		uid
+		grammar.version
+		grammar.content
+	}
+}`
+
+type grammarNode struct {
+	UID     string   `json:"uid,omitempty"`
+	Types   []string `json:"dgraph.type,omitempty"`
+	Path    string   `json:"grammar.path,omitempty"`
+	Version string   `json:"grammar.version,omitempty"`
+	Content []byte   `json:"grammar.content,omitempty"`
+}
+
+type grammarResponse struct {
+	Grammars []grammarNode `json:"grammars"`
+}
+
+type grammarRepository struct {
+	mutex          sync.RWMutex
+	client         Client
+	adapter        grammars.Adapter
+	versionAdapter versions.Adapter
+	grammars       map[string]map[string]grammars.Grammar
+}
+
+func createGrammarRepository(
+	client Client,
+	adapter grammars.Adapter,
+	versionAdapter versions.Adapter,
+) grammars.Repository {
+	out := grammarRepository{
+		client:         client,
+		adapter:        adapter,
+		versionAdapter: versionAdapter,
+		grammars:       map[string]map[string]grammars.Grammar{},
+	}
+
+	return &out
+}
+
+// Init initializes the repository and its schema
+func (app *grammarRepository) Init() error {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	app.grammars = map[string]map[string]grammars.Grammar{}
+	return app.client.Alter(context.Background(), Schema)
+}
+
+// List lists the grammar paths and their sorted versions
+func (app *grammarRepository) List() (map[string][]versions.Version, error) {
+	ctx := context.Background()
+	txn := app.client.NewTxn()
+	defer txn.Discard(ctx)
+
+	response, err := app.query(ctx, txn, grammarsListQuery, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	output := map[string][]versions.Version{}
+	for _, oneGrammar := range response.Grammars {
+		version, err := app.versionAdapter.ToVersion([]byte(oneGrammar.Version))
+		if err != nil {
+			str := fmt.Sprintf("the grammar (path: %s) contains an invalid version (%s): %s", oneGrammar.Path, oneGrammar.Version, err.Error())
+			return nil, errors.New(str)
+		}
+
+		output[oneGrammar.Path] = append(output[oneGrammar.Path], version)
+	}
+
+	for path, oneList := range output {
+		sort.Slice(oneList, func(i, j int) bool {
+			return oneList[i].Compare(oneList[j]) < 0
+		})
+
+		output[path] = oneList
+	}
+
+	return output, nil
+}
+
+// Insert inserts a grammar, replacing the grammar stored with the same path and version
+func (app *grammarRepository) Insert(path []string, grammar grammars.Grammar) error {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	pathStr, err := joinPath(path)
+	if err != nil {
+		return err
+	}
+
+	content, err := app.adapter.ToBytes(grammar)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	txn := app.client.NewTxn()
+	defer txn.Discard(ctx)
+
+	nodes, err := app.find(ctx, txn, pathStr)
+	if err != nil {
+		return err
+	}
+
+	version := grammar.Version().String()
+	node := grammarNode{
+		UID:     fmt.Sprintf("%s%s", blankNodePrefix, "grammar"),
+		Types:   []string{grammarType},
+		Path:    pathStr,
+		Version: version,
+		Content: content,
+	}
+
+	for _, oneNode := range nodes {
+		if oneNode.Version == version {
+			node.UID = oneNode.UID
+			break
+		}
+	}
+
+	setJSON, err := json.Marshal(node)
+	if err != nil {
+		return err
+	}
+
+	_, err = txn.Mutate(ctx, setJSON, nil)
+	if err != nil {
+		return err
+	}
+
+	err = txn.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := app.grammars[pathStr]; !ok {
+		app.grammars[pathStr] = map[string]grammars.Grammar{}
+	}
+
+	app.grammars[pathStr][version] = grammar
+	return nil
+}
+
+// Retrieve retrieves the grammar whose version is the best match of the reference, parsing its stored content on first access
+func (app *grammarRepository) Retrieve(reference references.Reference) (grammars.Grammar, error) {
+	pathStr, err := joinPath(reference.Path())
+	if err != nil {
+		return nil, err
+	}
+
+	// the client is queried without holding the lock, so the reads are not serialized:
+	ctx := context.Background()
+	txn := app.client.NewTxn()
+	defer txn.Discard(ctx)
+
+	constraint := reference.Constraint()
+	node, version, err := app.resolve(ctx, txn, pathStr, constraint)
+	if err != nil {
+		return nil, err
+	}
+
+	if node == nil {
+		str := fmt.Sprintf("the version (%s) of the provided grammar path (%s) could not be found", constraint.String(), pathStr)
+		return nil, errors.New(str)
+	}
+
+	app.mutex.RLock()
+	ins, ok := app.grammars[pathStr][node.Version]
+	app.mutex.RUnlock()
+	if ok {
+		return ins, nil
+	}
+
+	grammar, remaining, err := app.adapter.ToGrammar(node.Content)
+	if err != nil {
+		str := fmt.Sprintf("the grammar (path: %s, version: %s) could not be parsed: %s", pathStr, node.Version, err.Error())
+		return nil, errors.New(str)
+	}
+
+	if len(bytes.TrimSpace(remaining)) > 0 {
+		str := fmt.Sprintf("the grammar (path: %s, version: %s) was expected to contain no remaining data after its definition", pathStr, version.String())
+		return nil, errors.New(str)
+	}
+
+	if grammar.Version().Compare(version) != 0 {
+		str := fmt.Sprintf("the grammar (path: %s) was expected to declare its stored version (%s), %s declared", pathStr, version.String(), grammar.Version().String())
+		return nil, errors.New(str)
+	}
+
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	if _, ok := app.grammars[pathStr]; !ok {
+		app.grammars[pathStr] = map[string]grammars.Grammar{}
+	}
+
+	// a grammar inserted meanwhile is more recent than the parsed content:
+	if ins, ok := app.grammars[pathStr][node.Version]; ok {
+		return ins, nil
+	}
+
+	app.grammars[pathStr][node.Version] = grammar
+	return grammar, nil
+}
+
+// Delete deletes the grammar whose version is the exact version of the reference
+func (app *grammarRepository) Delete(reference references.Reference) error {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+	pathStr, err := joinPath(reference.Path())
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	txn := app.client.NewTxn()
+	defer txn.Discard(ctx)
+
+	constraint := reference.Constraint()
+	if !constraint.IsExact() {
+		str := fmt.Sprintf("the version (%s) of the grammar to delete at the provided path (%s) was expected to be exact, such as 1.2.3", constraint.String(), pathStr)
+		return errors.New(str)
+	}
+
+	node, _, err := app.resolve(ctx, txn, pathStr, constraint)
+	if err != nil {
+		return err
+	}
+
+	if node == nil {
+		str := fmt.Sprintf("there is no grammar at the provided path (%s) for version (%s)", pathStr, constraint.String())
+		return errors.New(str)
+	}
+
+	deleteJSON, err := json.Marshal(map[string]string{
+		"uid": node.UID,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	_, err = txn.Mutate(ctx, nil, deleteJSON)
+	if err != nil {
+		return err
+	}
+
+	err = txn.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := app.grammars[pathStr]; ok {
+		delete(app.grammars[pathStr], node.Version)
+		if len(app.grammars[pathStr]) <= 0 {
+			delete(app.grammars, pathStr)
+		}
+	}
+
+	return nil
+}
+
+func (app *grammarRepository) resolve(ctx context.Context, txn Txn, path string, constraint versions.Constraint) (*grammarNode, versions.Version, error) {
+	nodes, err := app.find(ctx, txn, path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	list := []versions.Version{}
+	byVersion := map[string]grammarNode{}
+	for _, oneNode := range nodes {
+		version, err := app.versionAdapter.ToVersion([]byte(oneNode.Version))
+		if err != nil {
+			continue
+		}
+
+		list = append(list, version)
+		byVersion[version.String()] = oneNode
+	}
+
+	version, err := constraint.Resolve(list)
+	if err != nil {
+		return nil, nil, nil
+	}
+
+	node := byVersion[version.String()]
+	return &node, version, nil
+}
+
+func (app *grammarRepository) find(ctx context.Context, txn Txn, path string) ([]grammarNode, error) {
+	response, err := app.query(ctx, txn, grammarRetrieveQuery, map[string]string{
+		"$path": path,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Grammars, nil
+}
+
+func (app *grammarRepository) query(ctx context.Context, txn Txn, query string, vars map[string]string) (*grammarResponse, error) {
+	data, err := txn.Query(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	response := grammarResponse{}
+	err = json.Unmarshal(data, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func joinPath(path []string) (string, error) {
+	if len(path) <= 0 {
+		return "", errors.New("the path must contain at least 1 segment in order to locate a grammar")
+	}
+
+	return strings.Join(path, grammarPathSeparator), nil
+}
@@ -0,0 +1,306 @@
+package dgraphs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/blocks/lines/tokens/elements/references"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+)
+
+func TestGrammarRepository_Success(t *testing.T) {
+	grammar, _, err := grammars.NewAdapter().ToGrammar([]byte(`
+		v3;
+		> .assignment;
+		# .SPACE;
+
+		assignment: .LL_A .EQUAL .N_ONE[1,3] .SEMICOLON
+					---
+					valid: "a=11;";
+					;
+
+		N_ONE: "1";
+		LL_A: "a";
+		EQUAL: "=";
+		SEMICOLON: ";";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	client := NewClientMemory()
+	repository := NewGrammarRepository(client)
+	err = repository.Init()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	path := []string{"languages", "assignments"}
+	for i := 0; i < 2; i++ {
+		err = repository.Insert(path, grammar)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+	}
+
+	list, err := repository.List()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if retVersions, ok := list["languages/assignments"]; !ok || len(retVersions) != 1 || retVersions[0].String() != "3.0.0" {
+		t.Errorf("the list was expected to contain the version 3 of the inserted grammar once, %v returned", list)
+		return
+	}
+
+	constraint, err := versions.NewAdapter().ToConstraint([]byte("^3"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	reference, err := references.NewBuilder().Create().
+		WithPath(path).
+		WithName("assignments").
+		WithConstraint(constraint).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// a new repository on the same client parses the stored grammar:
+	retGrammar, err := NewGrammarRepository(client).Retrieve(reference)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if retGrammar.Version().String() != "3.0.0" || retGrammar.Root().Name() != "assignment" {
+		t.Errorf("the retrieved grammar was expected to be the inserted grammar")
+		return
+	}
+
+	// a grammar can only be deleted using its exact version, a bare version being exact:
+	err = repository.Delete(reference)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	exactConstraint, err := versions.NewAdapter().ToConstraint([]byte("3"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	exactReference, err := references.NewBuilder().Create().
+		WithPath(path).
+		WithName("assignments").
+		WithConstraint(exactConstraint).
+		Now()
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = repository.Delete(exactReference)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = repository.Retrieve(reference)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	err = repository.Delete(exactReference)
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestGrammarRepository_concurrent_Success(t *testing.T) {
+	grammar, _, err := grammars.NewAdapter().ToGrammar([]byte(`
+		v1;
+		> .value;
+		# .SPACE;
+
+		value: .N_ONE+
+			;
+
+		N_ONE: "1";
+		SPACE: " ";
+	`))
+
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	constraint, err := versions.NewAdapter().ToConstraint([]byte("1"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	repository := NewGrammarRepository(NewClientMemory())
+	err = repository.Init()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	amount := 16
+	errs := make(chan error, amount)
+	waitGroup := sync.WaitGroup{}
+	for i := 0; i < amount; i++ {
+		waitGroup.Add(1)
+		go func(idx int) {
+			defer waitGroup.Done()
+			path := []string{"concurrent", fmt.Sprintf("value%d", idx)}
+			err := repository.Insert(path, grammar)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			reference, err := references.NewBuilder().Create().
+				WithPath(path).
+				WithName("value").
+				WithConstraint(constraint).
+				Now()
+
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			_, err = NewGrammarRepository(repository.(*grammarRepository).client).Retrieve(reference)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			_, err = repository.List()
+			if err != nil {
+				errs <- err
+				return
+			}
+		}(i)
+	}
+
+	waitGroup.Wait()
+	close(errs)
+	for oneErr := range errs {
+		t.Errorf("the error was expected to be nil, error returned: %s", oneErr.Error())
+		return
+	}
+
+	list, err := repository.List()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(list) != amount {
+		t.Errorf("the list was expected to contain %d grammars, %d returned", amount, len(list))
+		return
+	}
+}
+
+func TestGrammarRepository_withInvalidContent_returnsError(t *testing.T) {
+	content := `
+		v1;
+		> .value;
+		# .SPACE;
+
+		value: .N_ONE
+			;
+
+		N_ONE: "1";
+		SPACE: " ";
+	`
+
+	cases := map[string]string{
+		"remaining data": fmt.Sprintf("%s ---", content),
+		"other version":  strings.Replace(content, "v1;", "v2;", 1),
+	}
+
+	for name, oneContent := range cases {
+		client := NewClientMemory()
+		repository := NewGrammarRepository(client)
+		err := repository.Init()
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		// the content is stored as version 1.0.0 without going through Insert:
+		setJSON, err := json.Marshal(grammarNode{
+			UID:     fmt.Sprintf("%s%s", blankNodePrefix, "grammar"),
+			Types:   []string{grammarType},
+			Path:    "values",
+			Version: "1.0.0",
+			Content: []byte(oneContent),
+		})
+
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		ctx := context.Background()
+		txn := client.NewTxn()
+		_, err = txn.Mutate(ctx, setJSON, nil)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		err = txn.Commit(ctx)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		constraint, err := versions.NewAdapter().ToConstraint([]byte("1.0.0"))
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		reference, err := references.NewBuilder().Create().
+			WithPath([]string{"values"}).
+			WithName("values").
+			WithConstraint(constraint).
+			Now()
+
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		_, err = repository.Retrieve(reference)
+		if err == nil {
+			t.Errorf("the %s case: the error was expected to be valid, nil returned", name)
+			return
+		}
+	}
+}
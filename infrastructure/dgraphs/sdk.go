@@ -0,0 +1,147 @@
+package dgraphs
+
+import (
+	"context"
+
+	"github.com/steve-care-software/grammars/domain/engine/asts"
+	"github.com/steve-care-software/grammars/domain/engine/grammars"
+	"github.com/steve-care-software/grammars/domain/engine/grammars/versions"
+)
+
+const blankNodePrefix = "_:"
+const uidPrefix = "0x"
+
+const grammarType = "Grammar"
+const documentType = "Document"
+const elementType = "Element"
+const tokenType = "Token"
+
+const constantEncodingBase64 = "base64"
+
+// Schema represents the DQL schema of the stored grammars and documents
+const Schema = `
+grammar.path: string @index(exact) .
+grammar.version: string @index(exact) .
+grammar.content: string .
+document.name: string @index(exact) @upsert .
+document.version: string .
+document.root: uid .
+element.index: int .
+element.version: string .
+element.ast: uid .
+instruction.block: string @index(exact) .
+instruction.line: int .
+instruction.tokens: [uid] .
+token.name: string @index(exact) .
+token.index: int .
+token.elements: [uid] .
+constant.name: string @index(exact) .
+constant.value: string @index(exact) .
+constant.encoding: string .
+
+type Grammar {
+	grammar.path
+	grammar.version
+	grammar.content
+}
+
+type Document {
+	document.name
+	document.version
+	document.root
+}
+
+type Element {
+	element.index
+	element.version
+	element.ast
+	instruction.block
+	instruction.line
+	instruction.tokens
+	constant.name
+	constant.value
+	constant.encoding
+}
+
+type Token {
+	token.name
+	token.index
+	token.elements
+}
+`
+
+// NewClientMemory creates a new in-process client that understands the DQL used by the repositories, it is meant for tests
+func NewClientMemory() Client {
+	return createClientMemory()
+}
+
+// NewGrammarRepository creates a new grammar repository stored in Dgraph
+func NewGrammarRepository(
+	client Client,
+) grammars.Repository {
+	adapter := grammars.NewAdapter()
+	versionAdapter := versions.NewAdapter()
+	return createGrammarRepository(
+		client,
+		adapter,
+		versionAdapter,
+	)
+}
+
+// NewASTRepository creates a new AST repository stored in Dgraph
+func NewASTRepository(
+	client Client,
+) ASTRepository {
+	builder := asts.NewBuilder()
+	instructionBuilder := asts.NewInstructionBuilder()
+	tokensBuilder := asts.NewTokensBuilder()
+	tokenBuilder := asts.NewTokenBuilder()
+	elementsBuilder := asts.NewElementsBuilder()
+	elementBuilder := asts.NewElementBuilder()
+	constantBuilder := asts.NewConstantBuilder()
+	versionAdapter := versions.NewAdapter()
+	return createASTRepository(
+		client,
+		builder,
+		instructionBuilder,
+		tokensBuilder,
+		tokenBuilder,
+		elementsBuilder,
+		elementBuilder,
+		constantBuilder,
+		versionAdapter,
+	)
+}
+
+// Client represents the subset of the dgo client used by the repositories
+type Client interface {
+	// Alter alters the schema of the database
+	Alter(ctx context.Context, schema string) error
+
+	// NewTxn creates a new transaction
+	NewTxn() Txn
+}
+
+// Txn represents a transaction
+type Txn interface {
+	// Query executes the DQL query using the variables and returns its JSON response
+	Query(ctx context.Context, query string, vars map[string]string) ([]byte, error)
+
+	// Mutate sets and deletes the JSON nodes and returns the uids assigned to the blank nodes
+	Mutate(ctx context.Context, setJSON []byte, deleteJSON []byte) (map[string]string, error)
+
+	// Commit commits the transaction
+	Commit(ctx context.Context) error
+
+	// Discard discards the transaction
+	Discard(ctx context.Context) error
+}
+
+// ASTRepository represents a repository of parsed documents, stored as instruction, token and element nodes
+type ASTRepository interface {
+	Init() error
+	List() ([]string, error)
+	Insert(name string, ast asts.AST) error
+	Retrieve(name string) (asts.AST, error)
+	Delete(name string) error
+}